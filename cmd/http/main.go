@@ -18,15 +18,18 @@ import (
 	"go-campaign.com/internal/image"
 	"go-campaign.com/internal/infrastructure"
 	paymentModule "go-campaign.com/internal/payment"
+	"go-campaign.com/internal/shared/events"
 	"go-campaign.com/internal/shared/http/middleware"
 	"go-campaign.com/internal/shared/services/payment"
 	"go-campaign.com/internal/user"
 	"go-campaign.com/pkg/filesystem"
+	"go-campaign.com/pkg/mailer"
+	"go-campaign.com/pkg/scheduler"
 )
 
 func main() {
 	if err := run(); err != nil {
-		log.Fatalf("Application error: %w", err)
+		log.Fatalf("Application error: %v", err)
 	}
 }
 
@@ -56,6 +59,11 @@ func run() error {
 
 	defer deps.CloseDatabaseConnection()
 
+	shutdown, startShutdown := context.WithCancel(context.Background())
+	defer startShutdown()
+
+	deps.Shutdown = shutdown
+
 	app, err := setupApp(deps)
 
 	if err != nil {
@@ -64,6 +72,8 @@ func run() error {
 
 	setupModule(app, deps)
 
+	deps.Scheduler.Start()
+
 	go func() {
 		serverErr <- app.Listen(port)
 	}()
@@ -75,13 +85,19 @@ func run() error {
 		return fmt.Errorf("start server: %w", err)
 	}
 
+	// ends the open event streams, the server waits for them otherwise
+	startShutdown()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	if err := app.ShutdownWithContext(ctx); err != nil {
-		log.Printf("server shutdown error: %w", err)
+		log.Printf("server shutdown error: %v", err)
 	}
 
+	deps.Scheduler.Stop()
+	deps.Events.Wait()
+
 	return nil
 }
 
@@ -114,11 +130,26 @@ func setupDependencies(cfg *config.Config) (*app.Dependencies, error) {
 		return nil, err
 	}
 
+	mail := mailer.NewLogMailer()
+
+	if cfg.App.Service.Mail.Driver == "smtp" {
+		mail = mailer.NewSMTPMailer(
+			cfg.App.Service.Mail.Host,
+			cfg.App.Service.Mail.Port,
+			cfg.App.Service.Mail.Username,
+			cfg.App.Service.Mail.Password,
+			cfg.App.Service.Mail.From,
+		)
+	}
+
 	return &app.Dependencies{
 		DB:             db,
 		Config:         cfg,
 		FileSystem:     fsystem,
 		PaymentGateway: paymentGateway,
+		Events:         events.NewBus(),
+		Mailer:         mail,
+		Scheduler:      scheduler.New(),
 	}, nil
 }
 
@@ -156,4 +187,13 @@ func setupModule(fiberApp *fiber.App, deps *app.Dependencies) {
 	for _, module := range modules {
 		module(v1, deps)
 	}
+
+	// server-rendered pages are served from the root
+	webModules := []app.Bootable{
+		campaign.BootWeb,
+	}
+
+	for _, module := range webModules {
+		module(fiberApp, deps)
+	}
 }
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_campaigns_title_trgm;
+DROP INDEX IF EXISTS idx_campaigns_search_vector;
+DROP TRIGGER IF EXISTS trg_campaigns_search_vector ON campaigns;
+DROP FUNCTION IF EXISTS campaigns_search_vector_update();
+
+ALTER TABLE
+    campaigns DROP COLUMN IF EXISTS search_vector,
+    DROP COLUMN IF EXISTS tags;
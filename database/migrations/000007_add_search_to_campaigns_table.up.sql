@@ -0,0 +1,33 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE
+    campaigns
+ADD
+    tags TEXT [] DEFAULT '{}' NOT NULL,
+ADD
+    search_vector TSVECTOR;
+
+-- keep search_vector in sync with title, tags and description
+CREATE OR REPLACE FUNCTION campaigns_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('simple', array_to_string(NEW.tags, ' ')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'C');
+
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_campaigns_search_vector
+BEFORE INSERT OR UPDATE OF title, description, tags ON campaigns
+FOR EACH ROW EXECUTE FUNCTION campaigns_search_vector_update();
+
+-- backfill existing campaigns
+UPDATE campaigns SET title = title;
+
+-- add full-text index for search_vector
+CREATE INDEX idx_campaigns_search_vector ON campaigns USING GIN (search_vector);
+
+-- add trigram index for typo tolerant title search
+CREATE INDEX idx_campaigns_title_trgm ON campaigns USING GIN (title gin_trgm_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_payments_campaign_id_status;
+DROP INDEX IF EXISTS idx_campaigns_target_amount;
+DROP INDEX IF EXISTS idx_campaigns_current_amount;
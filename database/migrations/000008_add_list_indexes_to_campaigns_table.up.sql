@@ -0,0 +1,8 @@
+-- add index for current_amount (most funded sort)
+CREATE INDEX IF NOT EXISTS idx_campaigns_current_amount ON campaigns (current_amount);
+
+-- add index for target_amount (target range filter)
+CREATE INDEX IF NOT EXISTS idx_campaigns_target_amount ON campaigns (target_amount);
+
+-- add composite index for counting paid donors per campaign
+CREATE INDEX IF NOT EXISTS idx_payments_campaign_id_status ON payments (campaign_id, status);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_campaigns_user_id_start_date_id;
+DROP INDEX IF EXISTS idx_donaturs_campaign_id_created_at_id;
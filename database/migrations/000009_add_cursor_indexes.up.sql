@@ -0,0 +1,5 @@
+-- add composite index for the donatur list cursor
+CREATE INDEX IF NOT EXISTS idx_donaturs_campaign_id_created_at_id ON donaturs (campaign_id, created_at, id);
+
+-- add composite index for the user campaign list cursor
+CREATE INDEX IF NOT EXISTS idx_campaigns_user_id_start_date_id ON campaigns (user_id, start_date, id);
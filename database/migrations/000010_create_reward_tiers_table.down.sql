@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS donation_rewards;
+DROP TABLE IF EXISTS reward_tiers;
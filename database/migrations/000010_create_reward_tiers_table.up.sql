@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS reward_tiers (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    description TEXT NULL,
+    min_amount DECIMAL(10, 2) NOT NULL,
+    quantity INT NULL, -- NULL means unlimited stock
+    reserved INT NOT NULL DEFAULT 0, -- held by donations waiting for payment
+    claimed INT NOT NULL DEFAULT 0, -- taken by paid donations
+    requires_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    CONSTRAINT chk_reward_tiers_stock CHECK (quantity IS NULL OR reserved + claimed <= quantity)
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_reward_tiers_campaign_id ON reward_tiers (campaign_id);
+
+CREATE TABLE IF NOT EXISTS donation_rewards (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    donation_id INT NOT NULL UNIQUE,
+    reward_tier_id INT NOT NULL,
+    campaign_id INT NOT NULL,
+    status INT NOT NULL DEFAULT 1, -- 1: reserved, 2: claimed, 3: released
+    shipping_name VARCHAR(100) NULL,
+    shipping_phone VARCHAR(20) NULL,
+    shipping_address TEXT NULL,
+    fulfilled_at TIMESTAMP NULL, -- date when the owner sent the reward
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(donation_id) REFERENCES donations(id) ON DELETE CASCADE,
+    FOREIGN KEY(reward_tier_id) REFERENCES reward_tiers(id) ON DELETE CASCADE,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add composite index for the fulfilment list
+CREATE INDEX IF NOT EXISTS idx_donation_rewards_campaign_id_status ON donation_rewards (campaign_id, status);
+-- add index foreign key reward_tier_id
+CREATE INDEX IF NOT EXISTS idx_donation_rewards_reward_tier_id ON donation_rewards (reward_tier_id);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS campaign_milestones (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    reached_at TIMESTAMP NULL, -- date when current_amount first passed the amount
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    CONSTRAINT uq_campaign_milestones_amount UNIQUE (campaign_id, amount)
+);
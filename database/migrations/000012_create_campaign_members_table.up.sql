@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS campaign_members (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    user_id INT NULL, -- set once the invitation is accepted
+    email VARCHAR(100) NOT NULL,
+    role INT NOT NULL, -- 1: owner, 2: editor, 3: viewer
+    status INT NOT NULL DEFAULT 1, -- 1: invited, 2: accepted, 3: declined
+    token VARCHAR(64) NULL UNIQUE, -- invitation token, cleared once answered
+    invited_by INT NULL,
+    accepted_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY(invited_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_campaign_members_email UNIQUE (campaign_id, email)
+);
+
+-- add composite index for the membership checks
+CREATE INDEX IF NOT EXISTS idx_campaign_members_user_id_campaign_id ON campaign_members (user_id, campaign_id);
+
+-- every existing campaign creator becomes its owner
+INSERT INTO campaign_members (campaign_id, user_id, email, role, status, accepted_at)
+SELECT c.id, c.user_id, u.email, 1, 2, c.created_at
+FROM campaigns c
+JOIN users u ON u.id = c.user_id;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS campaign_reviews;
+ALTER TABLE campaigns DROP COLUMN IF EXISTS approved_at;
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,21 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- set the first time an admin approves the campaign, some fields are locked afterwards
+ALTER TABLE campaigns ADD COLUMN IF NOT EXISTS approved_at TIMESTAMP NULL;
+
+-- campaigns that were already live don't go through the review
+UPDATE campaigns SET approved_at = COALESCE(created_at, CURRENT_TIMESTAMP) WHERE status IN (2, 3, 4);
+
+CREATE TABLE IF NOT EXISTS campaign_reviews (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    reviewer_id INT NULL,
+    decision INT NOT NULL, -- 1: approved, 2: rejected
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(reviewer_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_reviews_campaign_id ON campaign_reviews (campaign_id);
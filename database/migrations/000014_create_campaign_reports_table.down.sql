@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS campaign_reports;
+ALTER TABLE campaigns DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE campaigns DROP COLUMN IF EXISTS suspended_at;
@@ -0,0 +1,21 @@
+-- a suspended campaign stays visible with a notice but can't take donations
+ALTER TABLE campaigns ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMP NULL;
+ALTER TABLE campaigns ADD COLUMN IF NOT EXISTS suspension_reason TEXT NULL;
+
+CREATE TABLE IF NOT EXISTS campaign_reports (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    reporter_key VARCHAR(64) NOT NULL, -- hash identifying the reporter, one report per reporter and campaign
+    category VARCHAR(30) NOT NULL, -- e.g., 'fraud', 'misleading', 'spam'
+    description TEXT NULL,
+    status INT NOT NULL DEFAULT 1, -- 1: open, 2: dismissed, 3: upheld
+    resolved_by INT NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(resolved_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_campaign_reports_reporter UNIQUE (campaign_id, reporter_key)
+);
+
+-- add composite index for counting open reports
+CREATE INDEX IF NOT EXISTS idx_campaign_reports_campaign_id_status ON campaign_reports (campaign_id, status);
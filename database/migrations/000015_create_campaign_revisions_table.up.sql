@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS campaign_revisions (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    revision INT NOT NULL, -- 1 is the campaign as it was created
+    author_id INT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    slug VARCHAR(255) NOT NULL,
+    target_amount DECIMAL(10, 2) NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    status INT NOT NULL,
+    images TEXT[] NOT NULL DEFAULT '{}',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(author_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_campaign_revisions_revision UNIQUE (campaign_id, revision)
+);
+
+-- existing campaigns start their history from their current state
+INSERT INTO campaign_revisions (campaign_id, revision, author_id, title, description, slug, target_amount, start_date, end_date, status, images, tags, created_at)
+SELECT id, 1, user_id, title, description, slug, target_amount, start_date, end_date, status, images, tags,
+    COALESCE(updated_at, created_at, CURRENT_TIMESTAMP)
+FROM campaigns;
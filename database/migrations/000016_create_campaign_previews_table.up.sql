@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS campaign_previews (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    token VARCHAR(64) NOT NULL UNIQUE, -- random token shared in the preview link
+    created_by INT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_previews_campaign_id ON campaign_previews (campaign_id);
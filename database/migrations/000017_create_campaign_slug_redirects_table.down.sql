@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS campaign_slug_redirects;
+
+DROP INDEX IF EXISTS idx_campaigns_slug_deleted_at;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_campaigns_user_slug_deleted_at
+ON campaigns (user_id, slug)
+WHERE deleted_at IS NULL;
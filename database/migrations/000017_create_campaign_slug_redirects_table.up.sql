@@ -0,0 +1,24 @@
+-- slugs used to be unique per user only, later duplicates get their id appended
+UPDATE campaigns c
+SET slug = LEFT(c.slug, 240) || '-' || c.id
+WHERE c.deleted_at IS NULL AND EXISTS (
+    SELECT 1 FROM campaigns o
+    WHERE o.slug = c.slug AND o.deleted_at IS NULL AND o.id < c.id
+);
+
+DROP INDEX IF EXISTS idx_campaigns_user_slug_deleted_at;
+
+-- slugs are looked up globally, so they are unique among live campaigns
+CREATE UNIQUE INDEX IF NOT EXISTS idx_campaigns_slug_deleted_at
+ON campaigns (slug)
+WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS campaign_slug_redirects (
+    slug VARCHAR(255) PRIMARY KEY, -- a slug the campaign was renamed from
+    campaign_id INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_slug_redirects_campaign_id ON campaign_slug_redirects (campaign_id);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS campaign_short_links (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL UNIQUE, -- one short link per campaign
+    code VARCHAR(16) NOT NULL UNIQUE,
+    clicks BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
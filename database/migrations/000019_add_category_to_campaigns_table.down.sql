@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_campaigns_category;
+
+ALTER TABLE
+    campaigns
+DROP
+    COLUMN IF EXISTS category;
@@ -0,0 +1,7 @@
+ALTER TABLE
+    campaigns
+ADD
+    category VARCHAR(30) NULL;
+
+-- add index for category (category filter and feeds)
+CREATE INDEX IF NOT EXISTS idx_campaigns_category ON campaigns (category) WHERE deleted_at IS NULL;
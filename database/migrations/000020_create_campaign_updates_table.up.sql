@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS campaign_updates (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    author_id INT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(author_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index for the updates of a campaign, newest first
+CREATE INDEX IF NOT EXISTS idx_campaign_updates_campaign_id_created_at ON campaign_updates (campaign_id, created_at DESC) WHERE deleted_at IS NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_campaigns_changed_at;
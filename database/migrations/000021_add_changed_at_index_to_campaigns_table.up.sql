@@ -0,0 +1,2 @@
+-- add index for the campaigns changed since a time (incremental sitemap)
+CREATE INDEX IF NOT EXISTS idx_campaigns_changed_at ON campaigns (GREATEST(updated_at, deleted_at));
@@ -0,0 +1,4 @@
+ALTER TABLE
+    donaturs
+DROP
+    COLUMN IF EXISTS is_anonymous;
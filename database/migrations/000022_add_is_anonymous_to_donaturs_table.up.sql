@@ -0,0 +1,4 @@
+ALTER TABLE
+    donaturs
+ADD
+    is_anonymous BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS campaign_trending_scores (
+    campaign_id INT NOT NULL PRIMARY KEY,
+    -- donation velocity, recent donations weigh more than older ones
+    score NUMERIC(14, 4) NOT NULL,
+    recent_donations INT NOT NULL,
+    recent_amount DECIMAL(14, 2) NOT NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index for the trending ranking
+CREATE INDEX IF NOT EXISTS idx_campaign_trending_scores_score ON campaign_trending_scores (score DESC, campaign_id DESC);
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS campaign_fundraisers (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    user_id INT NOT NULL,
+    slug VARCHAR(60) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    story TEXT NOT NULL,
+    target_amount DECIMAL(10, 2) NOT NULL,
+    -- the part of the parent campaign's current_amount raised through this page
+    current_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- fundraiser slugs are looked up globally, so they are unique among live pages
+CREATE UNIQUE INDEX IF NOT EXISTS idx_campaign_fundraisers_slug ON campaign_fundraisers (slug) WHERE deleted_at IS NULL;
+-- add index for the fundraiser leaderboard of a campaign
+CREATE INDEX IF NOT EXISTS idx_campaign_fundraisers_campaign_id_current_amount ON campaign_fundraisers (campaign_id, current_amount DESC) WHERE deleted_at IS NULL;
+-- add index foreign key user_id
+CREATE INDEX IF NOT EXISTS idx_campaign_fundraisers_user_id ON campaign_fundraisers (user_id);
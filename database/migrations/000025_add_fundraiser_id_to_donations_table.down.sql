@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_donations_fundraiser_id;
+
+ALTER TABLE
+    donations
+DROP
+    COLUMN IF EXISTS fundraiser_id;
@@ -0,0 +1,7 @@
+ALTER TABLE
+    donations
+ADD
+    fundraiser_id INT NULL REFERENCES campaign_fundraisers(id) ON DELETE SET NULL;
+
+-- add index foreign key fundraiser_id
+CREATE INDEX IF NOT EXISTS idx_donations_fundraiser_id ON donations (fundraiser_id) WHERE fundraiser_id IS NOT NULL;
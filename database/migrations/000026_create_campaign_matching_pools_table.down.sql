@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS donation_matches;
+DROP TABLE IF EXISTS campaign_matching_pools;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS campaign_matching_pools (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    sponsor_name VARCHAR(100) NOT NULL,
+    sponsor_email VARCHAR(100) NOT NULL,
+    -- amount matched for every 1 donated, e.g. 1 for 1:1 and 0.5 for 1:2
+    ratio NUMERIC(6, 2) NOT NULL,
+    cap DECIMAL(12, 2) NOT NULL,
+    matched_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    created_by INT NULL,
+    -- set once the sponsor was emailed the statement of the closed pool
+    statement_sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CHECK (matched_amount <= cap),
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_matching_pools_campaign_id ON campaign_matching_pools (campaign_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS donation_matches (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    pool_id INT NOT NULL,
+    donation_id INT NOT NULL,
+    campaign_id INT NOT NULL,
+    donation_amount DECIMAL(10, 2) NOT NULL,
+    amount DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- a donation is matched at most once per pool, even when the payment
+    -- callback is delivered again
+    UNIQUE (pool_id, donation_id),
+    FOREIGN KEY(pool_id) REFERENCES campaign_matching_pools(id) ON DELETE CASCADE,
+    FOREIGN KEY(donation_id) REFERENCES donations(id) ON DELETE CASCADE,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS donation_dedications (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    donation_id INT NOT NULL UNIQUE,
+    type VARCHAR(10) NOT NULL, -- 'memory' or 'honor'
+    honoree_name VARCHAR(100) NOT NULL,
+    recipient_email VARCHAR(100) NULL,
+    message TEXT NULL,
+    -- the donor allows the dedication to show on the donor wall
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    ecard_sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(donation_id) REFERENCES donations(id) ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS campaign_locations (
+    campaign_id INT PRIMARY KEY,
+    province VARCHAR(60) NULL,
+    city VARCHAR(60) NULL,
+    latitude DOUBLE PRECISION NULL,
+    longitude DOUBLE PRECISION NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- coordinates are either both set or both empty
+    CHECK ((latitude IS NULL) = (longitude IS NULL)),
+    CHECK (latitude BETWEEN -90 AND 90),
+    CHECK (longitude BETWEEN -180 AND 180),
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index for the bounding box of the radius search
+CREATE INDEX IF NOT EXISTS idx_campaign_locations_coordinates ON campaign_locations (latitude, longitude) WHERE latitude IS NOT NULL;
+
+-- add index for the region filter, regions are matched case-insensitively
+CREATE INDEX IF NOT EXISTS idx_campaign_locations_region ON campaign_locations (LOWER(province), LOWER(city));
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS campaign_similarities (
+    campaign_id INT NOT NULL,
+    similar_campaign_id INT NOT NULL,
+    -- shared category and tags, text similarity and donor overlap
+    score NUMERIC(10, 4) NOT NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (campaign_id, similar_campaign_id),
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(similar_campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index for the ranking of a campaign's similar campaigns
+CREATE INDEX IF NOT EXISTS idx_campaign_similarities_score ON campaign_similarities (campaign_id, score DESC);
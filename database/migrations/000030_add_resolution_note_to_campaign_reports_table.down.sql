@@ -0,0 +1 @@
+ALTER TABLE campaign_reports DROP COLUMN IF EXISTS resolution_note;
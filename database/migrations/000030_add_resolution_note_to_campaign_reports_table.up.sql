@@ -0,0 +1,2 @@
+-- the note the admin gave when resolving the report
+ALTER TABLE campaign_reports ADD COLUMN IF NOT EXISTS resolution_note TEXT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE campaign_matching_pools DROP COLUMN IF EXISTS confirmed_at;
+ALTER TABLE campaign_matching_pools DROP COLUMN IF EXISTS confirmation_token;
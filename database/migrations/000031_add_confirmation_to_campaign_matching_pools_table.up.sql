@@ -0,0 +1,6 @@
+-- emailed to the sponsor, a pool only matches once the sponsor confirmed it
+ALTER TABLE campaign_matching_pools ADD COLUMN IF NOT EXISTS confirmation_token VARCHAR(64) NULL UNIQUE;
+ALTER TABLE campaign_matching_pools ADD COLUMN IF NOT EXISTS confirmed_at TIMESTAMP NULL;
+
+-- the pools created before keep matching
+UPDATE campaign_matching_pools SET confirmed_at = created_at;
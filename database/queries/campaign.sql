@@ -10,47 +10,163 @@ SELECT id, title, images,
 	   	   	WHEN status = 2 THEN 'Active'
 	   	   	WHEN status = 3 THEN 'Completed'
 	   	   	WHEN status = 4 THEN 'Cancelled'
+	   	   	WHEN status = 5 THEN 'Pending Review'
+	   	   	WHEN status = 6 THEN 'Rejected'
 	   	   ELSE 'Unknown'
-	   END AS status_label
+	   END AS status_label,
+	   CASE
+		   WHEN sqlc.narg('query')::text IS NULL THEN 0
+		   ELSE ts_rank_cd(search_vector, websearch_to_tsquery('simple', sqlc.narg('query')::text)) + word_similarity(sqlc.narg('query')::text, title)
+	   END::real AS rank,
+	   CASE
+		   WHEN sqlc.narg('query')::text IS NULL THEN ''
+		   ELSE ts_headline('simple', REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), websearch_to_tsquery('simple', sqlc.narg('query')::text), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')
+	   END::text AS title_highlight
 FROM campaigns
 WHERE 
-	user_id = $1 AND
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
 	deleted_at IS NULL AND
-	(sqlc.narg('title')::text IS NULL OR title ILIKE '%' || sqlc.narg('title')::text || '%') AND
+	(
+		sqlc.narg('query')::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', sqlc.narg('query')::text) OR
+		sqlc.narg('query')::text <% title
+	) AND
     (sqlc.narg('status')::integer IS NULL OR status = sqlc.narg('status')::integer)
-ORDER BY start_date DESC
+ORDER BY rank DESC, start_date DESC
 LIMIT $2 OFFSET $3;
 
+-- name: GetUserCampaignsAfterCursor :many
+SELECT id, title, images,
+	   CASE 
+		   WHEN target_amount = 0 THEN 0 
+		   ELSE current_amount / target_amount  * 100
+	   END::DECIMAL(10, 2) AS progress, 
+	   start_date, end_date, status,
+	   CASE
+	   	   	WHEN status = 1 THEN 'Draft'
+	   	   	WHEN status = 2 THEN 'Active'
+	   	   	WHEN status = 3 THEN 'Completed'
+	   	   	WHEN status = 4 THEN 'Cancelled'
+	   	   	WHEN status = 5 THEN 'Pending Review'
+	   	   	WHEN status = 6 THEN 'Rejected'
+	   	   ELSE 'Unknown'
+	   END AS status_label,
+	   CASE
+		   WHEN sqlc.narg('query')::text IS NULL THEN 0
+		   ELSE ts_rank_cd(search_vector, websearch_to_tsquery('simple', sqlc.narg('query')::text)) + word_similarity(sqlc.narg('query')::text, title)
+	   END::real AS rank,
+	   CASE
+		   WHEN sqlc.narg('query')::text IS NULL THEN ''
+		   ELSE ts_headline('simple', REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), websearch_to_tsquery('simple', sqlc.narg('query')::text), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')
+	   END::text AS title_highlight
+FROM campaigns
+WHERE 
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
+	deleted_at IS NULL AND
+	(
+		sqlc.narg('query')::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', sqlc.narg('query')::text) OR
+		sqlc.narg('query')::text <% title
+	) AND
+    (sqlc.narg('status')::integer IS NULL OR status = sqlc.narg('status')::integer) AND
+	(
+		sqlc.narg('cursor_id')::integer IS NULL OR
+		(start_date, id) < (sqlc.narg('cursor_start_date')::timestamp, sqlc.narg('cursor_id')::integer)
+	)
+ORDER BY start_date DESC, id DESC
+LIMIT $2;
+
+-- name: GetUserCampaignsBeforeCursor :many
+SELECT id, title, images,
+	   CASE 
+		   WHEN target_amount = 0 THEN 0 
+		   ELSE current_amount / target_amount  * 100
+	   END::DECIMAL(10, 2) AS progress, 
+	   start_date, end_date, status,
+	   CASE
+	   	   	WHEN status = 1 THEN 'Draft'
+	   	   	WHEN status = 2 THEN 'Active'
+	   	   	WHEN status = 3 THEN 'Completed'
+	   	   	WHEN status = 4 THEN 'Cancelled'
+	   	   	WHEN status = 5 THEN 'Pending Review'
+	   	   	WHEN status = 6 THEN 'Rejected'
+	   	   ELSE 'Unknown'
+	   END AS status_label,
+	   CASE
+		   WHEN sqlc.narg('query')::text IS NULL THEN 0
+		   ELSE ts_rank_cd(search_vector, websearch_to_tsquery('simple', sqlc.narg('query')::text)) + word_similarity(sqlc.narg('query')::text, title)
+	   END::real AS rank,
+	   CASE
+		   WHEN sqlc.narg('query')::text IS NULL THEN ''
+		   ELSE ts_headline('simple', REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), websearch_to_tsquery('simple', sqlc.narg('query')::text), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')
+	   END::text AS title_highlight
+FROM campaigns
+WHERE 
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
+	deleted_at IS NULL AND
+	(
+		sqlc.narg('query')::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', sqlc.narg('query')::text) OR
+		sqlc.narg('query')::text <% title
+	) AND
+    (sqlc.narg('status')::integer IS NULL OR status = sqlc.narg('status')::integer) AND
+	(start_date, id) > (sqlc.arg('cursor_start_date')::timestamp, sqlc.arg('cursor_id')::integer)
+ORDER BY start_date ASC, id ASC
+LIMIT $2;
+
 -- name: GetTotalUserCampaigns :one
 SELECT COUNT(*) AS total
 FROM campaigns
 WHERE 
-	user_id = $1 AND
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
 	deleted_at IS NULL AND
-	(sqlc.narg('title')::text IS NULL OR title ILIKE '%' || sqlc.narg('title')::text || '%') AND
+	(
+		sqlc.narg('query')::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', sqlc.narg('query')::text) OR
+		sqlc.narg('query')::text <% title
+	) AND
     (sqlc.narg('status')::integer IS NULL OR status = sqlc.narg('status')::integer);
 
 -- name: GetUserCampaignById :one
-SELECT id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, status, images,
-	   created_at::TIMESTAMP, updated_at::TIMESTAMP
-FROM campaigns
-WHERE id = $1 AND user_id = $2;
+SELECT c.id, c.title, c.description, c.slug, c.user_id, c.target_amount, c.current_amount, c.start_date, c.end_date, c.status, c.images, c.tags, c.category,
+	   c.created_at::TIMESTAMP, c.updated_at::TIMESTAMP, c.approved_at, cm.role AS member_role
+FROM campaigns c
+JOIN campaign_members cm ON cm.campaign_id = c.id AND cm.user_id = $2 AND cm.status = 2
+WHERE c.id = $1 AND c.deleted_at IS NULL;
 
 -- name: CreateCampaign :one
-INSERT INTO campaigns (title, description, slug, user_id, target_amount, start_date, end_date, status, images, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+INSERT INTO campaigns (title, description, slug, user_id, target_amount, start_date, end_date, status, images, tags, category, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5::numeric, $6, $7, $8, $9, $10, $11, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 RETURNING *;
 
 -- name: UpdateCampaign :one
 UPDATE campaigns
-SET title = $1, description = $2, slug = $3, target_amount = $4, start_date = $5, end_date = $6, status = $7, updated_at = CURRENT_TIMESTAMP, images = $9
-WHERE id = $8 AND user_id = $10
-RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, images, status, created_at::TIMESTAMP, updated_at::TIMESTAMP;
+SET title = $1, description = $2, slug = $3, target_amount = $4::numeric, start_date = $5, end_date = $6, status = $7, updated_at = CURRENT_TIMESTAMP, images = $9, tags = $11, category = $12
+WHERE id = $8 AND EXISTS (
+	SELECT 1 FROM campaign_members cm
+	WHERE cm.campaign_id = campaigns.id AND cm.user_id = $10 AND cm.status = 2 AND cm.role IN (1, 2)
+)
+RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, images, tags, category, status, created_at::TIMESTAMP, updated_at::TIMESTAMP;
 
 -- name: SoftDeleteCampaign :one
 UPDATE campaigns
 SET deleted_at = CURRENT_TIMESTAMP
-WHERE id = $1 AND user_id = $2
+WHERE id = $1 AND EXISTS (
+	SELECT 1 FROM campaign_members cm
+	WHERE cm.campaign_id = campaigns.id AND cm.user_id = $2 AND cm.status = 2 AND cm.role = 1
+)
 RETURNING *;
 
 -- name: GetCampaignBySlug :one
@@ -65,6 +181,10 @@ campaigns.current_amount::numeric as current_amount,
 campaigns.start_date, 
 campaigns.end_date,
 campaigns.status,
+campaigns.tags,
+campaigns.images,
+campaigns.category,
+campaigns.suspended_at,
 	users.name as user_name, users.email as user_email,
 	CASE 
 		WHEN campaigns.current_amount = 0 THEN 0 
@@ -72,40 +192,7 @@ campaigns.status,
 	END::numeric AS progress
 FROM campaigns
 JOIN users ON campaigns.user_id = users.id
-WHERE campaigns.slug = $1;
-
--- name: GetCampaigns :many
-SELECT id, title, slug,
-		current_amount::numeric, target_amount::numeric,
-	   CASE 
-		   WHEN target_amount = 0 THEN 0 
-		   ELSE current_amount /target_amount * 100  
-	   END::numeric AS progress, 
-	   start_date, end_date,
-	   CASE
-	   	   	WHEN status = 1 THEN 'Draft'
-	   	   	WHEN status = 2 THEN 'Active'
-	   	   	WHEN status = 3 THEN 'Completed'
-	   	   	WHEN status = 4 THEN 'Cancelled'
-	   	   ELSE 'Unknown'
-	   END AS status
-FROM campaigns
-WHERE 
-	deleted_at IS NULL AND
-	status = 2 AND
-	start_date <= CURRENT_TIMESTAMP AND
-	end_date >= CURRENT_TIMESTAMP
-ORDER BY start_date DESC
-LIMIT $1 OFFSET $2;
-
--- name: GetTotalCampaigns :one
-SELECT COUNT(*) AS total
-FROM campaigns
-WHERE 
-	deleted_at IS NULL AND
-	status = 2 AND
-	start_date <= CURRENT_TIMESTAMP AND
-	end_date >= CURRENT_TIMESTAMP;
+WHERE campaigns.slug = $1 AND campaigns.approved_at IS NOT NULL AND campaigns.deleted_at IS NULL;
 
 -- name: FindCampaignsBySlugForUpdate :one
 SELECT id, user_id, status FROM campaigns
@@ -123,12 +210,12 @@ SET current_amount = current_amount + sqlc.arg(amount)::numeric
 WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: CreateDonatur :one
-INSERT INTO donaturs (name, email, user_id, campaign_id)
-VALUES ($1, $2, $3, $4) RETURNING *;
+INSERT INTO donaturs (name, email, user_id, campaign_id, is_anonymous)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
 -- name: CreateDonation :one
-INSERT INTO donations (donatur_id, campaign_id, amount, note)
-VALUES ($1, $2, $3, $4) RETURNING *;
+INSERT INTO donations (donatur_id, campaign_id, amount, note, fundraiser_id)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
 -- name: GetPaymentByTransactionId :one
 SELECT * FROM payments WHERE transaction_id = $1;
@@ -153,9 +240,17 @@ RETURNING *;
 -- name: GetPaginatedDonaturs :many
 SELECT 
 	d.id, 
-	d.name, 
-	COALESCE(d.email, '') AS email,
-	p.amount::numeric AS total_donated
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END)::text AS name, 
+	(CASE WHEN d.is_anonymous THEN '' ELSE COALESCE(d.email, '') END)::text AS email,
+	p.amount::numeric AS total_donated,
+	COALESCE((
+		SELECT (CASE dd.type WHEN 'memory' THEN 'In memory of ' ELSE 'In honor of ' END) || dd.honoree_name
+		FROM donation_dedications dd
+		JOIN donations dn ON dn.id = dd.donation_id
+		WHERE dn.donatur_id = d.id AND dd.is_public
+		ORDER BY dd.id DESC
+		LIMIT 1
+	), '')::text AS dedication
 FROM donaturs d
 JOIN (
 	SELECT donatur_id, SUM(amount) AS amount
@@ -172,6 +267,73 @@ AND EXISTS (
 ORDER BY d.created_at DESC
 LIMIT $2 OFFSET $3;
 
+-- name: GetDonatursAfterCursor :many
+SELECT 
+	d.id, 
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END)::text AS name, 
+	(CASE WHEN d.is_anonymous THEN '' ELSE COALESCE(d.email, '') END)::text AS email,
+	p.amount::numeric AS total_donated,
+	COALESCE((
+		SELECT (CASE dd.type WHEN 'memory' THEN 'In memory of ' ELSE 'In honor of ' END) || dd.honoree_name
+		FROM donation_dedications dd
+		JOIN donations dn ON dn.id = dd.donation_id
+		WHERE dn.donatur_id = d.id AND dd.is_public
+		ORDER BY dd.id DESC
+		LIMIT 1
+	), '')::text AS dedication,
+	d.created_at::timestamp AS created_at
+FROM donaturs d
+JOIN (
+	SELECT donatur_id, SUM(amount) AS amount
+	FROM donations
+	GROUP BY donatur_id
+) p ON d.id = p.donatur_id
+WHERE d.campaign_id = (
+	SELECT id FROM campaigns WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+)
+AND EXISTS (
+	SELECT 1 FROM payments
+	WHERE status = 5 AND donatur_id = d.id
+)
+AND (
+	sqlc.narg('cursor_id')::integer IS NULL OR
+	(d.created_at, d.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::integer)
+)
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT $2;
+
+-- name: GetDonatursBeforeCursor :many
+SELECT 
+	d.id, 
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END)::text AS name, 
+	(CASE WHEN d.is_anonymous THEN '' ELSE COALESCE(d.email, '') END)::text AS email,
+	p.amount::numeric AS total_donated,
+	COALESCE((
+		SELECT (CASE dd.type WHEN 'memory' THEN 'In memory of ' ELSE 'In honor of ' END) || dd.honoree_name
+		FROM donation_dedications dd
+		JOIN donations dn ON dn.id = dd.donation_id
+		WHERE dn.donatur_id = d.id AND dd.is_public
+		ORDER BY dd.id DESC
+		LIMIT 1
+	), '')::text AS dedication,
+	d.created_at::timestamp AS created_at
+FROM donaturs d
+JOIN (
+	SELECT donatur_id, SUM(amount) AS amount
+	FROM donations
+	GROUP BY donatur_id
+) p ON d.id = p.donatur_id
+WHERE d.campaign_id = (
+	SELECT id FROM campaigns WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+)
+AND EXISTS (
+	SELECT 1 FROM payments
+	WHERE status = 5 AND donatur_id = d.id
+)
+AND (d.created_at, d.id) > (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::integer)
+ORDER BY d.created_at ASC, d.id ASC
+LIMIT $2;
+
 -- name: GetCampaignTotalPaidDonaturs :one
 SELECT COUNT(*) AS total FROM donaturs
 WHERE donaturs.campaign_id IN (
@@ -197,4 +359,912 @@ WHERE id = $2;
 SELECT * FROM payments WHERE transaction_id = $1 FOR UPDATE;
 
 -- name: FindAndLockDonationForUpdate :one
-SELECT * FROM donations WHERE id = $1 FOR UPDATE;
\ No newline at end of file
+SELECT * FROM donations WHERE id = $1 FOR UPDATE;
+-- name: GetCampaignRewardTiers :many
+SELECT * FROM reward_tiers
+WHERE campaign_id = $1 AND deleted_at IS NULL
+ORDER BY min_amount ASC, id ASC;
+
+-- name: GetRewardTierById :one
+SELECT * FROM reward_tiers
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL;
+
+-- name: CreateRewardTier :one
+INSERT INTO reward_tiers (campaign_id, title, description, min_amount, quantity, requires_shipping)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: UpdateRewardTier :one
+UPDATE reward_tiers
+SET title = $3, description = $4, min_amount = $5, quantity = $6, requires_shipping = $7, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteRewardTier :execrows
+UPDATE reward_tiers
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL;
+
+-- name: FindRewardTierForUpdate :one
+SELECT * FROM reward_tiers
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: ReserveRewardTier :exec
+UPDATE reward_tiers SET reserved = reserved + 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ClaimRewardTier :exec
+UPDATE reward_tiers SET reserved = reserved - 1, claimed = claimed + 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ReleaseRewardTier :exec
+UPDATE reward_tiers SET reserved = reserved - 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ClaimReleasedRewardTier :execrows
+UPDATE reward_tiers SET claimed = claimed + 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND (quantity IS NULL OR reserved + claimed < quantity);
+
+-- name: CreateDonationReward :one
+INSERT INTO donation_rewards (donation_id, reward_tier_id, campaign_id, status, shipping_name, shipping_phone, shipping_address)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: FindAndLockDonationRewardForUpdate :one
+SELECT * FROM donation_rewards WHERE donation_id = $1 FOR UPDATE;
+
+-- name: UpdateDonationRewardStatus :exec
+UPDATE donation_rewards SET status = $1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $2;
+
+-- name: GetRewardFulfilments :many
+SELECT
+	dr.id,
+	dr.reward_tier_id,
+	rt.title AS reward_title,
+	dn.name AS donatur_name,
+	COALESCE(dn.email, '') AS donatur_email,
+	d.amount::numeric AS amount,
+	COALESCE(dr.shipping_name, '') AS shipping_name,
+	COALESCE(dr.shipping_phone, '') AS shipping_phone,
+	COALESCE(dr.shipping_address, '') AS shipping_address,
+	dr.fulfilled_at,
+	dr.created_at::timestamp AS created_at
+FROM donation_rewards dr
+JOIN reward_tiers rt ON rt.id = dr.reward_tier_id
+JOIN donations d ON d.id = dr.donation_id
+JOIN donaturs dn ON dn.id = d.donatur_id
+WHERE dr.campaign_id = $1 AND dr.status = 2
+AND (sqlc.narg('reward_tier_id')::integer IS NULL OR dr.reward_tier_id = sqlc.narg('reward_tier_id')::integer)
+AND (sqlc.narg('fulfilled')::boolean IS NULL OR (dr.fulfilled_at IS NOT NULL) = sqlc.narg('fulfilled')::boolean)
+ORDER BY dr.created_at ASC, dr.id ASC
+LIMIT $2 OFFSET $3;
+
+-- name: GetTotalRewardFulfilments :one
+SELECT COUNT(*) AS total
+FROM donation_rewards dr
+WHERE dr.campaign_id = $1 AND dr.status = 2
+AND (sqlc.narg('reward_tier_id')::integer IS NULL OR dr.reward_tier_id = sqlc.narg('reward_tier_id')::integer)
+AND (sqlc.narg('fulfilled')::boolean IS NULL OR (dr.fulfilled_at IS NOT NULL) = sqlc.narg('fulfilled')::boolean);
+
+-- name: MarkDonationRewardFulfilled :execrows
+UPDATE donation_rewards
+SET fulfilled_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND status = 2 AND fulfilled_at IS NULL;
+
+-- name: GetCampaignMilestones :many
+SELECT
+	m.id,
+	m.amount::numeric AS amount,
+	m.description,
+	(m.amount > c.target_amount)::boolean AS stretch,
+	m.reached_at
+FROM campaign_milestones m
+JOIN campaigns c ON c.id = m.campaign_id
+WHERE m.campaign_id = $1
+ORDER BY m.amount ASC;
+
+-- name: DeleteCampaignMilestones :exec
+DELETE FROM campaign_milestones WHERE campaign_id = $1;
+
+-- name: CreateCampaignMilestone :exec
+INSERT INTO campaign_milestones (campaign_id, amount, description)
+VALUES ($1, $2, $3);
+
+-- name: MarkReachedMilestones :many
+UPDATE campaign_milestones m
+SET reached_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+FROM campaigns c
+WHERE m.campaign_id = c.id
+	AND m.campaign_id = $1
+	AND m.reached_at IS NULL
+	AND m.amount <= c.current_amount
+RETURNING m.id, m.amount::numeric AS amount, m.description, (m.amount > c.target_amount)::boolean AS stretch;
+
+-- name: GetCampaignOwnerContact :one
+SELECT c.title, c.slug, u.name AS owner_name, u.email AS owner_email
+FROM campaigns c
+JOIN users u ON u.id = c.user_id
+WHERE c.id = $1 AND c.deleted_at IS NULL;
+
+-- name: CreateCampaignOwner :exec
+INSERT INTO campaign_members (campaign_id, user_id, email, role, status, accepted_at)
+SELECT $1, u.id, u.email, 1, 2, CURRENT_TIMESTAMP
+FROM users u
+WHERE u.id = $2;
+
+-- name: GetCampaignMembers :many
+SELECT cm.id, cm.user_id, cm.email, COALESCE(u.name, '')::text AS name, cm.role, cm.status, cm.accepted_at, cm.created_at::TIMESTAMP
+FROM campaign_members cm
+LEFT JOIN users u ON u.id = cm.user_id
+WHERE cm.campaign_id = $1 AND cm.status <> 3
+ORDER BY cm.role ASC, cm.id ASC;
+
+-- name: InviteCampaignMember :one
+INSERT INTO campaign_members (campaign_id, email, role, status, token, invited_by)
+VALUES ($1, $2, $3, 1, $4, $5)
+ON CONFLICT (campaign_id, email) DO UPDATE
+SET role = EXCLUDED.role, status = 1, token = EXCLUDED.token, invited_by = EXCLUDED.invited_by, updated_at = CURRENT_TIMESTAMP
+WHERE campaign_members.status <> 2
+RETURNING *;
+
+-- name: UpdateCampaignMemberRole :execrows
+UPDATE campaign_members SET role = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND role <> 1;
+
+-- name: DeleteCampaignMember :execrows
+DELETE FROM campaign_members
+WHERE id = $1 AND campaign_id = $2 AND role <> 1;
+
+-- name: GetPendingInvitations :many
+SELECT cm.id, cm.campaign_id, c.title AS campaign_title, cm.role, cm.token, cm.created_at::TIMESTAMP
+FROM campaign_members cm
+JOIN campaigns c ON c.id = cm.campaign_id
+WHERE cm.email = $1 AND cm.status = 1 AND c.deleted_at IS NULL
+ORDER BY cm.id DESC;
+
+-- name: FindInvitationByToken :one
+SELECT * FROM campaign_members
+WHERE token = $1 AND status = 1;
+
+-- name: AcceptInvitation :execrows
+UPDATE campaign_members
+SET user_id = $2, status = 2, token = NULL, accepted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 1;
+
+-- name: DeclineInvitation :execrows
+UPDATE campaign_members
+SET status = 3, token = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 1;
+
+-- name: GetUserEmail :one
+SELECT email FROM users WHERE id = $1;
+
+-- name: IsUserAdmin :one
+SELECT is_admin FROM users WHERE id = $1;
+
+-- name: GetReviewQueue :many
+SELECT c.id, c.title, c.slug, c.description, c.target_amount::numeric AS target_amount, c.start_date, c.end_date, c.images, c.tags,
+	   u.id AS owner_id, u.name AS owner_name, u.email AS owner_email,
+	   c.updated_at::TIMESTAMP AS submitted_at
+FROM campaigns c
+JOIN users u ON u.id = c.user_id
+WHERE c.status = 5 AND c.deleted_at IS NULL
+ORDER BY c.updated_at ASC, c.id ASC
+LIMIT $1 OFFSET $2;
+
+-- name: GetTotalReviewQueue :one
+SELECT COUNT(*) AS total
+FROM campaigns
+WHERE status = 5 AND deleted_at IS NULL;
+
+-- name: FindCampaignForReview :one
+SELECT c.id, c.title, c.status, u.email AS owner_email
+FROM campaigns c
+JOIN users u ON u.id = c.user_id
+WHERE c.id = $1 AND c.deleted_at IS NULL
+FOR UPDATE OF c;
+
+-- name: ApproveCampaign :exec
+UPDATE campaigns
+SET status = 2, approved_at = COALESCE(approved_at, CURRENT_TIMESTAMP), updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: RejectCampaign :exec
+UPDATE campaigns
+SET status = 6, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateCampaignReview :exec
+INSERT INTO campaign_reviews (campaign_id, reviewer_id, decision, reason)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetCampaignReviews :many
+SELECT r.id, r.decision, r.reason, COALESCE(u.name, '')::text AS reviewer_name, r.created_at::TIMESTAMP
+FROM campaign_reviews r
+LEFT JOIN users u ON u.id = r.reviewer_id
+WHERE r.campaign_id = $1
+ORDER BY r.id DESC;
+
+-- name: CreateCampaignReport :execrows
+INSERT INTO campaign_reports (campaign_id, reporter_key, category, description)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (campaign_id, reporter_key) DO NOTHING;
+
+-- name: CountOpenCampaignReports :one
+SELECT COUNT(*) AS total
+FROM campaign_reports
+WHERE campaign_id = $1 AND status = 1;
+
+-- name: SuspendCampaign :execrows
+UPDATE campaigns
+SET suspended_at = CURRENT_TIMESTAMP, suspension_reason = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND suspended_at IS NULL;
+
+-- name: UpholdCampaignSuspension :exec
+UPDATE campaigns
+SET suspended_at = COALESCE(suspended_at, CURRENT_TIMESTAMP), suspension_reason = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: LiftReportSuspension :execrows
+UPDATE campaigns
+SET suspended_at = NULL, suspension_reason = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND suspended_at IS NOT NULL
+	-- a suspension an admin upheld stays in place
+	AND NOT EXISTS (
+		SELECT 1 FROM campaign_reports r
+		WHERE r.campaign_id = campaigns.id AND r.status = 3
+	);
+
+-- name: GetReportedCampaigns :many
+SELECT c.id, c.title, c.slug, c.suspended_at, c.suspension_reason,
+	   COUNT(*) FILTER (WHERE r.status = 1) AS open_reports,
+	   COUNT(*) AS total_reports,
+	   array_agg(DISTINCT r.category)::text[] AS categories,
+	   MAX(r.created_at)::TIMESTAMP AS last_reported_at
+FROM campaigns c
+JOIN campaign_reports r ON r.campaign_id = c.id
+WHERE c.deleted_at IS NULL
+GROUP BY c.id
+HAVING COUNT(*) FILTER (WHERE r.status = 1) > 0
+ORDER BY open_reports DESC, last_reported_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: GetTotalReportedCampaigns :one
+SELECT COUNT(DISTINCT r.campaign_id) AS total
+FROM campaign_reports r
+JOIN campaigns c ON c.id = r.campaign_id
+WHERE r.status = 1 AND c.deleted_at IS NULL;
+
+-- name: GetCampaignReports :many
+SELECT id, category, description, status, resolution_note, resolved_at, created_at::TIMESTAMP
+FROM campaign_reports
+WHERE campaign_id = $1
+ORDER BY id DESC;
+
+-- name: ResolveCampaignReports :execrows
+UPDATE campaign_reports
+SET status = $2, resolved_by = $3, resolution_note = $4, resolved_at = CURRENT_TIMESTAMP
+WHERE campaign_id = $1 AND status = 1;
+
+-- name: GetCampaignDailyDonations :many
+SELECT d.day::date AS day, COUNT(p.id) AS donations, COALESCE(SUM(p.amount), 0)::numeric AS total
+FROM generate_series(sqlc.arg('from_date')::date, sqlc.arg('to_date')::date, interval '1 day') AS d(day)
+LEFT JOIN payments p ON p.campaign_id = sqlc.arg('campaign_id')
+	AND p.status = 5
+	AND COALESCE(p.payment_date, p.updated_at)::date = d.day::date
+GROUP BY d.day
+ORDER BY d.day;
+
+-- name: GetCampaignDonationFunnel :one
+SELECT COUNT(dn.id) AS intents,
+	   COUNT(p.id) FILTER (WHERE p.link IS NOT NULL) AS invoices,
+	   COUNT(p.id) FILTER (WHERE p.status = 5) AS paid
+FROM donations dn
+LEFT JOIN payments p ON p.donation_id = dn.id
+WHERE dn.campaign_id = sqlc.arg('campaign_id')
+	AND dn.created_at >= sqlc.arg('from_date')::date
+	AND dn.created_at < sqlc.arg('to_date')::date + 1;
+
+-- name: GetCampaignDonationSummary :one
+SELECT COUNT(*) AS donations,
+	   COALESCE(SUM(amount), 0)::numeric AS total,
+	   COALESCE(AVG(amount), 0)::numeric AS average,
+	   COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY amount), 0)::numeric AS median,
+	   COALESCE(MIN(amount), 0)::numeric AS smallest,
+	   COALESCE(MAX(amount), 0)::numeric AS largest
+FROM payments
+WHERE campaign_id = sqlc.arg('campaign_id')
+	AND status = 5
+	AND COALESCE(payment_date, updated_at) >= sqlc.arg('from_date')::date
+	AND COALESCE(payment_date, updated_at) < sqlc.arg('to_date')::date + 1;
+
+-- name: GetCampaignPaymentMethods :many
+SELECT COALESCE(method, 'unknown')::text AS method, COUNT(*) AS donations, SUM(amount)::numeric AS total
+FROM payments
+WHERE campaign_id = sqlc.arg('campaign_id')
+	AND status = 5
+	AND COALESCE(payment_date, updated_at) >= sqlc.arg('from_date')::date
+	AND COALESCE(payment_date, updated_at) < sqlc.arg('to_date')::date + 1
+GROUP BY 1
+ORDER BY total DESC;
+
+-- name: GetCampaignTopDonors :many
+SELECT
+	(CASE WHEN d.is_anonymous THEN 0 ELSE d.user_id END)::int AS user_id,
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE MAX(d.name) END)::text AS name,
+	d.is_anonymous,
+	COUNT(p.id) AS donations,
+	SUM(p.amount)::numeric AS total
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+WHERE p.campaign_id = sqlc.arg('campaign_id')
+	AND p.status = 5
+	AND COALESCE(p.payment_date, p.updated_at) >= sqlc.arg('from_date')::date
+	AND COALESCE(p.payment_date, p.updated_at) < sqlc.arg('to_date')::date + 1
+GROUP BY d.user_id, d.is_anonymous
+ORDER BY total DESC, d.user_id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CreateCampaignRevision :one
+INSERT INTO campaign_revisions (campaign_id, revision, author_id, title, description, slug, target_amount, start_date, end_date, status, images, tags)
+SELECT c.id, COALESCE((SELECT MAX(r.revision) FROM campaign_revisions r WHERE r.campaign_id = c.id), 0) + 1, sqlc.arg('author_id'),
+	c.title, c.description, c.slug, c.target_amount, c.start_date, c.end_date, c.status, c.images, c.tags
+FROM campaigns c
+WHERE c.id = sqlc.arg('campaign_id')
+RETURNING revision;
+
+-- name: GetCampaignRevisions :many
+SELECT r.id, r.revision, r.title, r.status, r.author_id, COALESCE(u.name, '')::text AS author_name, r.created_at
+FROM campaign_revisions r
+LEFT JOIN users u ON u.id = r.author_id
+WHERE r.campaign_id = $1
+ORDER BY r.revision DESC;
+
+-- name: GetCampaignRevision :one
+SELECT r.id, r.revision, r.title, r.description, r.slug, r.target_amount, r.start_date, r.end_date, r.status, r.images, r.tags,
+	r.author_id, COALESCE(u.name, '')::text AS author_name, r.created_at
+FROM campaign_revisions r
+LEFT JOIN users u ON u.id = r.author_id
+WHERE r.campaign_id = $1 AND r.revision = $2;
+
+-- name: GetLatestCampaignRevision :one
+SELECT revision, created_at FROM campaign_revisions
+WHERE campaign_id = $1
+ORDER BY revision DESC
+LIMIT 1;
+
+-- name: CreateCampaignPreview :one
+INSERT INTO campaign_previews (campaign_id, token, created_by, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, token, expires_at, created_at::TIMESTAMP;
+
+-- name: GetCampaignPreviews :many
+SELECT p.id, p.token, p.expires_at, p.revoked_at, COALESCE(u.name, '')::text AS created_by_name, p.created_at::TIMESTAMP
+FROM campaign_previews p
+LEFT JOIN users u ON u.id = p.created_by
+WHERE p.campaign_id = $1
+ORDER BY p.id DESC;
+
+-- name: RevokeCampaignPreview :execrows
+UPDATE campaign_previews
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND revoked_at IS NULL;
+
+-- name: FindCampaignByPreviewToken :one
+SELECT 
+campaigns.id, 
+campaigns.user_id as user_id, 
+campaigns.title, 
+campaigns.description, 
+campaigns.slug, 
+campaigns.target_amount::numeric as target_amount, 
+campaigns.current_amount::numeric as current_amount, 
+campaigns.start_date, 
+campaigns.end_date,
+campaigns.status,
+campaigns.tags,
+campaigns.images,
+campaigns.category,
+campaigns.suspended_at,
+	users.name as user_name, users.email as user_email,
+	CASE 
+		WHEN campaigns.current_amount = 0 THEN 0 
+		ELSE campaigns.target_amount / campaigns.current_amount 
+	END::numeric AS progress
+FROM campaign_previews p
+JOIN campaigns ON campaigns.id = p.campaign_id
+JOIN users ON campaigns.user_id = users.id
+WHERE p.token = $1 AND p.revoked_at IS NULL AND p.expires_at > CURRENT_TIMESTAMP AND campaigns.deleted_at IS NULL;
+
+-- name: GetTakenSlugs :many
+SELECT slug FROM campaigns
+WHERE deleted_at IS NULL AND (slug = sqlc.arg('slug') OR slug LIKE sqlc.arg('slug') || '-%')
+UNION
+SELECT slug FROM campaign_slug_redirects
+WHERE slug = sqlc.arg('slug') OR slug LIKE sqlc.arg('slug') || '-%';
+
+-- name: CreateSlugRedirect :exec
+INSERT INTO campaign_slug_redirects (slug, campaign_id)
+VALUES ($1, $2)
+ON CONFLICT (slug) DO NOTHING;
+
+-- name: DeleteSlugRedirect :exec
+DELETE FROM campaign_slug_redirects WHERE slug = $1 AND campaign_id = $2;
+
+-- name: IsSlugRedirected :one
+SELECT EXISTS (
+	SELECT 1 FROM campaign_slug_redirects
+	WHERE slug = $1 AND campaign_id <> $2
+);
+
+-- name: FindRedirectedSlug :one
+SELECT c.slug AS current_slug FROM campaign_slug_redirects r
+JOIN campaigns c ON c.id = r.campaign_id
+WHERE r.slug = $1 AND c.deleted_at IS NULL AND c.approved_at IS NOT NULL;
+
+-- name: CreateShortLink :exec
+INSERT INTO campaign_short_links (campaign_id, code)
+VALUES ($1, $2)
+ON CONFLICT (campaign_id) DO NOTHING;
+
+-- name: GetShortLinkByCampaign :one
+SELECT code, clicks FROM campaign_short_links
+WHERE campaign_id = $1;
+
+-- name: VisitShortLink :one
+UPDATE campaign_short_links l
+SET clicks = l.clicks + 1
+FROM campaigns c
+WHERE l.code = $1 AND c.id = l.campaign_id AND c.deleted_at IS NULL AND c.approved_at IS NOT NULL
+RETURNING c.slug;
+
+-- name: CreateCampaignUpdate :one
+INSERT INTO campaign_updates (campaign_id, author_id, title, body)
+VALUES ($1, $2, $3, $4)
+RETURNING id, title, body, created_at::TIMESTAMP, updated_at::TIMESTAMP;
+
+-- name: GetCampaignUpdates :many
+SELECT u.id, u.title, u.body, COALESCE(users.name, '')::text AS author_name,
+	   u.created_at::TIMESTAMP, u.updated_at::TIMESTAMP
+FROM campaign_updates u
+LEFT JOIN users ON users.id = u.author_id
+WHERE u.campaign_id = $1 AND u.deleted_at IS NULL
+ORDER BY u.created_at DESC, u.id DESC
+LIMIT $2;
+
+-- name: DeleteCampaignUpdate :execrows
+UPDATE campaign_updates
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL;
+
+-- name: GetSitemapChanges :many
+SELECT id, slug, category, updated_at::TIMESTAMP,
+	   (deleted_at IS NULL AND approved_at IS NOT NULL AND suspended_at IS NULL AND status IN (2, 3))::boolean AS listed,
+	   GREATEST(updated_at, deleted_at)::TIMESTAMP AS changed_at
+FROM campaigns
+WHERE GREATEST(updated_at, deleted_at) >= sqlc.arg('since')::TIMESTAMP
+ORDER BY changed_at, id;
+
+-- name: GetCampaignLeaderboard :many
+SELECT
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE MAX(d.name) END)::text AS name,
+	d.is_anonymous,
+	COUNT(p.id) AS donations,
+	SUM(p.amount)::numeric AS total
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+WHERE p.campaign_id = sqlc.arg('campaign_id') AND p.status = 5
+GROUP BY d.user_id, d.is_anonymous
+ORDER BY total DESC, MIN(COALESCE(p.payment_date, p.updated_at)) ASC
+LIMIT sqlc.arg('limit');
+
+-- name: TryJobLock :one
+SELECT pg_try_advisory_xact_lock(hashtext(sqlc.arg('name')::text));
+
+-- name: RefreshTrendingScores :exec
+WITH recent AS (
+	SELECT
+		p.campaign_id,
+		SUM(
+			LN(1 + p.amount::float8 / 10000) *
+			POWER(0.5, EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - COALESCE(p.payment_date, p.updated_at))::float8 / 3600 / sqlc.arg('half_life_hours')::float8)
+		)::numeric(14, 4) AS score,
+		COUNT(p.id)::int AS recent_donations,
+		SUM(p.amount)::numeric AS recent_amount
+	FROM payments p
+	WHERE p.status = 5 AND COALESCE(p.payment_date, p.updated_at) >= sqlc.arg('since')::timestamp
+	GROUP BY p.campaign_id
+), stale AS (
+	DELETE FROM campaign_trending_scores t
+	WHERE NOT EXISTS (SELECT 1 FROM recent r WHERE r.campaign_id = t.campaign_id)
+)
+INSERT INTO campaign_trending_scores (campaign_id, score, recent_donations, recent_amount, computed_at)
+SELECT campaign_id, score, recent_donations, recent_amount, CURRENT_TIMESTAMP
+FROM recent
+ON CONFLICT (campaign_id) DO UPDATE
+SET
+	score = EXCLUDED.score,
+	recent_donations = EXCLUDED.recent_donations,
+	recent_amount = EXCLUDED.recent_amount,
+	computed_at = EXCLUDED.computed_at;
+
+-- name: CreateFundraiser :one
+INSERT INTO campaign_fundraisers (campaign_id, user_id, slug, title, story, target_amount)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetTakenFundraiserSlugs :many
+SELECT slug FROM campaign_fundraisers
+WHERE deleted_at IS NULL AND (slug = sqlc.arg('slug') OR slug LIKE sqlc.arg('slug') || '-%');
+
+-- name: GetFundraiserBySlug :one
+SELECT
+	f.id, f.slug, f.title, f.story,
+	f.target_amount::numeric AS target_amount,
+	f.current_amount::numeric AS current_amount,
+	f.created_at::timestamp AS created_at,
+	u.name AS owner_name,
+	c.id AS campaign_id, c.slug AS campaign_slug, c.title AS campaign_title,
+	(SELECT COUNT(*) FROM donations d JOIN payments p ON p.donation_id = d.id WHERE d.fundraiser_id = f.id AND p.status = 5) AS donations
+FROM campaign_fundraisers f
+JOIN campaigns c ON c.id = f.campaign_id
+JOIN users u ON u.id = f.user_id
+WHERE f.slug = $1 AND f.deleted_at IS NULL
+	AND c.deleted_at IS NULL AND c.approved_at IS NOT NULL AND c.suspended_at IS NULL;
+
+-- name: GetCampaignFundraisers :many
+SELECT
+	f.id, f.slug, f.title,
+	f.target_amount::numeric AS target_amount,
+	f.current_amount::numeric AS current_amount,
+	f.created_at::timestamp AS created_at,
+	u.name AS owner_name
+FROM campaign_fundraisers f
+JOIN users u ON u.id = f.user_id
+WHERE f.campaign_id = sqlc.arg('campaign_id') AND f.deleted_at IS NULL
+ORDER BY f.current_amount DESC, f.created_at ASC, f.id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: GetUserFundraisers :many
+SELECT
+	f.id, f.slug, f.title, f.story,
+	f.target_amount::numeric AS target_amount,
+	f.current_amount::numeric AS current_amount,
+	f.created_at::timestamp AS created_at,
+	c.id AS campaign_id, c.slug AS campaign_slug, c.title AS campaign_title
+FROM campaign_fundraisers f
+JOIN campaigns c ON c.id = f.campaign_id
+WHERE f.user_id = $1 AND f.deleted_at IS NULL
+ORDER BY f.created_at DESC, f.id DESC;
+
+-- name: UpdateFundraiser :one
+UPDATE campaign_fundraisers
+SET title = $3, story = $4, target_amount = $5, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteFundraiser :execrows
+UPDATE campaign_fundraisers
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
+
+-- name: FindFundraiserForDonation :one
+SELECT f.id FROM campaign_fundraisers f
+JOIN campaigns c ON c.id = f.campaign_id
+WHERE f.slug = $1 AND f.campaign_id = $2 AND f.deleted_at IS NULL
+	AND c.deleted_at IS NULL AND c.suspended_at IS NULL AND c.status = 2;
+
+-- name: IncreaseFundraiserCurrentAmount :exec
+UPDATE campaign_fundraisers f
+SET current_amount = f.current_amount + sqlc.arg('amount')::numeric, updated_at = CURRENT_TIMESTAMP
+FROM donations d
+WHERE d.id = sqlc.arg('donation_id') AND f.id = d.fundraiser_id;
+
+-- name: CreateMatchingPool :one
+INSERT INTO campaign_matching_pools (campaign_id, sponsor_name, sponsor_email, ratio, cap, starts_at, ends_at, created_by, confirmation_token)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetCampaignMatchingPools :many
+SELECT * FROM campaign_matching_pools
+WHERE campaign_id = $1 AND deleted_at IS NULL
+ORDER BY starts_at ASC, id ASC;
+
+-- name: GetActiveMatchingPools :many
+SELECT id, sponsor_name, ratio::numeric AS ratio, cap::numeric AS cap, matched_amount::numeric AS matched_amount, starts_at, ends_at
+FROM campaign_matching_pools
+WHERE campaign_id = $1
+	AND deleted_at IS NULL
+	AND confirmed_at IS NOT NULL
+	AND starts_at <= CURRENT_TIMESTAMP
+	AND ends_at > CURRENT_TIMESTAMP
+	AND matched_amount < cap
+ORDER BY ends_at ASC, id ASC;
+
+-- name: GetMatchingPool :one
+SELECT * FROM campaign_matching_pools
+WHERE id = $1 AND campaign_id = $2;
+
+-- name: FindMatchingPoolsForUpdate :many
+SELECT id, ratio::numeric AS ratio, cap::numeric AS cap, matched_amount::numeric AS matched_amount
+FROM campaign_matching_pools
+WHERE campaign_id = sqlc.arg('campaign_id')
+	AND deleted_at IS NULL
+	AND confirmed_at IS NOT NULL
+	AND starts_at <= sqlc.arg('donated_at')::timestamp
+	AND ends_at > sqlc.arg('donated_at')::timestamp
+	AND matched_amount < cap
+ORDER BY created_at ASC, id ASC
+FOR UPDATE;
+
+-- name: CreateDonationMatch :execrows
+INSERT INTO donation_matches (pool_id, donation_id, campaign_id, donation_amount, amount)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (pool_id, donation_id) DO NOTHING;
+
+-- name: IncreaseMatchingPoolAmount :exec
+UPDATE campaign_matching_pools
+SET matched_amount = matched_amount + sqlc.arg('amount')::numeric, updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id');
+
+-- name: CancelMatchingPool :execrows
+UPDATE campaign_matching_pools
+SET deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL;
+
+-- name: GetPendingMatchingPool :one
+SELECT p.sponsor_name, p.ratio::numeric AS ratio, p.cap::numeric AS cap, p.starts_at, p.ends_at, c.title AS campaign_title
+FROM campaign_matching_pools p
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.confirmation_token = $1 AND p.deleted_at IS NULL;
+
+-- name: ConfirmMatchingPool :one
+UPDATE campaign_matching_pools
+SET confirmed_at = CURRENT_TIMESTAMP, confirmation_token = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE confirmation_token = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetMatchingPoolStatement :many
+SELECT
+	m.donation_id,
+	(CASE WHEN dr.is_anonymous THEN 'Anonymous' ELSE dr.name END)::text AS donor_name,
+	m.donation_amount::numeric AS donation_amount,
+	m.amount::numeric AS amount,
+	m.created_at::timestamp AS created_at
+FROM donation_matches m
+JOIN donations d ON d.id = m.donation_id
+JOIN donaturs dr ON dr.id = d.donatur_id
+WHERE m.pool_id = $1
+ORDER BY m.created_at ASC, m.id ASC;
+
+-- name: GetPoolsDueForStatement :many
+SELECT p.id, p.campaign_id, c.title AS campaign_title
+FROM campaign_matching_pools p
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.confirmed_at IS NOT NULL
+	AND p.statement_sent_at IS NULL
+	AND (
+		p.deleted_at IS NULL AND (p.ends_at <= CURRENT_TIMESTAMP OR p.matched_amount >= p.cap)
+		-- cancelled pools still owe what they matched before
+		OR p.deleted_at IS NOT NULL AND p.matched_amount > 0
+	)
+ORDER BY p.id ASC;
+
+-- name: MarkMatchingPoolStatementSent :exec
+UPDATE campaign_matching_pools
+SET statement_sent_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateDonationDedication :exec
+INSERT INTO donation_dedications (donation_id, type, honoree_name, recipient_email, message, is_public)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetUnsentECard :one
+SELECT
+	dd.id,
+	dd.type,
+	dd.honoree_name,
+	dd.recipient_email::text AS recipient_email,
+	COALESCE(dd.message, '')::text AS message,
+	(CASE WHEN dr.is_anonymous THEN 'Someone' ELSE dr.name END)::text AS donor_name,
+	c.title AS campaign_title,
+	c.slug AS campaign_slug
+FROM donation_dedications dd
+JOIN donations d ON d.id = dd.donation_id
+JOIN donaturs dr ON dr.id = d.donatur_id
+JOIN campaigns c ON c.id = d.campaign_id
+WHERE dd.donation_id = $1
+	AND dd.recipient_email IS NOT NULL
+	AND dd.ecard_sent_at IS NULL;
+
+-- name: GetUnsentECardDonations :many
+SELECT dd.donation_id
+FROM donation_dedications dd
+JOIN payments p ON p.donation_id = dd.donation_id
+WHERE dd.recipient_email IS NOT NULL
+	AND dd.ecard_sent_at IS NULL
+	AND p.status = 5
+	AND p.updated_at > sqlc.arg('paid_after')::timestamp
+	AND p.updated_at <= sqlc.arg('paid_before')::timestamp
+ORDER BY dd.id ASC
+LIMIT sqlc.arg('limit')::int;
+
+-- name: MarkECardSent :exec
+UPDATE donation_dedications
+SET ecard_sent_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: UpsertCampaignLocation :exec
+INSERT INTO campaign_locations (campaign_id, province, city, latitude, longitude)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (campaign_id) DO UPDATE
+SET province = EXCLUDED.province,
+	city = EXCLUDED.city,
+	latitude = EXCLUDED.latitude,
+	longitude = EXCLUDED.longitude,
+	updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteCampaignLocation :exec
+DELETE FROM campaign_locations WHERE campaign_id = $1;
+
+-- name: GetCampaignLocation :one
+SELECT * FROM campaign_locations WHERE campaign_id = $1;
+
+-- name: GetCampaignCategoryStats :many
+SELECT
+	COALESCE(c.category, 'other')::text AS category,
+	COUNT(*) FILTER (
+		WHERE c.suspended_at IS NULL
+			AND c.status = 2
+			AND c.start_date <= CURRENT_TIMESTAMP
+			AND c.end_date >= CURRENT_TIMESTAMP
+	) AS active_campaigns,
+	COUNT(*) FILTER (WHERE c.current_amount >= c.target_amount) AS funded_campaigns,
+	COALESCE(SUM(c.current_amount), 0)::numeric AS total_raised
+FROM campaigns c
+WHERE c.deleted_at IS NULL AND c.approved_at IS NOT NULL
+GROUP BY 1;
+
+-- name: GetDonationCategoryStats :many
+SELECT
+	COALESCE(c.category, 'other')::text AS category,
+	COUNT(p.id) AS donations,
+	COUNT(DISTINCT d.user_id) AS donors
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.status = 5 AND c.deleted_at IS NULL
+GROUP BY 1;
+
+-- name: GetPlatformDonorCount :one
+SELECT COUNT(DISTINCT d.user_id)
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.status = 5 AND c.deleted_at IS NULL;
+
+-- name: RefreshCampaignSimilarities :exec
+WITH listed AS (
+	SELECT
+		id, category, tags, title,
+		LEFT(COALESCE(description, ''), 500) AS description,
+		(suspended_at IS NULL AND status = 2 AND start_date <= CURRENT_TIMESTAMP AND end_date >= CURRENT_TIMESTAMP) AS active
+	FROM campaigns
+	WHERE deleted_at IS NULL AND approved_at IS NOT NULL AND status IN (2, 3)
+), donors AS (
+	SELECT DISTINCT p.campaign_id, d.user_id
+	FROM payments p
+	JOIN donaturs d ON d.id = p.donatur_id
+	WHERE p.status = 5
+), donor_counts AS (
+	SELECT campaign_id, COUNT(*) AS donors
+	FROM donors
+	GROUP BY campaign_id
+), overlap AS (
+	SELECT a.campaign_id, b.campaign_id AS similar_campaign_id, COUNT(*) AS shared
+	FROM donors a
+	JOIN donors b ON b.user_id = a.user_id AND b.campaign_id <> a.campaign_id
+	GROUP BY a.campaign_id, b.campaign_id
+), candidates AS (
+	-- only the pairs that can score, scoring every pair grows with the
+	-- square of the listed campaigns
+	SELECT s.id AS campaign_id, c.id AS similar_campaign_id
+	FROM listed s
+	JOIN listed c ON c.category = s.category
+	UNION
+	SELECT s.id, c.id
+	FROM listed s
+	JOIN listed c ON c.tags && s.tags
+	UNION
+	SELECT campaign_id, similar_campaign_id
+	FROM overlap
+	UNION
+	-- % is answered by idx_campaigns_title_trgm
+	SELECT s.id, c.id
+	FROM listed s
+	JOIN campaigns c ON c.title % s.title
+), scored AS (
+	-- a shared category counts 1, tags 2 (jaccard), titles 1.5 and
+	-- descriptions 1 (trigram similarity), donors 2 (share of the smaller
+	-- donor base that gave to both)
+	SELECT
+		s.id AS campaign_id,
+		c.id AS similar_campaign_id,
+		(
+			(CASE WHEN s.category = c.category THEN 1 ELSE 0 END) +
+			2 * COALESCE(
+				cardinality(ARRAY(SELECT UNNEST(s.tags) INTERSECT SELECT UNNEST(c.tags)))::float8 /
+				NULLIF(cardinality(ARRAY(SELECT UNNEST(s.tags) UNION SELECT UNNEST(c.tags))), 0),
+				0
+			) +
+			1.5 * similarity(s.title, c.title) +
+			similarity(s.description, c.description) +
+			2 * COALESCE(o.shared::float8 / NULLIF(LEAST(sd.donors, cd.donors), 0), 0)
+		)::numeric(10, 4) AS score
+	FROM candidates p
+	JOIN listed s ON s.id = p.campaign_id
+	JOIN listed c ON c.id = p.similar_campaign_id AND c.active AND c.id <> s.id
+	LEFT JOIN overlap o ON o.campaign_id = s.id AND o.similar_campaign_id = c.id
+	LEFT JOIN donor_counts sd ON sd.campaign_id = s.id
+	LEFT JOIN donor_counts cd ON cd.campaign_id = c.id
+), ranked AS (
+	SELECT campaign_id, similar_campaign_id, score
+	FROM (
+		SELECT *, ROW_NUMBER() OVER (PARTITION BY campaign_id ORDER BY score DESC, similar_campaign_id DESC) AS position
+		FROM scored
+		WHERE score >= sqlc.arg('min_score')::numeric
+	) r
+	WHERE position <= sqlc.arg('per_campaign')::int
+), stale AS (
+	DELETE FROM campaign_similarities cs
+	WHERE NOT EXISTS (
+		SELECT 1 FROM ranked r
+		WHERE r.campaign_id = cs.campaign_id AND r.similar_campaign_id = cs.similar_campaign_id
+	)
+)
+INSERT INTO campaign_similarities (campaign_id, similar_campaign_id, score, computed_at)
+SELECT campaign_id, similar_campaign_id, score, CURRENT_TIMESTAMP
+FROM ranked
+ON CONFLICT (campaign_id, similar_campaign_id) DO UPDATE
+SET
+	score = EXCLUDED.score,
+	computed_at = EXCLUDED.computed_at;
+
+-- name: GetSimilarCampaigns :many
+SELECT
+	c.id, c.title, c.slug, c.category,
+	c.current_amount::numeric AS current_amount,
+	c.target_amount::numeric AS target_amount,
+	(CASE WHEN c.target_amount = 0 THEN 0 ELSE c.current_amount / c.target_amount * 100 END)::numeric AS progress,
+	c.end_date,
+	s.score
+FROM campaign_similarities s
+JOIN campaigns c ON c.id = s.similar_campaign_id
+WHERE s.campaign_id = sqlc.arg('campaign_id')
+	-- scores are recomputed periodically, a campaign may have ended or been
+	-- suspended since
+	AND c.deleted_at IS NULL
+	AND c.suspended_at IS NULL
+	AND c.status = 2
+	AND c.start_date <= CURRENT_TIMESTAMP
+	AND c.end_date >= CURRENT_TIMESTAMP
+ORDER BY s.score DESC, c.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: NotifyDonationPaid :exec
+SELECT pg_notify(sqlc.arg('channel')::text, json_build_object(
+	'campaign_id', c.id,
+	'donation_id', sqlc.arg('donation_id')::int,
+	'donor_name', (CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END),
+	'anonymous', d.is_anonymous,
+	'amount', sqlc.arg('amount')::numeric,
+	'matched', sqlc.arg('matched')::numeric,
+	'current_amount', c.current_amount,
+	'target_amount', c.target_amount,
+	'paid_at', sqlc.arg('paid_at')::timestamptz
+)::text)
+FROM campaigns c
+JOIN donaturs d ON d.campaign_id = c.id
+WHERE c.id = sqlc.arg('campaign_id') AND d.id = sqlc.arg('donatur_id');
@@ -5,7 +5,8 @@ CREATE TABLE IF NOT EXISTS users (
     email VARCHAR(100) NOT NULL UNIQUE,
     password VARCHAR(255) NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_admin BOOLEAN NOT NULL DEFAULT FALSE
 
     -- id is the primary key and will auto-increment
     -- name is a unique field for user identification
@@ -16,6 +17,8 @@ CREATE TABLE IF NOT EXISTS users (
 
 
 -- campaigns table
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 CREATE TABLE IF NOT EXISTS campaigns (
     id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
     title VARCHAR(255) NOT NULL,
@@ -26,18 +29,28 @@ CREATE TABLE IF NOT EXISTS campaigns (
     current_amount DECIMAL(10, 2) DEFAULT 0.00,
     start_date TIMESTAMP NOT NULL,
     end_date TIMESTAMP NOT NULL,
-    status INT NOT NULL DEFAULT 0, -- 0: draft, 1: active, 2: completed, 3: cancelled
+    status INT NOT NULL DEFAULT 0, -- 1: draft, 2: active, 3: completed, 4: cancelled, 5: pending review, 6: rejected
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     deleted_at TIMESTAMP NULL,
     -- add images column
     images TEXT [] DEFAULT '{}' NOT NULL,
+    -- add search columns
+    tags TEXT [] DEFAULT '{}' NOT NULL,
+    search_vector TSVECTOR,
+    -- set the first time an admin approves the campaign
+    approved_at TIMESTAMP NULL,
+    -- a suspended campaign can't take donations
+    suspended_at TIMESTAMP NULL,
+    suspension_reason TEXT NULL,
+    -- one of the campaign categories, e.g. 'education', 'health', 'disaster'
+    category VARCHAR(30) NULL,
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
--- add unique constraint for user_id, slug and deleted_at combination (partial index)
-CREATE UNIQUE INDEX idx_campaigns_user_slug_deleted_at
-ON campaigns (user_id, slug)
+-- slugs are looked up globally, so they are unique among live campaigns
+CREATE UNIQUE INDEX idx_campaigns_slug_deleted_at
+ON campaigns (slug)
 WHERE deleted_at IS NULL;
 
 -- add index for status
@@ -48,6 +61,43 @@ CREATE INDEX idx_campaigns_start_date ON campaigns (start_date);
 
 -- add index for end_date
 CREATE INDEX idx_campaigns_end_date ON campaigns (end_date);
+
+-- keep search_vector in sync with title, tags and description
+CREATE OR REPLACE FUNCTION campaigns_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('simple', array_to_string(NEW.tags, ' ')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'C');
+
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_campaigns_search_vector
+BEFORE INSERT OR UPDATE OF title, description, tags ON campaigns
+FOR EACH ROW EXECUTE FUNCTION campaigns_search_vector_update();
+
+-- add full-text index for search_vector
+CREATE INDEX idx_campaigns_search_vector ON campaigns USING GIN (search_vector);
+
+-- add trigram index for typo tolerant title search
+CREATE INDEX idx_campaigns_title_trgm ON campaigns USING GIN (title gin_trgm_ops);
+
+-- add index for current_amount (most funded sort)
+CREATE INDEX IF NOT EXISTS idx_campaigns_current_amount ON campaigns (current_amount);
+
+-- add index for target_amount (target range filter)
+CREATE INDEX IF NOT EXISTS idx_campaigns_target_amount ON campaigns (target_amount);
+
+-- add index for category (category filter and feeds)
+CREATE INDEX IF NOT EXISTS idx_campaigns_category ON campaigns (category) WHERE deleted_at IS NULL;
+
+-- add index for the campaigns changed since a time (incremental sitemap)
+CREATE INDEX IF NOT EXISTS idx_campaigns_changed_at ON campaigns (GREATEST(updated_at, deleted_at));
+
+-- add composite index for the user campaign list cursor
+CREATE INDEX IF NOT EXISTS idx_campaigns_user_id_start_date_id ON campaigns (user_id, start_date, id);
 -- end of campaigns table
 
 
@@ -60,9 +110,14 @@ CREATE TABLE IF NOT EXISTS donaturs (
     email VARCHAR(100) NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- anonymous donors are listed without their name
+    is_anonymous BOOLEAN NOT NULL DEFAULT FALSE,
     FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
     FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
 );
+
+-- add composite index for the donatur list cursor
+CREATE INDEX IF NOT EXISTS idx_donaturs_campaign_id_created_at_id ON donaturs (campaign_id, created_at, id);
 -- end of donaturs table
 
 -- donations table
@@ -121,4 +176,357 @@ CREATE INDEX idx_payments_donatur_id ON payments (donatur_id);
 CREATE INDEX idx_payments_donation_id ON payments (donation_id);
 -- add index foreign key campaign_id
 CREATE INDEX idx_payments_campaign_id ON payments (campaign_id);
+-- add composite index for counting paid donors per campaign
+CREATE INDEX IF NOT EXISTS idx_payments_campaign_id_status ON payments (campaign_id, status);
 -- -- end of payments table
+
+-- start of reward_tiers table
+CREATE TABLE IF NOT EXISTS reward_tiers (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    description TEXT NULL,
+    min_amount DECIMAL(10, 2) NOT NULL,
+    quantity INT NULL, -- NULL means unlimited stock
+    reserved INT NOT NULL DEFAULT 0, -- held by donations waiting for payment
+    claimed INT NOT NULL DEFAULT 0, -- taken by paid donations
+    requires_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    CONSTRAINT chk_reward_tiers_stock CHECK (quantity IS NULL OR reserved + claimed <= quantity)
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_reward_tiers_campaign_id ON reward_tiers (campaign_id);
+-- end of reward_tiers table
+
+-- start of donation_rewards table
+CREATE TABLE IF NOT EXISTS donation_rewards (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    donation_id INT NOT NULL UNIQUE,
+    reward_tier_id INT NOT NULL,
+    campaign_id INT NOT NULL,
+    status INT NOT NULL DEFAULT 1, -- 1: reserved, 2: claimed, 3: released, 4: unavailable
+    shipping_name VARCHAR(100) NULL,
+    shipping_phone VARCHAR(20) NULL,
+    shipping_address TEXT NULL,
+    fulfilled_at TIMESTAMP NULL, -- date when the owner sent the reward
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(donation_id) REFERENCES donations(id) ON DELETE CASCADE,
+    FOREIGN KEY(reward_tier_id) REFERENCES reward_tiers(id) ON DELETE CASCADE,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add composite index for the fulfilment list
+CREATE INDEX IF NOT EXISTS idx_donation_rewards_campaign_id_status ON donation_rewards (campaign_id, status);
+-- add index foreign key reward_tier_id
+CREATE INDEX IF NOT EXISTS idx_donation_rewards_reward_tier_id ON donation_rewards (reward_tier_id);
+-- end of donation_rewards table
+
+-- start of campaign_milestones table
+CREATE TABLE IF NOT EXISTS campaign_milestones (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    reached_at TIMESTAMP NULL, -- date when current_amount first passed the amount
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    CONSTRAINT uq_campaign_milestones_amount UNIQUE (campaign_id, amount)
+);
+-- end of campaign_milestones table
+
+-- start of campaign_members table
+CREATE TABLE IF NOT EXISTS campaign_members (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    user_id INT NULL, -- set once the invitation is accepted
+    email VARCHAR(100) NOT NULL,
+    role INT NOT NULL, -- 1: owner, 2: editor, 3: viewer
+    status INT NOT NULL DEFAULT 1, -- 1: invited, 2: accepted, 3: declined
+    token VARCHAR(64) NULL UNIQUE, -- invitation token, cleared once answered
+    invited_by INT NULL,
+    accepted_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY(invited_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_campaign_members_email UNIQUE (campaign_id, email)
+);
+
+-- add composite index for the membership checks
+CREATE INDEX IF NOT EXISTS idx_campaign_members_user_id_campaign_id ON campaign_members (user_id, campaign_id);
+-- end of campaign_members table
+
+-- start of campaign_reviews table
+CREATE TABLE IF NOT EXISTS campaign_reviews (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    reviewer_id INT NULL,
+    decision INT NOT NULL, -- 1: approved, 2: rejected
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(reviewer_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_reviews_campaign_id ON campaign_reviews (campaign_id);
+-- end of campaign_reviews table
+
+-- start of campaign_reports table
+CREATE TABLE IF NOT EXISTS campaign_reports (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    reporter_key VARCHAR(64) NOT NULL, -- hash identifying the reporter, one report per reporter and campaign
+    category VARCHAR(30) NOT NULL, -- e.g., 'fraud', 'misleading', 'spam'
+    description TEXT NULL,
+    status INT NOT NULL DEFAULT 1, -- 1: open, 2: dismissed, 3: upheld
+    resolved_by INT NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- the note the admin gave when resolving the report
+    resolution_note TEXT NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(resolved_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_campaign_reports_reporter UNIQUE (campaign_id, reporter_key)
+);
+
+-- add composite index for counting open reports
+CREATE INDEX IF NOT EXISTS idx_campaign_reports_campaign_id_status ON campaign_reports (campaign_id, status);
+-- end of campaign_reports table
+
+-- campaign_revisions table
+CREATE TABLE IF NOT EXISTS campaign_revisions (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    revision INT NOT NULL, -- 1 is the campaign as it was created
+    author_id INT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    slug VARCHAR(255) NOT NULL,
+    target_amount DECIMAL(10, 2) NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    status INT NOT NULL,
+    images TEXT[] NOT NULL DEFAULT '{}',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(author_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_campaign_revisions_revision UNIQUE (campaign_id, revision)
+);
+-- end of campaign_revisions table
+
+-- campaign_previews table
+CREATE TABLE IF NOT EXISTS campaign_previews (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    token VARCHAR(64) NOT NULL UNIQUE, -- random token shared in the preview link
+    created_by INT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_previews_campaign_id ON campaign_previews (campaign_id);
+-- end of campaign_previews table
+
+-- campaign_slug_redirects table
+CREATE TABLE IF NOT EXISTS campaign_slug_redirects (
+    slug VARCHAR(255) PRIMARY KEY, -- a slug the campaign was renamed from
+    campaign_id INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_slug_redirects_campaign_id ON campaign_slug_redirects (campaign_id);
+-- end of campaign_slug_redirects table
+
+-- campaign_short_links table
+CREATE TABLE IF NOT EXISTS campaign_short_links (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL UNIQUE, -- one short link per campaign
+    code VARCHAR(16) NOT NULL UNIQUE,
+    clicks BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+-- end of campaign_short_links table
+
+-- campaign_updates table
+CREATE TABLE IF NOT EXISTS campaign_updates (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    author_id INT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(author_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index for the updates of a campaign, newest first
+CREATE INDEX IF NOT EXISTS idx_campaign_updates_campaign_id_created_at ON campaign_updates (campaign_id, created_at DESC) WHERE deleted_at IS NULL;
+-- end of campaign_updates table
+
+-- campaign_trending_scores table
+CREATE TABLE IF NOT EXISTS campaign_trending_scores (
+    campaign_id INT NOT NULL PRIMARY KEY,
+    -- donation velocity, recent donations weigh more than older ones
+    score NUMERIC(14, 4) NOT NULL,
+    recent_donations INT NOT NULL,
+    recent_amount DECIMAL(14, 2) NOT NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index for the trending ranking
+CREATE INDEX IF NOT EXISTS idx_campaign_trending_scores_score ON campaign_trending_scores (score DESC, campaign_id DESC);
+-- end of campaign_trending_scores table
+
+-- campaign_fundraisers table
+CREATE TABLE IF NOT EXISTS campaign_fundraisers (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    user_id INT NOT NULL,
+    slug VARCHAR(60) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    story TEXT NOT NULL,
+    target_amount DECIMAL(10, 2) NOT NULL,
+    -- the part of the parent campaign's current_amount raised through this page
+    current_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- fundraiser slugs are looked up globally, so they are unique among live pages
+CREATE UNIQUE INDEX IF NOT EXISTS idx_campaign_fundraisers_slug ON campaign_fundraisers (slug) WHERE deleted_at IS NULL;
+-- add index for the fundraiser leaderboard of a campaign
+CREATE INDEX IF NOT EXISTS idx_campaign_fundraisers_campaign_id_current_amount ON campaign_fundraisers (campaign_id, current_amount DESC) WHERE deleted_at IS NULL;
+-- add index foreign key user_id
+CREATE INDEX IF NOT EXISTS idx_campaign_fundraisers_user_id ON campaign_fundraisers (user_id);
+
+-- donations made through a fundraiser page are attributed to it
+ALTER TABLE donations ADD COLUMN IF NOT EXISTS fundraiser_id INT NULL REFERENCES campaign_fundraisers(id) ON DELETE SET NULL;
+
+-- add index foreign key fundraiser_id
+CREATE INDEX IF NOT EXISTS idx_donations_fundraiser_id ON donations (fundraiser_id) WHERE fundraiser_id IS NOT NULL;
+-- end of campaign_fundraisers table
+
+-- campaign_matching_pools table
+CREATE TABLE IF NOT EXISTS campaign_matching_pools (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    campaign_id INT NOT NULL,
+    sponsor_name VARCHAR(100) NOT NULL,
+    sponsor_email VARCHAR(100) NOT NULL,
+    -- amount matched for every 1 donated, e.g. 1 for 1:1 and 0.5 for 1:2
+    ratio NUMERIC(6, 2) NOT NULL,
+    cap DECIMAL(12, 2) NOT NULL,
+    matched_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    created_by INT NULL,
+    -- set once the sponsor was emailed the statement of the closed pool
+    statement_sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    -- emailed to the sponsor, a pool only matches once the sponsor confirmed it
+    confirmation_token VARCHAR(64) NULL UNIQUE,
+    confirmed_at TIMESTAMP NULL,
+    CHECK (matched_amount <= cap),
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- add index foreign key campaign_id
+CREATE INDEX IF NOT EXISTS idx_campaign_matching_pools_campaign_id ON campaign_matching_pools (campaign_id) WHERE deleted_at IS NULL;
+-- end of campaign_matching_pools table
+
+-- donation_matches table
+CREATE TABLE IF NOT EXISTS donation_matches (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    pool_id INT NOT NULL,
+    donation_id INT NOT NULL,
+    campaign_id INT NOT NULL,
+    donation_amount DECIMAL(10, 2) NOT NULL,
+    amount DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- a donation is matched at most once per pool, even when the payment
+    -- callback is delivered again
+    UNIQUE (pool_id, donation_id),
+    FOREIGN KEY(pool_id) REFERENCES campaign_matching_pools(id) ON DELETE CASCADE,
+    FOREIGN KEY(donation_id) REFERENCES donations(id) ON DELETE CASCADE,
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+-- end of donation_matches table
+
+-- donation_dedications table
+CREATE TABLE IF NOT EXISTS donation_dedications (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    donation_id INT NOT NULL UNIQUE,
+    type VARCHAR(10) NOT NULL, -- 'memory' or 'honor'
+    honoree_name VARCHAR(100) NOT NULL,
+    recipient_email VARCHAR(100) NULL,
+    message TEXT NULL,
+    -- the donor allows the dedication to show on the donor wall
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    ecard_sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(donation_id) REFERENCES donations(id) ON DELETE CASCADE
+);
+-- end of donation_dedications table
+
+-- campaign_locations table
+CREATE TABLE IF NOT EXISTS campaign_locations (
+    campaign_id INT PRIMARY KEY,
+    province VARCHAR(60) NULL,
+    city VARCHAR(60) NULL,
+    latitude DOUBLE PRECISION NULL,
+    longitude DOUBLE PRECISION NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- coordinates are either both set or both empty
+    CHECK ((latitude IS NULL) = (longitude IS NULL)),
+    CHECK (latitude BETWEEN -90 AND 90),
+    CHECK (longitude BETWEEN -180 AND 180),
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index for the bounding box of the radius search
+CREATE INDEX IF NOT EXISTS idx_campaign_locations_coordinates ON campaign_locations (latitude, longitude) WHERE latitude IS NOT NULL;
+
+-- add index for the region filter, regions are matched case-insensitively
+CREATE INDEX IF NOT EXISTS idx_campaign_locations_region ON campaign_locations (LOWER(province), LOWER(city));
+-- end of campaign_locations table
+
+-- campaign_similarities table
+CREATE TABLE IF NOT EXISTS campaign_similarities (
+    campaign_id INT NOT NULL,
+    similar_campaign_id INT NOT NULL,
+    -- shared category and tags, text similarity and donor overlap
+    score NUMERIC(10, 4) NOT NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (campaign_id, similar_campaign_id),
+    FOREIGN KEY(campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE,
+    FOREIGN KEY(similar_campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+-- add index for the ranking of a campaign's similar campaigns
+CREATE INDEX IF NOT EXISTS idx_campaign_similarities_score ON campaign_similarities (campaign_id, score DESC);
+-- end of campaign_similarities table
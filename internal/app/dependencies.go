@@ -1,11 +1,15 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 
 	"go-campaign.com/internal/config"
+	"go-campaign.com/internal/shared/events"
 	"go-campaign.com/internal/shared/services/payment"
 	"go-campaign.com/pkg/filesystem"
+	"go-campaign.com/pkg/mailer"
+	"go-campaign.com/pkg/scheduler"
 )
 
 type Dependencies struct {
@@ -13,6 +17,13 @@ type Dependencies struct {
 	DB             *sql.DB
 	FileSystem     filesystem.Filesystem
 	PaymentGateway payment.PaymentGateway
+	Events         *events.Bus
+	Mailer         mailer.Mailer
+	// Scheduler runs the periodic jobs the modules register
+	Scheduler *scheduler.Scheduler
+	// Shutdown is cancelled when the server starts shutting down, long-lived
+	// requests such as event streams end on it
+	Shutdown context.Context
 }
 
 func NewDependencies(
@@ -20,12 +31,20 @@ func NewDependencies(
 	db *sql.DB,
 	fileSystem filesystem.Filesystem,
 	paymentGateway payment.PaymentGateway,
+	eventBus *events.Bus,
+	mail mailer.Mailer,
+	jobs *scheduler.Scheduler,
+	shutdown context.Context,
 ) *Dependencies {
 	return &Dependencies{
 		Config:         config,
 		DB:             db,
 		FileSystem:     fileSystem,
 		PaymentGateway: paymentGateway,
+		Events:         eventBus,
+		Mailer:         mail,
+		Scheduler:      jobs,
+		Shutdown:       shutdown,
 	}
 }
 
@@ -9,6 +9,50 @@ const (
 	StatusActive    Status = 2
 	StatusCompleted Status = 3
 	StatusCancelled Status = 4
+	// StatusPendingReview waits for an admin to approve or reject the campaign
+	StatusPendingReview Status = 5
+	StatusRejected      Status = 6
+)
+
+// String matches the status labels the campaign queries return.
+func (s Status) String() string {
+	switch s {
+	case StatusDraft:
+		return "Draft"
+	case StatusActive:
+		return "Active"
+	case StatusCompleted:
+		return "Completed"
+	case StatusCancelled:
+		return "Cancelled"
+	case StatusPendingReview:
+		return "Pending Review"
+	case StatusRejected:
+		return "Rejected"
+	default:
+		return "Unknown"
+	}
+}
+
+// CampaignCategories group campaigns for browsing, feeds and statistics.
+var CampaignCategories = []string{
+	"education",
+	"health",
+	"disaster",
+	"environment",
+	"animals",
+	"humanity",
+	"religion",
+	"community",
+	"creative",
+	"other",
+}
+
+type ReviewDecision int
+
+const (
+	ReviewApproved ReviewDecision = 1
+	ReviewRejected ReviewDecision = 2
 )
 
 type Campaign struct {
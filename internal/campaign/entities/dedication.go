@@ -0,0 +1,17 @@
+package entities
+
+// DedicationTypes are the ways a donor can dedicate a donation to someone.
+var DedicationTypes = []string{
+	"memory",
+	"honor",
+}
+
+// DedicationPhrase introduces the honoree of a dedication, e.g. "In memory
+// of".
+func DedicationPhrase(dedicationType string) string {
+	if dedicationType == "memory" {
+		return "In memory of"
+	}
+
+	return "In honor of"
+}
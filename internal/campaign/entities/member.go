@@ -0,0 +1,68 @@
+package entities
+
+type MemberRole int32
+
+const (
+	RoleOwner  MemberRole = 1
+	RoleEditor MemberRole = 2
+	RoleViewer MemberRole = 3
+)
+
+// CanEdit reports whether the role may change the campaign, its rewards and
+// its milestones. Every role may read them.
+func (r MemberRole) CanEdit() bool {
+	return r == RoleOwner || r == RoleEditor
+}
+
+// CanManageMembers reports whether the role may invite, update and remove
+// campaign members.
+func (r MemberRole) CanManageMembers() bool {
+	return r == RoleOwner
+}
+
+func (r MemberRole) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleEditor:
+		return "editor"
+	case RoleViewer:
+		return "viewer"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseMemberRole returns the role of an invitable role name, owner is not
+// one of them since a campaign only has a single owner.
+func ParseMemberRole(role string) (MemberRole, bool) {
+	switch role {
+	case "editor":
+		return RoleEditor, true
+	case "viewer":
+		return RoleViewer, true
+	default:
+		return 0, false
+	}
+}
+
+type MemberStatus int32
+
+const (
+	MemberInvited  MemberStatus = 1
+	MemberAccepted MemberStatus = 2
+	MemberDeclined MemberStatus = 3
+)
+
+func (s MemberStatus) String() string {
+	switch s {
+	case MemberInvited:
+		return "invited"
+	case MemberAccepted:
+		return "accepted"
+	case MemberDeclined:
+		return "declined"
+	default:
+		return "unknown"
+	}
+}
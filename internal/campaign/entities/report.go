@@ -0,0 +1,37 @@
+package entities
+
+// ReportCategories are the reasons a visitor can pick when reporting a
+// campaign.
+var ReportCategories = []string{
+	"fraud",
+	"misleading",
+	"inappropriate",
+	"spam",
+	"impersonation",
+	"other",
+}
+
+type ReportStatus int32
+
+const (
+	ReportOpen      ReportStatus = 1
+	ReportDismissed ReportStatus = 2
+	ReportUpheld    ReportStatus = 3
+)
+
+func (s ReportStatus) String() string {
+	switch s {
+	case ReportOpen:
+		return "open"
+	case ReportDismissed:
+		return "dismissed"
+	case ReportUpheld:
+		return "upheld"
+	default:
+		return "unknown"
+	}
+}
+
+// SuspensionNotice is shown on the public page of a suspended campaign, the
+// suspension reason itself stays internal.
+const SuspensionNotice = "This campaign has been suspended while we review reports about it. Donations are paused."
@@ -1,30 +1,209 @@
 package campaign
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"go-campaign.com/internal/app"
 	"go-campaign.com/internal/campaign/repository/postgres"
 	"go-campaign.com/internal/campaign/repository/sqlc"
 	"go-campaign.com/internal/campaign/services"
 	v1 "go-campaign.com/internal/campaign/transport/http/v1"
+	"go-campaign.com/internal/campaign/transport/http/web"
+	"go-campaign.com/internal/shared/events"
+	"go-campaign.com/pkg/mailer"
 )
 
 func BootHttpV1(router fiber.Router, deps *app.Dependencies) {
 	q := sqlc.New(deps.DB) // Now using the shared repository package
 	donationRepository := postgres.NewDonationRepository(deps.DB, q)
-	campaignRepository := postgres.NewCampaignRepository(q)
+	campaignRepository := postgres.NewCampaignRepository(deps.DB, q)
 
-	userService := services.NewUserCampaignService(q)
+	userService := services.NewUserCampaignService(deps.DB, q)
 	userHandler := v1.NewHandler(userService)
 
 	publicHandler := v1.NewPublicHandler(
-		services.NewCampaignService(
-			deps.PaymentGateway,
-			donationRepository,
-			campaignRepository,
-		),
+		newCampaignService(deps, donationRepository, campaignRepository),
 		deps.Config,
 	)
 
-	v1.RegisterRoute(router, userHandler, publicHandler)
+	rewardTierHandler := v1.NewRewardTierHandler(
+		services.NewRewardTierService(q),
+		userService,
+	)
+
+	memberHandler := v1.NewMemberHandler(
+		services.NewMemberService(q, deps.Mailer, deps.Config.App.URL),
+		userService,
+	)
+
+	reviewService := services.NewReviewService(deps.DB, q, deps.Events)
+	widgetService := services.NewWidgetService(campaignRepository, deps.Config.App.URL)
+	leaderboardService := services.NewLeaderboardService(deps.DB, q, campaignRepository)
+	dedicationService := services.NewDedicationService(deps.DB, q, deps.Mailer, deps.Config.App.URL)
+	matchingPoolService := services.NewMatchingPoolService(deps.DB, q, deps.Mailer, deps.Config.App.URL)
+	statsService := services.NewStatsService(q)
+	recommendationService := services.NewRecommendationService(deps.DB, q, campaignRepository)
+	donationStreamService := services.NewDonationStreamService(deps.Config.Database.URL, campaignRepository, deps.Shutdown.Done())
+
+	v1.RegisterRoute(
+		router,
+		userHandler,
+		publicHandler,
+		rewardTierHandler,
+		memberHandler,
+		v1.NewReviewHandler(reviewService),
+		v1.NewReportHandler(
+			services.NewReportService(deps.DB, q, deps.Config.App.Moderation.ReportThreshold),
+			deps.Config.App.Moderation.ReporterKeySecret,
+		),
+		v1.NewAnalyticsHandler(services.NewAnalyticsService(q), userService),
+		v1.NewExportHandler(services.NewExportService(donationRepository), userService),
+		v1.NewPreviewHandler(services.NewPreviewService(q, deps.Config.App.URL), userService),
+		v1.NewWidgetHandler(widgetService),
+		v1.NewShareHandler(services.NewShareService(q, campaignRepository, deps.Config.App.URL), userService),
+		v1.NewCampaignUpdateHandler(services.NewCampaignUpdateService(q, campaignRepository), userService),
+		v1.NewLeaderboardHandler(leaderboardService),
+		v1.NewFundraiserHandler(services.NewFundraiserService(q, campaignRepository)),
+		v1.NewMatchingPoolHandler(matchingPoolService, userService),
+		v1.NewStatsHandler(statsService),
+		v1.NewRecommendationHandler(recommendationService),
+		v1.NewDonationStreamHandler(donationStreamService),
+		reviewService.IsAdmin,
+	)
+
+	deps.Scheduler.Every("trending", services.TrendingRefreshInterval, leaderboardService.RefreshTrending)
+	deps.Scheduler.Every("matching-statements", services.MatchingStatementInterval, matchingPoolService.SendStatements)
+	deps.Scheduler.Every("ecards", services.ECardRetryInterval, dedicationService.ResendECards)
+	// every instance serves the statistics from its own memory, so the
+	// refresh runs on each of them
+	deps.Scheduler.Every("stats", services.StatsRefreshInterval, statsService.Refresh)
+	deps.Scheduler.Every("similarities", services.SimilarityRefreshInterval, recommendationService.RefreshSimilarities)
+	// the listener runs until shutdown, the interval only matters when it fails
+	deps.Scheduler.Every("donation-stream", services.DonationStreamRetryInterval, donationStreamService.Listen)
+
+	deps.Events.Subscribe(events.MilestoneReachedEvent, notifyMilestoneReached(q, deps.Mailer, deps.Config.App.URL))
+	deps.Events.Subscribe(events.PaymentPaidEvent, widgetService.Invalidate)
+	deps.Events.Subscribe(events.PaymentPaidEvent, dedicationService.SendECard)
+	deps.Events.Subscribe(events.CampaignReviewedEvent, notifyCampaignReviewed(deps.Mailer))
+}
+
+// BootWeb registers the server-rendered campaign pages, short links and
+// feeds, they live outside of the api group so shared links stay short.
+func BootWeb(router fiber.Router, deps *app.Dependencies) {
+	q := sqlc.New(deps.DB)
+	donationRepository := postgres.NewDonationRepository(deps.DB, q)
+	campaignRepository := postgres.NewCampaignRepository(deps.DB, q)
+
+	campaignService := newCampaignService(deps, donationRepository, campaignRepository)
+	sitemapService := services.NewSitemapService(q, deps.Config.App.URL)
+
+	// every instance serves the sitemaps from its own memory, so the refresh
+	// runs on each of them
+	deps.Scheduler.Every("sitemap", services.SitemapRefreshInterval, sitemapService.Refresh)
+
+	web.RegisterRoute(
+		router,
+		web.NewHandler(
+			services.NewShareService(q, campaignRepository, deps.Config.App.URL),
+			campaignService,
+			deps.Config.App.URL,
+		),
+		web.NewFeedHandler(
+			services.NewFeedService(
+				campaignService,
+				services.NewCampaignUpdateService(q, campaignRepository),
+				deps.Config.App.URL,
+			),
+		),
+		web.NewSitemapHandler(sitemapService),
+		web.NewMatchingPoolHandler(services.NewMatchingPoolService(deps.DB, q, deps.Mailer, deps.Config.App.URL)),
+	)
+}
+
+func newCampaignService(deps *app.Dependencies, donations *postgres.DonationRepository, campaigns *postgres.CampaignRepository) *services.CampaignService {
+	return services.NewCampaignService(
+		deps.PaymentGateway,
+		donations,
+		campaigns,
+		deps.Events,
+	)
+}
+
+// notifyCampaignReviewed emails the owner the outcome of the review.
+func notifyCampaignReviewed(m mailer.Mailer) events.Handler {
+	return func(ctx context.Context, event events.Event) {
+		reviewed := event.(events.CampaignReviewed)
+
+		subject := fmt.Sprintf("Your campaign %s was rejected", reviewed.Title)
+		body := fmt.Sprintf(
+			"Your campaign \"%s\" was not approved.\n\nReason: %s\n\nYou can update the campaign and submit it for review again.\n",
+			reviewed.Title,
+			reviewed.Reason,
+		)
+
+		if reviewed.Approved {
+			subject = fmt.Sprintf("Your campaign %s was approved", reviewed.Title)
+			body = fmt.Sprintf(
+				"Your campaign \"%s\" was approved and is now live.\n\nNote from the reviewer: %s\n",
+				reviewed.Title,
+				reviewed.Reason,
+			)
+		}
+
+		err := m.Send(ctx, mailer.Message{
+			To:      []string{reviewed.OwnerEmail},
+			Subject: subject,
+			Body:    body,
+		})
+
+		if err != nil {
+			log.Printf("failed to notify the owner of campaign %d: %v", reviewed.CampaignID, err)
+		}
+	}
+}
+
+// notifyMilestoneReached emails the owner that a donation pushed the campaign
+// past one of its milestones.
+func notifyMilestoneReached(q *sqlc.Queries, m mailer.Mailer, appURL string) events.Handler {
+	appURL = strings.TrimRight(appURL, "/")
+
+	return func(ctx context.Context, event events.Event) {
+		milestone := event.(events.MilestoneReached)
+
+		campaign, err := q.GetCampaignOwnerContact(ctx, milestone.CampaignID)
+
+		if err != nil {
+			log.Printf("failed to get the owner of campaign %d: %v", milestone.CampaignID, err)
+			return
+		}
+
+		kind := "milestone"
+
+		if milestone.Stretch {
+			kind = "stretch goal"
+		}
+
+		err = m.Send(ctx, mailer.Message{
+			To:      []string{campaign.OwnerEmail},
+			Subject: fmt.Sprintf("Your campaign %s reached a %s", campaign.Title, kind),
+			Body: fmt.Sprintf(
+				"Hi %s,\n\nYour campaign \"%s\" reached the %s of Rp %s: %s\n\nYou can thank your donors with a campaign update: %s/campaigns/%s\n",
+				campaign.OwnerName,
+				campaign.Title,
+				kind,
+				milestone.Amount.StringFixed(2),
+				milestone.Description,
+				appURL,
+				campaign.Slug,
+			),
+		})
+
+		if err != nil {
+			log.Printf("failed to notify the owner of campaign %d about milestone %d: %v", milestone.CampaignID, milestone.MilestoneID, err)
+		}
+	}
 }
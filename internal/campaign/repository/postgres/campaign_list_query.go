@@ -0,0 +1,407 @@
+package postgres
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/request"
+)
+
+const campaignProgressExpr = `CASE WHEN c.target_amount = 0 THEN 0 ELSE c.current_amount / c.target_amount * 100 END`
+
+// campaignTrendingScoreExpr reads the score recomputed periodically, campaigns
+// without recent donations score zero.
+const campaignTrendingScoreExpr = `COALESCE(
+	(SELECT t.score FROM campaign_trending_scores t WHERE t.campaign_id = c.id), 0
+)`
+
+const campaignDonorCountExpr = `(
+	SELECT COUNT(DISTINCT p.donatur_id) FROM payments p
+	WHERE p.campaign_id = c.id AND p.status = 5
+)`
+
+// kmPerDegree is the length of a degree of latitude, and of longitude at the
+// equator.
+const kmPerDegree = 111.045
+
+// htmlEscapeExpr escapes the markup in a text column. ts_headline copies the
+// text around the matches as is and the highlights are rendered as HTML.
+const htmlEscapeExpr = `REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+
+// campaignDistanceExpr is the great-circle distance in km between the campaign
+// location and a point, computed with the haversine formula.
+const campaignDistanceExpr = `(2 * 6371 * ASIN(LEAST(1, SQRT(
+	POWER(SIN(RADIANS(l.latitude - %[1]s) / 2), 2) +
+	COS(RADIANS(%[1]s)) * COS(RADIANS(l.latitude)) * POWER(SIN(RADIANS(l.longitude - %[2]s) / 2), 2)
+))))`
+
+// campaignSortKey is the column a sort option orders by, c.id always
+// follows it as a tie breaker in the same direction.
+type campaignSortKey struct {
+	expr string
+	// cast restores the text value stored in a cursor to the key type
+	cast string
+	desc bool
+}
+
+// pgTimestampLayout is the text form of a timestamp column.
+const pgTimestampLayout = "2006-01-02 15:04:05.999999"
+
+// validKey reports whether a cursor key reads as the key type, a tampered
+// cursor would otherwise fail the cast in the query.
+func (k campaignSortKey) validKey(key string) bool {
+	var err error
+
+	switch k.cast {
+	case "timestamp":
+		_, err = time.Parse(pgTimestampLayout, key)
+	case "numeric":
+		_, err = decimal.NewFromString(key)
+	case "real", "float8":
+		// Postgres doesn't read the hexadecimal form ParseFloat accepts
+		if strings.ContainsAny(key, "xX") {
+			return false
+		}
+
+		_, err = strconv.ParseFloat(key, 64)
+	case "bigint":
+		_, err = strconv.ParseInt(key, 10, 64)
+	default:
+		return false
+	}
+
+	return err == nil
+}
+
+// campaignListQuery builds the public campaign list and its count from the
+// same WHERE clause, so the total always matches the applied filters.
+type campaignListQuery struct {
+	filter     repository.CampaignFilter
+	conditions []string
+	args       []any
+	searchArg  string
+	// distanceExpr is only set when the list is filtered by a point
+	distanceExpr string
+}
+
+func newCampaignListQuery(filter repository.CampaignFilter) *campaignListQuery {
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	q := &campaignListQuery{
+		filter: filter,
+		conditions: []string{
+			"c.deleted_at IS NULL",
+			"c.suspended_at IS NULL",
+			"c.status = 2",
+			"c.start_date <= CURRENT_TIMESTAMP",
+			"c.end_date >= CURRENT_TIMESTAMP",
+		},
+	}
+
+	if filter.Query != "" {
+		q.searchArg = q.arg(filter.Query) + "::text"
+		q.where(fmt.Sprintf(
+			"(c.search_vector @@ websearch_to_tsquery('simple', %[1]s) OR %[1]s <%% c.title)",
+			q.searchArg,
+		))
+	}
+
+	if filter.Category != "" {
+		q.where(fmt.Sprintf("c.category = %s", q.arg(filter.Category)))
+	}
+
+	if filter.ProgressMin != nil {
+		q.where(fmt.Sprintf("%s >= %s", campaignProgressExpr, q.arg(*filter.ProgressMin)))
+	}
+
+	if filter.ProgressMax != nil {
+		q.where(fmt.Sprintf("%s <= %s", campaignProgressExpr, q.arg(*filter.ProgressMax)))
+	}
+
+	if filter.TargetMin != nil {
+		q.where(fmt.Sprintf("c.target_amount >= %s", q.arg(*filter.TargetMin)))
+	}
+
+	if filter.TargetMax != nil {
+		q.where(fmt.Sprintf("c.target_amount <= %s", q.arg(*filter.TargetMax)))
+	}
+
+	if filter.TrendingOnly {
+		q.where("EXISTS (SELECT 1 FROM campaign_trending_scores t WHERE t.campaign_id = c.id)")
+	}
+
+	if filter.Province != "" {
+		q.where(fmt.Sprintf("LOWER(l.province) = LOWER(%s)", q.arg(filter.Province)))
+	}
+
+	if filter.City != "" {
+		q.where(fmt.Sprintf("LOWER(l.city) = LOWER(%s)", q.arg(filter.City)))
+	}
+
+	if filter.Near != nil {
+		q.near(*filter.Near, filter.RadiusKm)
+	}
+
+	return q
+}
+
+// near computes the distance to point and keeps the campaigns within radiusKm
+// of it when set. A bounding box narrows the rows through the coordinates
+// index before the exact distance is computed.
+func (q *campaignListQuery) near(point repository.GeoPoint, radiusKm *float64) {
+	lat, lng := q.arg(point.Latitude)+"::float8", q.arg(point.Longitude)+"::float8"
+
+	q.distanceExpr = fmt.Sprintf(campaignDistanceExpr, lat, lng)
+	q.where("l.latitude IS NOT NULL")
+
+	if radiusKm == nil {
+		return
+	}
+
+	latDelta := *radiusKm / kmPerDegree
+
+	q.where(fmt.Sprintf(
+		"l.latitude BETWEEN %s AND %s",
+		q.arg(point.Latitude-latDelta), q.arg(point.Latitude+latDelta),
+	))
+
+	// near the poles or across the antimeridian the longitude range wraps,
+	// the exact distance below still applies
+	lngDelta := latDelta / math.Cos(point.Latitude*math.Pi/180)
+
+	if point.Longitude-lngDelta >= -180 && point.Longitude+lngDelta <= 180 {
+		q.where(fmt.Sprintf(
+			"l.longitude BETWEEN %s AND %s",
+			q.arg(point.Longitude-lngDelta), q.arg(point.Longitude+lngDelta),
+		))
+	}
+
+	q.where(fmt.Sprintf("%s <= %s", q.distanceExpr, q.arg(*radiusKm)))
+}
+
+// arg registers a query argument and returns its placeholder.
+func (q *campaignListQuery) arg(value any) string {
+	q.args = append(q.args, value)
+
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *campaignListQuery) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+func (q *campaignListQuery) whereClause() string {
+	return strings.Join(q.conditions, " AND\n\t")
+}
+
+// Sort resolves the requested sort option, an empty or unknown value falls
+// back to relevance when searching and newest otherwise.
+func (q *campaignListQuery) Sort() string {
+	switch q.filter.Sort {
+	case repository.SortNewest,
+		repository.SortEndingSoon,
+		repository.SortMostFunded,
+		repository.SortClosestToGoal,
+		repository.SortMostDonors,
+		repository.SortTrending:
+		return q.filter.Sort
+	case repository.SortDistance:
+		if q.distanceExpr != "" {
+			return q.filter.Sort
+		}
+	}
+
+	if q.searchArg != "" {
+		return repository.SortRelevance
+	}
+
+	return repository.SortNewest
+}
+
+// sortKey whitelists the ORDER BY column of every sort option, user input
+// never reaches the query text.
+func (q *campaignListQuery) sortKey() campaignSortKey {
+	switch q.Sort() {
+	case repository.SortRelevance:
+		return campaignSortKey{expr: q.rankExpr(), cast: "real", desc: true}
+	case repository.SortEndingSoon:
+		return campaignSortKey{expr: "c.end_date", cast: "timestamp", desc: false}
+	case repository.SortMostFunded:
+		return campaignSortKey{expr: "c.current_amount", cast: "numeric", desc: true}
+	case repository.SortClosestToGoal:
+		// funded campaigns go last
+		return campaignSortKey{
+			expr: fmt.Sprintf("(CASE WHEN c.current_amount >= c.target_amount THEN -1 ELSE %s END)", campaignProgressExpr),
+			cast: "numeric",
+			desc: true,
+		}
+	case repository.SortMostDonors:
+		return campaignSortKey{expr: campaignDonorCountExpr, cast: "bigint", desc: true}
+	case repository.SortTrending:
+		return campaignSortKey{expr: campaignTrendingScoreExpr, cast: "numeric", desc: true}
+	case repository.SortDistance:
+		return campaignSortKey{expr: q.distanceExpr, cast: "float8", desc: false}
+	default:
+		return campaignSortKey{expr: "c.start_date", cast: "timestamp", desc: true}
+	}
+}
+
+func (q *campaignListQuery) rankExpr() string {
+	if q.searchArg == "" {
+		return "0::real"
+	}
+
+	return fmt.Sprintf(
+		"(ts_rank_cd(c.search_vector, websearch_to_tsquery('simple', %[1]s)) + word_similarity(%[1]s, c.title))::real",
+		q.searchArg,
+	)
+}
+
+func (q *campaignListQuery) orderClause(backward bool) string {
+	key := q.sortKey()
+	direction := "ASC"
+
+	if key.desc != backward {
+		direction = "DESC"
+	}
+
+	return fmt.Sprintf("%s %s, c.id %s", key.expr, direction, direction)
+}
+
+func (q *campaignListQuery) selectColumns() string {
+	titleHighlight, descriptionHighlight := "''::text", "''::text"
+	distance := "NULL::float8"
+
+	if q.distanceExpr != "" {
+		distance = q.distanceExpr
+	}
+
+	if q.searchArg != "" {
+		tsQuery := fmt.Sprintf("websearch_to_tsquery('simple', %s)", q.searchArg)
+
+		titleHighlight = fmt.Sprintf(
+			"ts_headline('simple', %s, %s, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')",
+			fmt.Sprintf(htmlEscapeExpr, "c.title"),
+			tsQuery,
+		)
+		descriptionHighlight = fmt.Sprintf(
+			"ts_headline('simple', %s, %s, 'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2')",
+			fmt.Sprintf(htmlEscapeExpr, "COALESCE(c.description, '')"),
+			tsQuery,
+		)
+	}
+
+	return fmt.Sprintf(`c.id, c.title, COALESCE(c.description, '') AS description, c.slug, c.category,
+	c.current_amount::numeric, c.target_amount::numeric,
+	(%s)::numeric AS progress,
+	c.start_date, c.end_date,
+	CASE
+		WHEN c.status = 1 THEN 'Draft'
+		WHEN c.status = 2 THEN 'Active'
+		WHEN c.status = 3 THEN 'Completed'
+		WHEN c.status = 4 THEN 'Cancelled'
+		ELSE 'Unknown'
+	END AS status,
+	%s AS donor_count,
+	c.updated_at::TIMESTAMP,
+	l.province, l.city,
+	%s AS distance_km,
+	%s AS rank,
+	%s AS title_highlight,
+	%s AS description_highlight,
+	(%s)::text AS cursor_key`,
+		campaignProgressExpr,
+		campaignDonorCountExpr,
+		distance,
+		q.rankExpr(),
+		titleHighlight,
+		descriptionHighlight,
+		q.sortKey().expr,
+	)
+}
+
+// List returns the paginated list query with its arguments.
+func (q *campaignListQuery) List(limit, offset int32) (string, []any) {
+	args := append([]any{}, q.args...)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`SELECT %s
+FROM campaigns c
+LEFT JOIN campaign_locations l ON l.campaign_id = c.id
+WHERE
+	%s
+ORDER BY %s
+LIMIT $%d OFFSET $%d`,
+		q.selectColumns(),
+		q.whereClause(),
+		q.orderClause(false),
+		len(args)-1,
+		len(args),
+	)
+
+	return query, args
+}
+
+// ListByCursor returns the keyset query of the page the cursor points at,
+// it fetches one extra row to tell whether another page exists. Rows of a
+// backward cursor come in reverse order.
+func (q *campaignListQuery) ListByCursor(cursor *request.Cursor, limit int32) (string, []any, error) {
+	conditions := q.whereClause()
+	backward := cursor != nil && cursor.Backward
+
+	args := append([]any{}, q.args...)
+
+	if cursor != nil {
+		key := q.sortKey()
+		operator := ">"
+
+		if !key.validKey(cursor.Key) {
+			return "", nil, request.ErrInvalidCursor
+		}
+
+		if key.desc != backward {
+			operator = "<"
+		}
+
+		args = append(args, cursor.Key, cursor.ID)
+		conditions += fmt.Sprintf(
+			" AND\n\t(%s, c.id) %s ($%d::%s, $%d)",
+			key.expr, operator, len(args)-1, key.cast, len(args),
+		)
+	}
+
+	args = append(args, limit+1)
+
+	query := fmt.Sprintf(`SELECT %s
+FROM campaigns c
+LEFT JOIN campaign_locations l ON l.campaign_id = c.id
+WHERE
+	%s
+ORDER BY %s
+LIMIT $%d`,
+		q.selectColumns(),
+		conditions,
+		q.orderClause(backward),
+		len(args),
+	)
+
+	return query, args, nil
+}
+
+// Count returns the total query for the same filters.
+func (q *campaignListQuery) Count() (string, []any) {
+	query := fmt.Sprintf(`SELECT COUNT(*) AS total
+FROM campaigns c
+LEFT JOIN campaign_locations l ON l.campaign_id = c.id
+WHERE
+	%s`,
+		q.whereClause(),
+	)
+
+	return query, q.args
+}
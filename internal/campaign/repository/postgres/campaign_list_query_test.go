@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/request"
+)
+
+func TestCampaignListQuerySort(t *testing.T) {
+	near := &repository.GeoPoint{Latitude: -6.2, Longitude: 106.8}
+
+	tests := []struct {
+		filter repository.CampaignFilter
+		sort   string
+		order  string
+		cast   string
+	}{
+		{repository.CampaignFilter{}, repository.SortNewest, "c.start_date DESC, c.id DESC", "timestamp"},
+		{repository.CampaignFilter{Sort: "title; DROP TABLE campaigns"}, repository.SortNewest, "c.start_date DESC, c.id DESC", "timestamp"},
+		{repository.CampaignFilter{Query: "banjir"}, repository.SortRelevance, "::real DESC, c.id DESC", "real"},
+		{repository.CampaignFilter{Query: "  "}, repository.SortNewest, "c.start_date DESC", "timestamp"},
+		{repository.CampaignFilter{Sort: repository.SortEndingSoon}, repository.SortEndingSoon, "c.end_date ASC, c.id ASC", "timestamp"},
+		{repository.CampaignFilter{Sort: repository.SortMostFunded}, repository.SortMostFunded, "c.current_amount DESC, c.id DESC", "numeric"},
+		{repository.CampaignFilter{Sort: repository.SortClosestToGoal}, repository.SortClosestToGoal, "THEN -1 ELSE", "numeric"},
+		{repository.CampaignFilter{Sort: repository.SortMostDonors}, repository.SortMostDonors, "COUNT(DISTINCT p.donatur_id)", "bigint"},
+		{repository.CampaignFilter{Sort: repository.SortTrending}, repository.SortTrending, "campaign_trending_scores", "numeric"},
+		// distance needs a point to measure from
+		{repository.CampaignFilter{Sort: repository.SortDistance}, repository.SortNewest, "c.start_date DESC", "timestamp"},
+		{repository.CampaignFilter{Sort: repository.SortDistance, Near: near}, repository.SortDistance, "))) ASC, c.id ASC", "float8"},
+	}
+
+	for _, tt := range tests {
+		q := newCampaignListQuery(tt.filter)
+
+		if got := q.Sort(); got != tt.sort {
+			t.Errorf("Sort(%+v) = %q, want %q", tt.filter, got, tt.sort)
+		}
+
+		if got := q.orderClause(false); !strings.Contains(got, tt.order) {
+			t.Errorf("orderClause(%+v) = %q, want it to contain %q", tt.filter, got, tt.order)
+		}
+
+		if got := q.sortKey().cast; got != tt.cast {
+			t.Errorf("sortKey(%+v).cast = %q, want %q", tt.filter, got, tt.cast)
+		}
+	}
+}
+
+func TestCampaignListQueryNear(t *testing.T) {
+	radius := 10.0
+
+	tests := []struct {
+		name   string
+		point  repository.GeoPoint
+		radius *float64
+		// conditions are the location conditions added after the base ones
+		conditions []string
+		args       int
+	}{
+		{"no radius", repository.GeoPoint{Latitude: -6.2, Longitude: 106.8}, nil, []string{"l.latitude IS NOT NULL"}, 2},
+		{
+			"radius",
+			repository.GeoPoint{Latitude: -6.2, Longitude: 106.8},
+			&radius,
+			[]string{"l.latitude IS NOT NULL", "l.latitude BETWEEN $3 AND $4", "l.longitude BETWEEN $5 AND $6", "<= $7"},
+			7,
+		},
+		// the longitude range wraps, only the latitude box applies
+		{
+			"across the antimeridian",
+			repository.GeoPoint{Latitude: -17.7, Longitude: 179.99},
+			&radius,
+			[]string{"l.latitude IS NOT NULL", "l.latitude BETWEEN $3 AND $4", "<= $5"},
+			5,
+		},
+	}
+
+	for _, tt := range tests {
+		q := newCampaignListQuery(repository.CampaignFilter{Near: &tt.point, RadiusKm: tt.radius})
+		conditions := q.conditions[5:]
+
+		if len(conditions) != len(tt.conditions) {
+			t.Errorf("%s: conditions = %q", tt.name, conditions)
+			continue
+		}
+
+		for i, want := range tt.conditions {
+			if !strings.Contains(conditions[i], want) {
+				t.Errorf("%s: condition %d = %q, want it to contain %q", tt.name, i, conditions[i], want)
+			}
+		}
+
+		if len(q.args) != tt.args {
+			t.Errorf("%s: args = %v, want %d", tt.name, q.args, tt.args)
+		}
+	}
+
+	q := newCampaignListQuery(repository.CampaignFilter{Near: &repository.GeoPoint{Latitude: -6.2, Longitude: 106.8}, RadiusKm: &radius})
+
+	minLat, maxLat := q.args[2].(float64), q.args[3].(float64)
+	minLng, maxLng := q.args[4].(float64), q.args[5].(float64)
+
+	// 10 km is about 0.09 degree of latitude, longitude degrees are shorter
+	// away from the equator so the box is wider there
+	if minLat > -6.29 || minLat < -6.3 || maxLat < -6.11 || maxLat > -6.1 {
+		t.Errorf("latitude box = %v..%v", minLat, maxLat)
+	}
+
+	if maxLng-minLng <= maxLat-minLat {
+		t.Errorf("longitude box %v..%v is not wider than the latitude box", minLng, maxLng)
+	}
+}
+
+func TestCampaignListQueryListByCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    repository.CampaignFilter
+		cursor    *request.Cursor
+		predicate string
+		order     string
+		args      []any
+	}{
+		{"first page", repository.CampaignFilter{}, nil, "", "c.start_date DESC, c.id DESC", []any{int32(11)}},
+		{
+			"forward",
+			repository.CampaignFilter{},
+			&request.Cursor{Key: "2024-03-01 08:00:00", ID: 7},
+			"(c.start_date, c.id) < ($1::timestamp, $2)",
+			"c.start_date DESC, c.id DESC",
+			[]any{"2024-03-01 08:00:00", int32(7), int32(11)},
+		},
+		{
+			"backward",
+			repository.CampaignFilter{},
+			&request.Cursor{Key: "2024-03-01 08:00:00", ID: 7, Backward: true},
+			"(c.start_date, c.id) > ($1::timestamp, $2)",
+			"c.start_date ASC, c.id ASC",
+			[]any{"2024-03-01 08:00:00", int32(7), int32(11)},
+		},
+		{
+			"ascending key after a filter",
+			repository.CampaignFilter{Sort: repository.SortEndingSoon, Category: "health"},
+			&request.Cursor{Key: "2024-04-01 00:00:00.5", ID: 3},
+			"(c.end_date, c.id) > ($2::timestamp, $3)",
+			"c.end_date ASC, c.id ASC",
+			[]any{"health", "2024-04-01 00:00:00.5", int32(3), int32(11)},
+		},
+		{
+			"numeric key",
+			repository.CampaignFilter{Sort: repository.SortMostFunded},
+			&request.Cursor{Key: "1500000.50", ID: 3},
+			"(c.current_amount, c.id) < ($1::numeric, $2)",
+			"c.current_amount DESC, c.id DESC",
+			[]any{"1500000.50", int32(3), int32(11)},
+		},
+	}
+
+	for _, tt := range tests {
+		query, args, err := newCampaignListQuery(tt.filter).ListByCursor(tt.cursor, 10)
+
+		if err != nil {
+			t.Errorf("%s: ListByCursor() error = %v", tt.name, err)
+			continue
+		}
+
+		if tt.predicate != "" && !strings.Contains(query, tt.predicate) {
+			t.Errorf("%s: the query is missing %q\n%s", tt.name, tt.predicate, query)
+		}
+
+		if !strings.Contains(query, "ORDER BY "+tt.order) {
+			t.Errorf("%s: the query is not ordered by %q\n%s", tt.name, tt.order, query)
+		}
+
+		if len(args) != len(tt.args) {
+			t.Errorf("%s: args = %v, want %v", tt.name, args, tt.args)
+			continue
+		}
+
+		for i := range args {
+			if args[i] != tt.args[i] {
+				t.Errorf("%s: args = %v, want %v", tt.name, args, tt.args)
+				break
+			}
+		}
+	}
+}
+
+func TestCampaignListQueryInvalidCursorKey(t *testing.T) {
+	tests := []struct {
+		sort string
+		key  string
+	}{
+		{repository.SortNewest, "yesterday"},
+		{repository.SortNewest, "2024-03-01"},
+		{repository.SortMostFunded, "1e5; DROP TABLE campaigns"},
+		{repository.SortMostFunded, ""},
+		{repository.SortMostDonors, "1.5"},
+		{repository.SortMostDonors, "99999999999999999999"},
+		{repository.SortRelevance, "0x1p-2"},
+		{repository.SortRelevance, "high"},
+	}
+
+	for _, tt := range tests {
+		q := newCampaignListQuery(repository.CampaignFilter{Sort: tt.sort, Query: "banjir"})
+
+		if _, _, err := q.ListByCursor(&request.Cursor{Key: tt.key, ID: 1}, 10); err != request.ErrInvalidCursor {
+			t.Errorf("ListByCursor(%s, %q) error = %v, want ErrInvalidCursor", tt.sort, tt.key, err)
+		}
+	}
+}
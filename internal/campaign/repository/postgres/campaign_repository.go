@@ -6,47 +6,138 @@ import (
 	"errors"
 	"fmt"
 
+	"go-campaign.com/internal/campaign/entities"
 	"go-campaign.com/internal/campaign/repository/sqlc"
 	"go-campaign.com/internal/campaign/services/repository"
 	"go-campaign.com/internal/shared/http/request"
 )
 
 type CampaignRepository struct {
+	db   *sql.DB
 	sqlc *sqlc.Queries
 }
 
 var _ repository.CampaignRepository = (*CampaignRepository)(nil)
 
-func NewCampaignRepository(sqlc *sqlc.Queries) *CampaignRepository {
+func NewCampaignRepository(db *sql.DB, sqlc *sqlc.Queries) *CampaignRepository {
 	return &CampaignRepository{
+		db:   db,
 		sqlc: sqlc,
 	}
 }
 
-// func (r *CampaignRepository) GetCampaignBySlug(ctx context.Context)
-func (r *CampaignRepository) GetPaginatedCampaigns(ctx context.Context, req request.PaginationRequest) ([]repository.CampaignList, error) {
-	campaigns, err := r.sqlc.GetCampaigns(ctx, sqlc.GetCampaignsParams{
-		Limit:  req.Limit,
-		Offset: req.Offset,
-	})
+// GetPaginatedCampaigns runs a dynamic query because sorting and range
+// filters can't be expressed in a single static sqlc query.
+func (r *CampaignRepository) GetPaginatedCampaigns(ctx context.Context, filter repository.CampaignFilter, req request.PaginationRequest) ([]repository.CampaignList, error) {
+	query, args := newCampaignListQuery(filter).List(req.Limit, req.Offset)
+
+	rows, err := r.queryCampaignList(ctx, query, args)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve campaign list: %w", err)
+		return nil, err
 	}
 
-	var campaignList []repository.CampaignList
+	campaignList := make([]repository.CampaignList, 0, len(rows))
 
-	for _, c := range campaigns {
-		campaignList = append(campaignList, repository.CampaignList(c))
+	for _, row := range rows {
+		campaignList = append(campaignList, row.Item)
 	}
 
 	return campaignList, nil
 }
 
-func (r *CampaignRepository) GetTotalCampaign(ctx context.Context) (int64, error) {
-	total, err := r.sqlc.GetTotalCampaigns(ctx)
+func (r *CampaignRepository) GetCampaignsByCursor(ctx context.Context, filter repository.CampaignFilter, req request.CursorPaginationRequest) (*request.CursorPage[repository.CampaignList], error) {
+	listQuery := newCampaignListQuery(filter)
+	sort := listQuery.Sort()
+
+	// a cursor only makes sense within the ordering it was created for
+	if req.Cursor != nil && req.Cursor.Sort != sort {
+		return nil, request.ErrInvalidCursor
+	}
+
+	query, args, err := listQuery.ListByCursor(req.Cursor, req.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queryCampaignList(ctx, query, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		rows[i].Cursor.Sort = sort
+	}
+
+	page := request.PageByCursor(rows, req)
+
+	return &page, nil
+}
+
+func (r *CampaignRepository) queryCampaignList(ctx context.Context, query string, args []any) ([]request.Keyed[repository.CampaignList], error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve campaign list: %w", err)
+	}
+
+	defer rows.Close()
+
+	var campaignList []request.Keyed[repository.CampaignList]
+
+	for rows.Next() {
+		var c repository.CampaignList
+		var cursorKey string
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.Title,
+			&c.Description,
+			&c.Slug,
+			&c.Category,
+			&c.CurrentAmount,
+			&c.TargetAmount,
+			&c.Progress,
+			&c.StartDate,
+			&c.EndDate,
+			&c.Status,
+			&c.DonorCount,
+			&c.UpdatedAt,
+			&c.Province,
+			&c.City,
+			&c.DistanceKm,
+			&c.Rank,
+			&c.TitleHighlight,
+			&c.DescriptionHighlight,
+			&cursorKey,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan campaign list: %w", err)
+		}
+
+		campaignList = append(campaignList, request.Keyed[repository.CampaignList]{
+			Item: c,
+			Cursor: request.Cursor{
+				Key: cursorKey,
+				ID:  c.ID,
+			},
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve campaign list: %w", err)
+	}
+
+	return campaignList, nil
+}
+
+func (r *CampaignRepository) GetTotalCampaign(ctx context.Context, filter repository.CampaignFilter) (int64, error) {
+	query, args := newCampaignListQuery(filter).Count()
+
+	var total int64
+
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
 		return 0, fmt.Errorf("failed to get total campaign: %w", err)
 	}
 
@@ -64,7 +155,49 @@ func (r *CampaignRepository) GetCampaignBySlug(ctx context.Context, slug string)
 		return nil, fmt.Errorf("failed to retrieve the campaign: %w", err)
 	}
 
-	return &repository.DetailCampaign{
+	return r.detailCampaign(ctx, c)
+}
+
+// GetCampaignByPreviewToken finds the campaign of a live preview link, drafts
+// included.
+func (r *CampaignRepository) GetCampaignByPreviewToken(ctx context.Context, token string) (*repository.DetailCampaign, error) {
+	c, err := r.sqlc.FindCampaignByPreviewToken(ctx, token)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("campaign not found: %w", err)
+		}
+
+		return nil, fmt.Errorf("failed to retrieve the campaign: %w", err)
+	}
+
+	campaign, err := r.detailCampaign(ctx, sqlc.GetCampaignBySlugRow(c))
+
+	if err != nil {
+		return nil, err
+	}
+
+	campaign.Preview = true
+
+	return campaign, nil
+}
+
+func (r *CampaignRepository) FindRedirectedSlug(ctx context.Context, slug string) (string, error) {
+	current, err := r.sqlc.FindRedirectedSlug(ctx, slug)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to find slug redirect: %w", err)
+	}
+
+	return current, nil
+}
+
+func (r *CampaignRepository) detailCampaign(ctx context.Context, c sqlc.GetCampaignBySlugRow) (*repository.DetailCampaign, error) {
+	campaign := &repository.DetailCampaign{
 		ID:            c.ID,
 		UserID:        c.UserID,
 		Title:         c.Title,
@@ -78,5 +211,103 @@ func (r *CampaignRepository) GetCampaignBySlug(ctx context.Context, slug string)
 		UserEmail:     c.UserEmail,
 		Progress:      c.Progress,
 		Status:        c.Status,
-	}, nil
+		Tags:          c.Tags,
+		Category:      c.Category,
+		Images:        c.Images,
+		Suspended:     c.SuspendedAt.Valid,
+	}
+
+	if campaign.Suspended {
+		notice := entities.SuspensionNotice
+		campaign.SuspensionNotice = &notice
+	}
+
+	latest, err := r.sqlc.GetLatestCampaignRevision(ctx, c.ID)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to retrieve the campaign revision: %w", err)
+	}
+
+	// revision 1 is the campaign as it was created
+	if latest.Revision > 1 {
+		campaign.LastEditedAt = &latest.CreatedAt
+	}
+
+	location, err := r.sqlc.GetCampaignLocation(ctx, c.ID)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to retrieve the campaign location: %w", err)
+	}
+
+	if err == nil {
+		campaign.Location = &repository.CampaignLocation{}
+
+		if location.Province.Valid {
+			campaign.Location.Province = &location.Province.String
+		}
+
+		if location.City.Valid {
+			campaign.Location.City = &location.City.String
+		}
+
+		if location.Latitude.Valid && location.Longitude.Valid {
+			campaign.Location.Latitude = &location.Latitude.Float64
+			campaign.Location.Longitude = &location.Longitude.Float64
+		}
+	}
+
+	return campaign, nil
+}
+
+func (r *CampaignRepository) GetCampaignMilestones(ctx context.Context, campaignID int32) ([]repository.Milestone, error) {
+	rows, err := r.sqlc.GetCampaignMilestones(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve campaign milestones: %w", err)
+	}
+
+	milestones := make([]repository.Milestone, 0, len(rows))
+
+	for _, row := range rows {
+		milestone := repository.Milestone{
+			ID:          row.ID,
+			Amount:      row.Amount,
+			Description: row.Description,
+			Stretch:     row.Stretch,
+			Reached:     row.ReachedAt.Valid,
+		}
+
+		if row.ReachedAt.Valid {
+			milestone.ReachedAt = &row.ReachedAt.Time
+		}
+
+		milestones = append(milestones, milestone)
+	}
+
+	return milestones, nil
+}
+
+func (r *CampaignRepository) GetActiveMatchingPools(ctx context.Context, campaignID int32) ([]repository.MatchingPool, error) {
+	rows, err := r.sqlc.GetActiveMatchingPools(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve matching pools: %w", err)
+	}
+
+	pools := make([]repository.MatchingPool, 0, len(rows))
+
+	for _, row := range rows {
+		pools = append(pools, repository.MatchingPool{
+			ID:          row.ID,
+			SponsorName: row.SponsorName,
+			Ratio:       row.Ratio,
+			Cap:         row.Cap,
+			Matched:     row.MatchedAmount,
+			Remaining:   row.Cap.Sub(row.MatchedAmount),
+			StartsAt:    row.StartsAt,
+			EndsAt:      row.EndsAt,
+		})
+	}
+
+	return pools, nil
 }
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go-campaign.com/internal/campaign/services/repository"
+)
+
+const exportDonationsQuery = `SELECT dn.id, p.transaction_id, d.name, COALESCE(d.email, ''), p.amount, p.status,
+	COALESCE(p.method, ''), COALESCE(p.vendor, ''), p.payment_date, COALESCE(dn.note, ''), dn.created_at
+FROM donations dn
+JOIN payments p ON p.donation_id = dn.id
+JOIN donaturs d ON d.id = dn.donatur_id
+WHERE dn.campaign_id = $1
+	AND ($2::date IS NULL OR dn.created_at >= $2::date)
+	AND ($3::date IS NULL OR dn.created_at < $3::date + 1)
+	AND ($4::integer IS NULL OR p.status = $4::integer)
+ORDER BY dn.id`
+
+// ExportDonations reads the donations row by row instead of loading them into
+// a slice like the sqlc queries do, so large campaigns export in constant
+// memory.
+func (r *DonationRepository) ExportDonations(ctx context.Context, filter repository.DonationExportFilter, fn func(repository.DonationExportRow) error) error {
+	var from, to sql.NullTime
+	var status sql.NullInt32
+
+	if filter.From != nil {
+		from = sql.NullTime{Time: *filter.From, Valid: true}
+	}
+
+	if filter.To != nil {
+		to = sql.NullTime{Time: *filter.To, Valid: true}
+	}
+
+	if filter.Status != nil {
+		status = sql.NullInt32{Int32: *filter.Status, Valid: true}
+	}
+
+	rows, err := r.db.QueryContext(ctx, exportDonationsQuery, filter.CampaignID, from, to, status)
+
+	if err != nil {
+		return fmt.Errorf("failed to export donations: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			row    repository.DonationExportRow
+			paidAt sql.NullTime
+		)
+
+		err := rows.Scan(
+			&row.DonationID,
+			&row.TransactionID,
+			&row.DonorName,
+			&row.DonorEmail,
+			&row.Amount,
+			&row.Status,
+			&row.Method,
+			&row.Vendor,
+			&paidAt,
+			&row.Note,
+			&row.CreatedAt,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to scan donation: %w", err)
+		}
+
+		if paidAt.Valid {
+			row.PaidAt = &paidAt.Time
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
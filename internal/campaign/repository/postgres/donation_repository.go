@@ -12,6 +12,7 @@ import (
 	"github.com/sqlc-dev/pqtype"
 	"go-campaign.com/internal/campaign/repository/sqlc"
 	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/request"
 )
 
 type DonationRepository struct {
@@ -55,8 +56,9 @@ func (r *DonationRepository) CreateDonationIntent(ctx context.Context, req repos
 			String: req.Email,
 			Valid:  req.Email != "",
 		},
-		UserID:     req.UserID,
-		CampaignID: req.CampaignID,
+		UserID:      req.UserID,
+		CampaignID:  req.CampaignID,
+		IsAnonymous: req.Anonymous,
 	})
 
 	if err != nil {
@@ -69,6 +71,25 @@ func (r *DonationRepository) CreateDonationIntent(ctx context.Context, req repos
 		note = *req.Note
 	}
 
+	var fundraiserID sql.NullInt32
+
+	if req.Fundraiser != "" {
+		id, err := qtx.FindFundraiserForDonation(ctx, sqlc.FindFundraiserForDonationParams{
+			Slug:       req.Fundraiser,
+			CampaignID: req.CampaignID,
+		})
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrFundraiserNotFound
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the fundraiser: %w", err)
+		}
+
+		fundraiserID = sql.NullInt32{Int32: id, Valid: true}
+	}
+
 	donation, err := qtx.CreateDonation(ctx, sqlc.CreateDonationParams{
 		DonaturID:  donatur.ID,
 		CampaignID: req.CampaignID,
@@ -77,12 +98,40 @@ func (r *DonationRepository) CreateDonationIntent(ctx context.Context, req repos
 			String: note,
 			Valid:  req.Note != nil,
 		},
+		FundraiserID: fundraiserID,
 	})
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create donation: %w", err)
 	}
 
+	if req.RewardTierID != nil {
+		if err := reserveRewardTier(ctx, qtx, donation.ID, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Dedication != nil {
+		err = qtx.CreateDonationDedication(ctx, sqlc.CreateDonationDedicationParams{
+			DonationID:  donation.ID,
+			Type:        req.Dedication.Type,
+			HonoreeName: req.Dedication.HonoreeName,
+			RecipientEmail: sql.NullString{
+				String: req.Dedication.RecipientEmail,
+				Valid:  req.Dedication.RecipientEmail != "",
+			},
+			Message: sql.NullString{
+				String: req.Dedication.Message,
+				Valid:  req.Dedication.Message != "",
+			},
+			IsPublic: req.Dedication.Public,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create donation dedication: %w", err)
+		}
+	}
+
 	transactionID := uuid.New()
 
 	payment, err := qtx.CreatePayment(ctx, sqlc.CreatePaymentParams{
@@ -106,6 +155,7 @@ func (r *DonationRepository) CreateDonationIntent(ctx context.Context, req repos
 
 	return &repository.DonationIntent{
 		PaymentID:     payment.ID,
+		DonationID:    donation.ID,
 		TransactionID: payment.TransactionID,
 		CampaignID:    donation.CampaignID,
 		Amount:        req.Amount,
@@ -129,36 +179,76 @@ func (r *DonationRepository) MarkInvoiceCreated(ctx context.Context, paymentID i
 	})
 }
 
-func (r *DonationRepository) MarkInvoiceFailed(ctx context.Context, paymentID int32) error {
-	return r.sqlc.UpdatePaymentStatus(ctx, sqlc.UpdatePaymentStatusParams{
+// MarkInvoiceFailed flags the payment for a retry, the reserved reward stock
+// is handed back since the donor never got an invoice to pay.
+func (r *DonationRepository) MarkInvoiceFailed(ctx context.Context, paymentID, donationID int32) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := r.sqlc.WithTx(tx)
+
+	err = qtx.UpdatePaymentStatus(ctx, sqlc.UpdatePaymentStatusParams{
 		Status: int32(sqlc.DonationPaymentStatusRetry),
 		ID:     paymentID,
 	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update payment status: %w", err)
+	}
+
+	if err := settleDonationReward(ctx, qtx, donationID, sqlc.DonationRewardStatusReleased); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r *DonationRepository) UpdateDonationPaymentFromWebhook(ctx context.Context, req repository.UpdatePayment) error {
+func (r *DonationRepository) UpdateDonationPaymentFromWebhook(ctx context.Context, req repository.UpdatePayment) (*repository.PaymentUpdateResult, error) {
+	if err := uuid.Validate(req.ExternalID); err != nil {
+		return nil, fmt.Errorf("invalid uuid: %w", err)
+	}
+
 	tx, err := r.db.BeginTx(ctx, nil)
 
 	if err != nil {
-		return fmt.Errorf("failed to start the database transaction: %w", err)
+		return nil, fmt.Errorf("failed to start the database transaction: %w", err)
 	}
 
 	defer func() {
 		_ = tx.Rollback()
 	}()
 
-	if err := uuid.Validate(req.ExternalID); err != nil {
-		return fmt.Errorf("invalid uuid: %w", err)
-	}
+	qtx := r.sqlc.WithTx(tx)
 
-	payment, err := r.sqlc.FindAndLockPaymentForUpdate(ctx, uuid.MustParse(req.ExternalID))
+	payment, err := qtx.FindAndLockPaymentForUpdate(ctx, uuid.MustParse(req.ExternalID))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("the payment record not found")
+			return nil, fmt.Errorf("the payment record not found")
 		}
 
-		return fmt.Errorf("failed to find the payment: %w", err)
+		return nil, fmt.Errorf("failed to find the payment: %w", err)
+	}
+
+	result := &repository.PaymentUpdateResult{
+		PaymentID:  payment.ID,
+		DonationID: payment.DonationID,
+		DonaturID:  payment.DonaturID,
+		CampaignID: payment.CampaignID,
+		Amount:     payment.Amount,
+	}
+
+	// the gateway retries callbacks, a paid payment is final so a repeated
+	// callback must not count the donation twice
+	if sqlc.DonationPaymentStatus(payment.Status) == sqlc.DonationPaymentStatusPaid {
+		return result, tx.Commit()
 	}
 
 	updateParams := sqlc.UpdatePaymentFromWebhookCallbackParams{
@@ -190,36 +280,121 @@ func (r *DonationRepository) UpdateDonationPaymentFromWebhook(ctx context.Contex
 		},
 	}
 
-	_, err = r.sqlc.UpdatePaymentFromWebhookCallback(ctx, updateParams)
+	_, err = qtx.UpdatePaymentFromWebhookCallback(ctx, updateParams)
 
 	if err != nil {
-		return fmt.Errorf("failed to update payment status")
+		return nil, fmt.Errorf("failed to update payment status: %w", err)
+	}
+
+	status := sqlc.DonationPaymentStatus(req.Status)
+
+	if status == sqlc.DonationPaymentStatusExpired || status == sqlc.DonationPaymentStatusFailed {
+		if err := settleDonationReward(ctx, qtx, payment.DonationID, sqlc.DonationRewardStatusReleased); err != nil {
+			return nil, err
+		}
+
+		return result, tx.Commit()
 	}
 
-	if sqlc.DonationPaymentStatus(req.Status) != sqlc.DonationPaymentStatusPaid {
-		return tx.Commit()
+	if status != sqlc.DonationPaymentStatusPaid {
+		return result, tx.Commit()
 	}
 
-	campaign, err := r.sqlc.FindCampaignByIdForUpdate(ctx, payment.CampaignID)
+	if err := settleDonationReward(ctx, qtx, payment.DonationID, sqlc.DonationRewardStatusClaimed); err != nil {
+		return nil, err
+	}
+
+	campaign, err := qtx.FindCampaignByIdForUpdate(ctx, payment.CampaignID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("campaign not found")
+			return nil, fmt.Errorf("campaign not found")
 		}
 
-		return fmt.Errorf("failed to retrieve the campaign: %w", err)
+		return nil, fmt.Errorf("failed to retrieve the campaign: %w", err)
 	}
 
-	err = r.sqlc.IncreaseCampaignCurrentAmount(ctx, sqlc.IncreaseCampaignCurrentAmountParams{
+	err = qtx.IncreaseCampaignCurrentAmount(ctx, sqlc.IncreaseCampaignCurrentAmountParams{
 		ID:     campaign.ID,
-		Amount: req.Amount,
+		Amount: payment.Amount,
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to increase the campaign's current_amount: %w", err)
+		return nil, fmt.Errorf("failed to increase the campaign's current_amount: %w", err)
 	}
 
-	return tx.Commit()
+	// a donation made through a fundraiser page also counts towards its target
+	err = qtx.IncreaseFundraiserCurrentAmount(ctx, sqlc.IncreaseFundraiserCurrentAmountParams{
+		Amount:     payment.Amount,
+		DonationID: payment.DonationID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to increase the fundraiser's current_amount: %w", err)
+	}
+
+	paidAt := time.Now()
+
+	if updateParams.PaymentDate.Valid && !updateParams.PaymentDate.Time.IsZero() {
+		paidAt = updateParams.PaymentDate.Time
+	}
+
+	result.Matched, err = applyMatchingPools(ctx, qtx, payment, paidAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Matched.IsPositive() {
+		err = qtx.IncreaseCampaignCurrentAmount(ctx, sqlc.IncreaseCampaignCurrentAmountParams{
+			ID:     campaign.ID,
+			Amount: result.Matched,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to credit the matched amount: %w", err)
+		}
+	}
+
+	milestones, err := qtx.MarkReachedMilestones(ctx, campaign.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update the campaign milestones: %w", err)
+	}
+
+	for _, milestone := range milestones {
+		result.ReachedMilestones = append(result.ReachedMilestones, repository.Milestone{
+			ID:          milestone.ID,
+			Amount:      milestone.Amount,
+			Description: milestone.Description,
+			Stretch:     milestone.Stretch,
+			Reached:     true,
+		})
+	}
+
+	// open campaign pages stream the donation, NOTIFY is only delivered on
+	// commit
+	err = qtx.NotifyDonationPaid(ctx, sqlc.NotifyDonationPaidParams{
+		Channel:    repository.DonationPaidChannel,
+		DonationID: payment.DonationID,
+		Amount:     payment.Amount,
+		Matched:    result.Matched,
+		PaidAt:     &paidAt,
+		CampaignID: campaign.ID,
+		DonaturID:  payment.DonaturID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to notify the paid donation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	result.Paid = true
+
+	return result, nil
 }
 
 func (r *DonationRepository) GetPaginatedDonatur(ctx context.Context, req repository.GetPaginatedDonaturParams) ([]repository.DonaturList, error) {
@@ -242,6 +417,79 @@ func (r *DonationRepository) GetPaginatedDonatur(ctx context.Context, req reposi
 	return donaturList, nil
 }
 
+func (r *DonationRepository) GetDonaturByCursor(ctx context.Context, slug string, req request.CursorPaginationRequest) (*request.CursorPage[repository.DonaturList], error) {
+	var rows []request.Keyed[repository.DonaturList]
+
+	keyed := func(id int32, name, email string, totalDonated decimal.Decimal, dedication string, createdAt time.Time) request.Keyed[repository.DonaturList] {
+		return request.Keyed[repository.DonaturList]{
+			Item: repository.DonaturList{
+				ID:           id,
+				Name:         name,
+				Email:        email,
+				TotalDonated: totalDonated,
+				Dedication:   dedication,
+			},
+			Cursor: request.Cursor{
+				Key: createdAt.Format(time.RFC3339Nano),
+				ID:  id,
+			},
+		}
+	}
+
+	if req.Cursor != nil && req.Cursor.Backward {
+		createdAt, err := time.Parse(time.RFC3339Nano, req.Cursor.Key)
+
+		if err != nil {
+			return nil, request.ErrInvalidCursor
+		}
+
+		donaturs, err := r.sqlc.GetDonatursBeforeCursor(ctx, sqlc.GetDonatursBeforeCursorParams{
+			Slug:            slug,
+			Limit:           req.Limit + 1,
+			CursorCreatedAt: createdAt,
+			CursorID:        req.Cursor.ID,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve donatur list: %w", err)
+		}
+
+		for _, d := range donaturs {
+			rows = append(rows, keyed(d.ID, d.Name, d.Email, d.TotalDonated, d.Dedication, d.CreatedAt))
+		}
+	} else {
+		params := sqlc.GetDonatursAfterCursorParams{
+			Slug:  slug,
+			Limit: req.Limit + 1,
+		}
+
+		if req.Cursor != nil {
+			createdAt, err := time.Parse(time.RFC3339Nano, req.Cursor.Key)
+
+			if err != nil {
+				return nil, request.ErrInvalidCursor
+			}
+
+			params.CursorCreatedAt = sql.NullTime{Time: createdAt, Valid: true}
+			params.CursorID = sql.NullInt32{Int32: req.Cursor.ID, Valid: true}
+		}
+
+		donaturs, err := r.sqlc.GetDonatursAfterCursor(ctx, params)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve donatur list: %w", err)
+		}
+
+		for _, d := range donaturs {
+			rows = append(rows, keyed(d.ID, d.Name, d.Email, d.TotalDonated, d.Dedication, d.CreatedAt))
+		}
+	}
+
+	page := request.PageByCursor(rows, req)
+
+	return &page, nil
+}
+
 func (r *DonationRepository) GetTotalPaidDonatur(ctx context.Context, slug string) (int64, error) {
 	total, err := r.sqlc.GetCampaignTotalPaidDonaturs(ctx, slug)
 
@@ -251,3 +499,187 @@ func (r *DonationRepository) GetTotalPaidDonatur(ctx context.Context, slug strin
 
 	return total, nil
 }
+
+// reserveRewardTier holds one unit of the chosen reward tier for the
+// donation, the row lock keeps concurrent donors from overselling it.
+func reserveRewardTier(ctx context.Context, qtx *sqlc.Queries, donationID int32, req repository.CreateDonationIntentParams) error {
+	tier, err := qtx.FindRewardTierForUpdate(ctx, sqlc.FindRewardTierForUpdateParams{
+		ID:         *req.RewardTierID,
+		CampaignID: req.CampaignID,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrRewardTierNotFound
+		}
+
+		return fmt.Errorf("failed to retrieve the reward tier: %w", err)
+	}
+
+	if req.Amount.LessThan(tier.MinAmount) {
+		return repository.ErrRewardAmountTooLow
+	}
+
+	if tier.Quantity.Valid && tier.Reserved+tier.Claimed >= tier.Quantity.Int32 {
+		return repository.ErrRewardTierSoldOut
+	}
+
+	if tier.RequiresShipping && req.Shipping == nil {
+		return repository.ErrShippingAddressRequired
+	}
+
+	if err := qtx.ReserveRewardTier(ctx, tier.ID); err != nil {
+		return fmt.Errorf("failed to reserve the reward tier: %w", err)
+	}
+
+	params := sqlc.CreateDonationRewardParams{
+		DonationID:   donationID,
+		RewardTierID: tier.ID,
+		CampaignID:   req.CampaignID,
+		Status:       int32(sqlc.DonationRewardStatusReserved),
+	}
+
+	if tier.RequiresShipping {
+		params.ShippingName = sql.NullString{String: req.Shipping.Name, Valid: true}
+		params.ShippingPhone = sql.NullString{String: req.Shipping.Phone, Valid: true}
+		params.ShippingAddress = sql.NullString{String: req.Shipping.Address, Valid: true}
+	}
+
+	if _, err := qtx.CreateDonationReward(ctx, params); err != nil {
+		return fmt.Errorf("failed to create donation reward: %w", err)
+	}
+
+	return nil
+}
+
+// applyMatchingPools matches a paid donation from every sponsor pool of the
+// campaign open at paidAt, each pool adds amount * ratio until its cap is
+// reached. It returns the total matched amount.
+func applyMatchingPools(ctx context.Context, qtx *sqlc.Queries, payment sqlc.Payment, paidAt time.Time) (decimal.Decimal, error) {
+	pools, err := qtx.FindMatchingPoolsForUpdate(ctx, sqlc.FindMatchingPoolsForUpdateParams{
+		CampaignID: payment.CampaignID,
+		DonatedAt:  paidAt,
+	})
+
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to retrieve the matching pools: %w", err)
+	}
+
+	total := decimal.Zero
+
+	for _, pool := range pools {
+		amount := decimal.Min(payment.Amount.Mul(pool.Ratio).Round(2), pool.Cap.Sub(pool.MatchedAmount))
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		created, err := qtx.CreateDonationMatch(ctx, sqlc.CreateDonationMatchParams{
+			PoolID:         pool.ID,
+			DonationID:     payment.DonationID,
+			CampaignID:     payment.CampaignID,
+			DonationAmount: payment.Amount,
+			Amount:         amount,
+		})
+
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("failed to record the donation match: %w", err)
+		}
+
+		// the donation was already matched by this pool
+		if created == 0 {
+			continue
+		}
+
+		err = qtx.IncreaseMatchingPoolAmount(ctx, sqlc.IncreaseMatchingPoolAmountParams{
+			Amount: amount,
+			ID:     pool.ID,
+		})
+
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("failed to update the matching pool: %w", err)
+		}
+
+		total = total.Add(amount)
+	}
+
+	return total, nil
+}
+
+// settleDonationReward moves a reserved reward to claimed or released and
+// adjusts the tier stock accordingly. Donations without a reward and rewards
+// that are already settled are left untouched.
+func settleDonationReward(ctx context.Context, qtx *sqlc.Queries, donationID int32, status sqlc.DonationRewardStatus) error {
+	reward, err := qtx.FindAndLockDonationRewardForUpdate(ctx, donationID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to retrieve the donation reward: %w", err)
+	}
+
+	current := sqlc.DonationRewardStatus(reward.Status)
+
+	// a PAID callback can come after the payment expired or failed, the
+	// reward is claimed again while the tier has stock left
+	if current == sqlc.DonationRewardStatusReleased && status == sqlc.DonationRewardStatusClaimed {
+		return reclaimDonationReward(ctx, qtx, reward)
+	}
+
+	if current != sqlc.DonationRewardStatusReserved {
+		return nil
+	}
+
+	switch status {
+	case sqlc.DonationRewardStatusClaimed:
+		err = qtx.ClaimRewardTier(ctx, reward.RewardTierID)
+	case sqlc.DonationRewardStatusReleased:
+		err = qtx.ReleaseRewardTier(ctx, reward.RewardTierID)
+	default:
+		return fmt.Errorf("invalid donation reward status: %d", status)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update the reward tier stock: %w", err)
+	}
+
+	err = qtx.UpdateDonationRewardStatus(ctx, sqlc.UpdateDonationRewardStatusParams{
+		Status: int32(status),
+		ID:     reward.ID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update the donation reward: %w", err)
+	}
+
+	return nil
+}
+
+// reclaimDonationReward claims a released reward, it is marked unavailable
+// when the tier sold out since it was released.
+func reclaimDonationReward(ctx context.Context, qtx *sqlc.Queries, reward sqlc.DonationReward) error {
+	claimed, err := qtx.ClaimReleasedRewardTier(ctx, reward.RewardTierID)
+
+	if err != nil {
+		return fmt.Errorf("failed to update the reward tier stock: %w", err)
+	}
+
+	status := sqlc.DonationRewardStatusClaimed
+
+	if claimed == 0 {
+		status = sqlc.DonationRewardStatusUnavailable
+	}
+
+	err = qtx.UpdateDonationRewardStatus(ctx, sqlc.UpdateDonationRewardStatusParams{
+		Status: int32(status),
+		ID:     reward.ID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update the donation reward: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/sqlfake"
+)
+
+// rewardTierRow is a reward_tiers row with a minimum amount of 50000.
+func rewardTierRow(quantity any, reserved, claimed int64, shipping bool) []driver.Value {
+	return []driver.Value{int64(5), int64(1), "Kaos", nil, "50000", quantity, reserved, claimed, shipping, nil, nil, nil}
+}
+
+func donationRewardRow(status sqlc.DonationRewardStatus) []driver.Value {
+	return []driver.Value{int64(9), int64(3), int64(5), int64(1), int64(status), nil, nil, nil, nil, nil, nil}
+}
+
+func rows(values ...[]driver.Value) sqlfake.Handler {
+	return func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{Rows: values}
+	}
+}
+
+func affected(n int64) sqlfake.Handler {
+	return func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{RowsAffected: n}
+	}
+}
+
+func TestReserveRewardTier(t *testing.T) {
+	tierID := int32(5)
+	shipping := &repository.ShippingAddress{Name: "Budi", Phone: "0812", Address: "Jl. Merdeka 1"}
+
+	tests := []struct {
+		name     string
+		tier     []driver.Value
+		amount   int64
+		shipping *repository.ShippingAddress
+		err      error
+		// shipped is whether the reward keeps the shipping address
+		shipped bool
+	}{
+		{"unknown tier", nil, 50000, nil, repository.ErrRewardTierNotFound, false},
+		{"amount too low", rewardTierRow(nil, 0, 0, false), 49999, nil, repository.ErrRewardAmountTooLow, false},
+		{"sold out", rewardTierRow(int64(2), 1, 1, false), 50000, nil, repository.ErrRewardTierSoldOut, false},
+		{"missing address", rewardTierRow(nil, 0, 0, true), 50000, nil, repository.ErrShippingAddressRequired, false},
+		{"unlimited", rewardTierRow(nil, 40, 60, false), 50000, shipping, nil, false},
+		{"last unit", rewardTierRow(int64(3), 1, 1, false), 75000, nil, nil, false},
+		{"shipped", rewardTierRow(nil, 0, 0, true), 50000, shipping, nil, true},
+	}
+
+	for _, tt := range tests {
+		db, fake := sqlfake.New()
+
+		if tt.tier != nil {
+			fake.On("FindRewardTierForUpdate", rows(tt.tier))
+		} else {
+			fake.On("FindRewardTierForUpdate", rows())
+		}
+
+		fake.On("ReserveRewardTier", affected(1))
+		fake.On("CreateDonationReward", rows(donationRewardRow(sqlc.DonationRewardStatusReserved)))
+
+		err := reserveRewardTier(context.Background(), sqlc.New(db), 3, repository.CreateDonationIntentParams{
+			CampaignID:   1,
+			Amount:       decimal.NewFromInt(tt.amount),
+			RewardTierID: &tierID,
+			Shipping:     tt.shipping,
+		})
+
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: reserveRewardTier() error = %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+
+		reserved, created := fake.Calls("ReserveRewardTier"), fake.Calls("CreateDonationReward")
+
+		if tt.err != nil {
+			if len(reserved) > 0 || len(created) > 0 {
+				t.Errorf("%s: a rejected reward was reserved", tt.name)
+			}
+
+			continue
+		}
+
+		if len(reserved) != 1 || len(created) != 1 {
+			t.Fatalf("%s: reserved %d times, created %d rewards", tt.name, len(reserved), len(created))
+		}
+
+		// donation_id, reward_tier_id, campaign_id, status, shipping name,
+		// phone and address
+		args := created[0].Args
+
+		if args[0] != int32(3) || args[1] != int32(5) || args[3] != int32(sqlc.DonationRewardStatusReserved) {
+			t.Errorf("%s: CreateDonationReward args = %v", tt.name, args)
+		}
+
+		if got := args[6] != nil; got != tt.shipped {
+			t.Errorf("%s: shipping address stored = %v, want %v", tt.name, got, tt.shipped)
+		}
+	}
+}
+
+func TestSettleDonationReward(t *testing.T) {
+	tests := []struct {
+		name    string
+		current sqlc.DonationRewardStatus
+		target  sqlc.DonationRewardStatus
+		// reclaimed is the rows ClaimReleasedRewardTier updates
+		reclaimed int64
+		// stock is the tier update expected, empty when the tier is untouched
+		stock  string
+		status sqlc.DonationRewardStatus
+	}{
+		{"paid", sqlc.DonationRewardStatusReserved, sqlc.DonationRewardStatusClaimed, 0, "ClaimRewardTier", sqlc.DonationRewardStatusClaimed},
+		{"expired", sqlc.DonationRewardStatusReserved, sqlc.DonationRewardStatusReleased, 0, "ReleaseRewardTier", sqlc.DonationRewardStatusReleased},
+		{"paid after expiring", sqlc.DonationRewardStatusReleased, sqlc.DonationRewardStatusClaimed, 1, "ClaimReleasedRewardTier", sqlc.DonationRewardStatusClaimed},
+		{"paid after selling out", sqlc.DonationRewardStatusReleased, sqlc.DonationRewardStatusClaimed, 0, "ClaimReleasedRewardTier", sqlc.DonationRewardStatusUnavailable},
+		{"paid twice", sqlc.DonationRewardStatusClaimed, sqlc.DonationRewardStatusClaimed, 0, "", 0},
+		{"expired twice", sqlc.DonationRewardStatusReleased, sqlc.DonationRewardStatusReleased, 0, "", 0},
+		{"expired after paid", sqlc.DonationRewardStatusClaimed, sqlc.DonationRewardStatusReleased, 0, "", 0},
+	}
+
+	for _, tt := range tests {
+		db, fake := sqlfake.New()
+
+		fake.On("FindAndLockDonationRewardForUpdate", rows(donationRewardRow(tt.current)))
+		fake.On("ClaimRewardTier", affected(1))
+		fake.On("ReleaseRewardTier", affected(1))
+		fake.On("ClaimReleasedRewardTier", affected(tt.reclaimed))
+		fake.On("UpdateDonationRewardStatus", affected(1))
+
+		if err := settleDonationReward(context.Background(), sqlc.New(db), 3, tt.target); err != nil {
+			t.Errorf("%s: settleDonationReward() error = %v", tt.name, err)
+			continue
+		}
+
+		for _, name := range []string{"ClaimRewardTier", "ReleaseRewardTier", "ClaimReleasedRewardTier"} {
+			want := 0
+
+			if name == tt.stock {
+				want = 1
+			}
+
+			if got := len(fake.Calls(name)); got != want {
+				t.Errorf("%s: %s called %d times, want %d", tt.name, name, got, want)
+			}
+		}
+
+		updates := fake.Calls("UpdateDonationRewardStatus")
+
+		if tt.stock == "" {
+			if len(updates) > 0 {
+				t.Errorf("%s: a settled reward was updated", tt.name)
+			}
+
+			continue
+		}
+
+		if len(updates) != 1 || updates[0].Args[0] != int32(tt.status) || updates[0].Args[1] != int32(9) {
+			t.Errorf("%s: UpdateDonationRewardStatus calls = %v, want status %d", tt.name, updates, tt.status)
+		}
+	}
+
+	// donations without a reward have nothing to settle
+	db, fake := sqlfake.New()
+	fake.On("FindAndLockDonationRewardForUpdate", rows())
+
+	if err := settleDonationReward(context.Background(), sqlc.New(db), 3, sqlc.DonationRewardStatusClaimed); err != nil {
+		t.Errorf("settleDonationReward() without reward error = %v", err)
+	}
+}
+
+func matchingPoolRow(id int64, ratio, cap, matched string) []driver.Value {
+	return []driver.Value{id, ratio, cap, matched}
+}
+
+func TestApplyMatchingPools(t *testing.T) {
+	tests := []struct {
+		name  string
+		pools [][]driver.Value
+		// created is the rows CreateDonationMatch inserts, 0 when the
+		// donation was matched before
+		created int64
+		// matches are the amounts matched by each pool, in order
+		matches []string
+		total   string
+	}{
+		{"no pool", nil, 1, nil, "0"},
+		{"one to one", [][]driver.Value{matchingPoolRow(1, "1", "1000000", "0")}, 1, []string{"50000"}, "50000"},
+		{"half", [][]driver.Value{matchingPoolRow(1, "0.5", "1000000", "0")}, 1, []string{"25000"}, "25000"},
+		{"rounded", [][]driver.Value{matchingPoolRow(1, "0.33", "1000000", "0")}, 1, []string{"16500"}, "16500"},
+		{"up to the cap", [][]driver.Value{matchingPoolRow(1, "2", "100000", "90000")}, 1, []string{"10000"}, "10000"},
+		{"spent", [][]driver.Value{matchingPoolRow(1, "1", "100000", "100000")}, 1, nil, "0"},
+		{
+			"several pools",
+			[][]driver.Value{matchingPoolRow(1, "1", "20000", "0"), matchingPoolRow(2, "0.5", "1000000", "0")},
+			1,
+			[]string{"20000", "25000"},
+			"45000",
+		},
+		{"replayed", [][]driver.Value{matchingPoolRow(1, "1", "1000000", "50000")}, 0, []string{"50000"}, "0"},
+	}
+
+	paidAt := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	payment := sqlc.Payment{DonationID: 3, CampaignID: 1, Amount: decimal.NewFromInt(50000)}
+
+	for _, tt := range tests {
+		db, fake := sqlfake.New()
+
+		fake.On("FindMatchingPoolsForUpdate", rows(tt.pools...))
+		fake.On("CreateDonationMatch", affected(tt.created))
+		fake.On("IncreaseMatchingPoolAmount", affected(1))
+
+		total, err := applyMatchingPools(context.Background(), sqlc.New(db), payment, paidAt)
+
+		if err != nil {
+			t.Errorf("%s: applyMatchingPools() error = %v", tt.name, err)
+			continue
+		}
+
+		if !total.Equal(decimal.RequireFromString(tt.total)) {
+			t.Errorf("%s: applyMatchingPools() = %s, want %s", tt.name, total, tt.total)
+		}
+
+		if find := fake.Calls("FindMatchingPoolsForUpdate"); find[0].Args[0] != int32(1) || !find[0].Args[1].(time.Time).Equal(paidAt) {
+			t.Errorf("%s: FindMatchingPoolsForUpdate args = %v", tt.name, find[0].Args)
+		}
+
+		created := fake.Calls("CreateDonationMatch")
+
+		if len(created) != len(tt.matches) {
+			t.Errorf("%s: %d matches created, want %d", tt.name, len(created), len(tt.matches))
+			continue
+		}
+
+		// pool_id, donation_id, campaign_id, donation_amount and amount
+		for i, call := range created {
+			if call.Args[1] != int32(3) || call.Args[3] != "50000" || call.Args[4] != tt.matches[i] {
+				t.Errorf("%s: CreateDonationMatch args = %v, want amount %s", tt.name, call.Args, tt.matches[i])
+			}
+		}
+
+		increased := fake.Calls("IncreaseMatchingPoolAmount")
+
+		if tt.created == 0 {
+			if len(increased) > 0 {
+				t.Errorf("%s: a replayed match increased the pool", tt.name)
+			}
+
+			continue
+		}
+
+		if len(increased) != len(tt.matches) {
+			t.Errorf("%s: %d pools increased, want %d", tt.name, len(increased), len(tt.matches))
+			continue
+		}
+
+		for i, call := range increased {
+			if call.Args[0] != tt.matches[i] || call.Args[1] != created[i].Args[0] {
+				t.Errorf("%s: IncreaseMatchingPoolAmount args = %v, want %s for pool %v", tt.name, call.Args, tt.matches[i], created[i].Args[0])
+			}
+		}
+	}
+}
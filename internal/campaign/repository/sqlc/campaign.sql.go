@@ -16,22 +16,140 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const acceptInvitation = `-- name: AcceptInvitation :execrows
+UPDATE campaign_members
+SET user_id = $2, status = 2, token = NULL, accepted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 1
+`
+
+type AcceptInvitationParams struct {
+	ID     int32         `json:"id"`
+	UserID sql.NullInt32 `json:"user_id"`
+}
+
+func (q *Queries) AcceptInvitation(ctx context.Context, arg AcceptInvitationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, acceptInvitation, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const approveCampaign = `-- name: ApproveCampaign :exec
+UPDATE campaigns
+SET status = 2, approved_at = COALESCE(approved_at, CURRENT_TIMESTAMP), updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) ApproveCampaign(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, approveCampaign, id)
+	return err
+}
+
+const cancelMatchingPool = `-- name: CancelMatchingPool :execrows
+UPDATE campaign_matching_pools
+SET deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+`
+
+type CancelMatchingPoolParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) CancelMatchingPool(ctx context.Context, arg CancelMatchingPoolParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelMatchingPool, arg.ID, arg.CampaignID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimReleasedRewardTier = `-- name: ClaimReleasedRewardTier :execrows
+UPDATE reward_tiers SET claimed = claimed + 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND (quantity IS NULL OR reserved + claimed < quantity)
+`
+
+func (q *Queries) ClaimReleasedRewardTier(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimReleasedRewardTier, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimRewardTier = `-- name: ClaimRewardTier :exec
+UPDATE reward_tiers SET reserved = reserved - 1, claimed = claimed + 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) ClaimRewardTier(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, claimRewardTier, id)
+	return err
+}
+
+const confirmMatchingPool = `-- name: ConfirmMatchingPool :one
+UPDATE campaign_matching_pools
+SET confirmed_at = CURRENT_TIMESTAMP, confirmation_token = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE confirmation_token = $1 AND deleted_at IS NULL
+RETURNING id, campaign_id, sponsor_name, sponsor_email, ratio, cap, matched_amount, starts_at, ends_at, created_by, statement_sent_at, created_at, updated_at, deleted_at, confirmation_token, confirmed_at
+`
+
+func (q *Queries) ConfirmMatchingPool(ctx context.Context, confirmationToken sql.NullString) (CampaignMatchingPool, error) {
+	row := q.db.QueryRowContext(ctx, confirmMatchingPool, confirmationToken)
+	var i CampaignMatchingPool
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.SponsorName,
+		&i.SponsorEmail,
+		&i.Ratio,
+		&i.Cap,
+		&i.MatchedAmount,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedBy,
+		&i.StatementSentAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ConfirmationToken,
+		&i.ConfirmedAt,
+	)
+	return i, err
+}
+
+const countOpenCampaignReports = `-- name: CountOpenCampaignReports :one
+SELECT COUNT(*) AS total
+FROM campaign_reports
+WHERE campaign_id = $1 AND status = 1
+`
+
+func (q *Queries) CountOpenCampaignReports(ctx context.Context, campaignID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOpenCampaignReports, campaignID)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const createCampaign = `-- name: CreateCampaign :one
-INSERT INTO campaigns (title, description, slug, user_id, target_amount, start_date, end_date, status, images, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, status, created_at, updated_at, deleted_at, images
+INSERT INTO campaigns (title, description, slug, user_id, target_amount, start_date, end_date, status, images, tags, category, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5::numeric, $6, $7, $8, $9, $10, $11, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, status, created_at, updated_at, deleted_at, images, tags, search_vector, approved_at, suspended_at, suspension_reason, category
 `
 
 type CreateCampaignParams struct {
-	Title        string    `json:"title"`
-	Description  *string   `json:"description"`
-	Slug         string    `json:"slug"`
-	UserID       int32     `json:"user_id"`
-	TargetAmount *float32  `json:"target_amount"`
-	StartDate    time.Time `json:"start_date"`
-	EndDate      time.Time `json:"end_date"`
-	Status       int32     `json:"status"`
-	Images       []string  `json:"images"`
+	Title        string          `json:"title"`
+	Description  *string         `json:"description"`
+	Slug         string          `json:"slug"`
+	UserID       int32           `json:"user_id"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Status       int32           `json:"status"`
+	Images       []string        `json:"images"`
+	Tags         []string        `json:"tags"`
+	Category     *string         `json:"category"`
 }
 
 func (q *Queries) CreateCampaign(ctx context.Context, arg CreateCampaignParams) (Campaign, error) {
@@ -45,6 +163,8 @@ func (q *Queries) CreateCampaign(ctx context.Context, arg CreateCampaignParams)
 		arg.EndDate,
 		arg.Status,
 		pq.Array(arg.Images),
+		pq.Array(arg.Tags),
+		arg.Category,
 	)
 	var i Campaign
 	err := row.Scan(
@@ -62,20 +182,205 @@ func (q *Queries) CreateCampaign(ctx context.Context, arg CreateCampaignParams)
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		pq.Array(&i.Images),
+		pq.Array(&i.Tags),
+		&i.SearchVector,
+		&i.ApprovedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.Category,
+	)
+	return i, err
+}
+
+const createCampaignMilestone = `-- name: CreateCampaignMilestone :exec
+INSERT INTO campaign_milestones (campaign_id, amount, description)
+VALUES ($1, $2, $3)
+`
+
+type CreateCampaignMilestoneParams struct {
+	CampaignID  int32           `json:"campaign_id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+}
+
+func (q *Queries) CreateCampaignMilestone(ctx context.Context, arg CreateCampaignMilestoneParams) error {
+	_, err := q.db.ExecContext(ctx, createCampaignMilestone, arg.CampaignID, arg.Amount, arg.Description)
+	return err
+}
+
+const createCampaignOwner = `-- name: CreateCampaignOwner :exec
+INSERT INTO campaign_members (campaign_id, user_id, email, role, status, accepted_at)
+SELECT $1, u.id, u.email, 1, 2, CURRENT_TIMESTAMP
+FROM users u
+WHERE u.id = $2
+`
+
+type CreateCampaignOwnerParams struct {
+	CampaignID int32 `json:"campaign_id"`
+	UserID     int32 `json:"user_id"`
+}
+
+func (q *Queries) CreateCampaignOwner(ctx context.Context, arg CreateCampaignOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, createCampaignOwner, arg.CampaignID, arg.UserID)
+	return err
+}
+
+const createCampaignPreview = `-- name: CreateCampaignPreview :one
+INSERT INTO campaign_previews (campaign_id, token, created_by, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, token, expires_at, created_at::TIMESTAMP
+`
+
+type CreateCampaignPreviewParams struct {
+	CampaignID int32         `json:"campaign_id"`
+	Token      string        `json:"token"`
+	CreatedBy  sql.NullInt32 `json:"created_by"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+}
+
+type CreateCampaignPreviewRow struct {
+	ID        int32     `json:"id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) CreateCampaignPreview(ctx context.Context, arg CreateCampaignPreviewParams) (CreateCampaignPreviewRow, error) {
+	row := q.db.QueryRowContext(ctx, createCampaignPreview,
+		arg.CampaignID,
+		arg.Token,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	var i CreateCampaignPreviewRow
+	err := row.Scan(
+		&i.ID,
+		&i.Token,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createCampaignReport = `-- name: CreateCampaignReport :execrows
+INSERT INTO campaign_reports (campaign_id, reporter_key, category, description)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (campaign_id, reporter_key) DO NOTHING
+`
+
+type CreateCampaignReportParams struct {
+	CampaignID  int32          `json:"campaign_id"`
+	ReporterKey string         `json:"reporter_key"`
+	Category    string         `json:"category"`
+	Description sql.NullString `json:"description"`
+}
+
+func (q *Queries) CreateCampaignReport(ctx context.Context, arg CreateCampaignReportParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createCampaignReport,
+		arg.CampaignID,
+		arg.ReporterKey,
+		arg.Category,
+		arg.Description,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createCampaignReview = `-- name: CreateCampaignReview :exec
+INSERT INTO campaign_reviews (campaign_id, reviewer_id, decision, reason)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateCampaignReviewParams struct {
+	CampaignID int32         `json:"campaign_id"`
+	ReviewerID sql.NullInt32 `json:"reviewer_id"`
+	Decision   int32         `json:"decision"`
+	Reason     string        `json:"reason"`
+}
+
+func (q *Queries) CreateCampaignReview(ctx context.Context, arg CreateCampaignReviewParams) error {
+	_, err := q.db.ExecContext(ctx, createCampaignReview,
+		arg.CampaignID,
+		arg.ReviewerID,
+		arg.Decision,
+		arg.Reason,
+	)
+	return err
+}
+
+const createCampaignRevision = `-- name: CreateCampaignRevision :one
+INSERT INTO campaign_revisions (campaign_id, revision, author_id, title, description, slug, target_amount, start_date, end_date, status, images, tags)
+SELECT c.id, COALESCE((SELECT MAX(r.revision) FROM campaign_revisions r WHERE r.campaign_id = c.id), 0) + 1, $1,
+	c.title, c.description, c.slug, c.target_amount, c.start_date, c.end_date, c.status, c.images, c.tags
+FROM campaigns c
+WHERE c.id = $2
+RETURNING revision
+`
+
+type CreateCampaignRevisionParams struct {
+	AuthorID   sql.NullInt32 `json:"author_id"`
+	CampaignID int32         `json:"campaign_id"`
+}
+
+func (q *Queries) CreateCampaignRevision(ctx context.Context, arg CreateCampaignRevisionParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, createCampaignRevision, arg.AuthorID, arg.CampaignID)
+	var revision int32
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const createCampaignUpdate = `-- name: CreateCampaignUpdate :one
+INSERT INTO campaign_updates (campaign_id, author_id, title, body)
+VALUES ($1, $2, $3, $4)
+RETURNING id, title, body, created_at::TIMESTAMP, updated_at::TIMESTAMP
+`
+
+type CreateCampaignUpdateParams struct {
+	CampaignID int32         `json:"campaign_id"`
+	AuthorID   sql.NullInt32 `json:"author_id"`
+	Title      string        `json:"title"`
+	Body       string        `json:"body"`
+}
+
+type CreateCampaignUpdateRow struct {
+	ID        int32     `json:"id"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (q *Queries) CreateCampaignUpdate(ctx context.Context, arg CreateCampaignUpdateParams) (CreateCampaignUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, createCampaignUpdate,
+		arg.CampaignID,
+		arg.AuthorID,
+		arg.Title,
+		arg.Body,
+	)
+	var i CreateCampaignUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const createDonation = `-- name: CreateDonation :one
-INSERT INTO donations (donatur_id, campaign_id, amount, note)
-VALUES ($1, $2, $3, $4) RETURNING id, donatur_id, campaign_id, amount, note, created_at, updated_at
+INSERT INTO donations (donatur_id, campaign_id, amount, note, fundraiser_id)
+VALUES ($1, $2, $3, $4, $5) RETURNING id, donatur_id, campaign_id, amount, note, created_at, updated_at, fundraiser_id
 `
 
 type CreateDonationParams struct {
-	DonaturID  int32           `json:"donatur_id"`
-	CampaignID int32           `json:"campaign_id"`
-	Amount     decimal.Decimal `json:"amount"`
-	Note       sql.NullString  `json:"note"`
+	DonaturID    int32           `json:"donatur_id"`
+	CampaignID   int32           `json:"campaign_id"`
+	Amount       decimal.Decimal `json:"amount"`
+	Note         sql.NullString  `json:"note"`
+	FundraiserID sql.NullInt32   `json:"fundraiser_id"`
 }
 
 func (q *Queries) CreateDonation(ctx context.Context, arg CreateDonationParams) (Donation, error) {
@@ -84,6 +389,7 @@ func (q *Queries) CreateDonation(ctx context.Context, arg CreateDonationParams)
 		arg.CampaignID,
 		arg.Amount,
 		arg.Note,
+		arg.FundraiserID,
 	)
 	var i Donation
 	err := row.Scan(
@@ -94,20 +400,119 @@ func (q *Queries) CreateDonation(ctx context.Context, arg CreateDonationParams)
 		&i.Note,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FundraiserID,
+	)
+	return i, err
+}
+
+const createDonationDedication = `-- name: CreateDonationDedication :exec
+INSERT INTO donation_dedications (donation_id, type, honoree_name, recipient_email, message, is_public)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateDonationDedicationParams struct {
+	DonationID     int32          `json:"donation_id"`
+	Type           string         `json:"type"`
+	HonoreeName    string         `json:"honoree_name"`
+	RecipientEmail sql.NullString `json:"recipient_email"`
+	Message        sql.NullString `json:"message"`
+	IsPublic       bool           `json:"is_public"`
+}
+
+func (q *Queries) CreateDonationDedication(ctx context.Context, arg CreateDonationDedicationParams) error {
+	_, err := q.db.ExecContext(ctx, createDonationDedication,
+		arg.DonationID,
+		arg.Type,
+		arg.HonoreeName,
+		arg.RecipientEmail,
+		arg.Message,
+		arg.IsPublic,
+	)
+	return err
+}
+
+const createDonationMatch = `-- name: CreateDonationMatch :execrows
+INSERT INTO donation_matches (pool_id, donation_id, campaign_id, donation_amount, amount)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (pool_id, donation_id) DO NOTHING
+`
+
+type CreateDonationMatchParams struct {
+	PoolID         int32           `json:"pool_id"`
+	DonationID     int32           `json:"donation_id"`
+	CampaignID     int32           `json:"campaign_id"`
+	DonationAmount decimal.Decimal `json:"donation_amount"`
+	Amount         decimal.Decimal `json:"amount"`
+}
+
+func (q *Queries) CreateDonationMatch(ctx context.Context, arg CreateDonationMatchParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createDonationMatch,
+		arg.PoolID,
+		arg.DonationID,
+		arg.CampaignID,
+		arg.DonationAmount,
+		arg.Amount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createDonationReward = `-- name: CreateDonationReward :one
+INSERT INTO donation_rewards (donation_id, reward_tier_id, campaign_id, status, shipping_name, shipping_phone, shipping_address)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, donation_id, reward_tier_id, campaign_id, status, shipping_name, shipping_phone, shipping_address, fulfilled_at, created_at, updated_at
+`
+
+type CreateDonationRewardParams struct {
+	DonationID      int32          `json:"donation_id"`
+	RewardTierID    int32          `json:"reward_tier_id"`
+	CampaignID      int32          `json:"campaign_id"`
+	Status          int32          `json:"status"`
+	ShippingName    sql.NullString `json:"shipping_name"`
+	ShippingPhone   sql.NullString `json:"shipping_phone"`
+	ShippingAddress sql.NullString `json:"shipping_address"`
+}
+
+func (q *Queries) CreateDonationReward(ctx context.Context, arg CreateDonationRewardParams) (DonationReward, error) {
+	row := q.db.QueryRowContext(ctx, createDonationReward,
+		arg.DonationID,
+		arg.RewardTierID,
+		arg.CampaignID,
+		arg.Status,
+		arg.ShippingName,
+		arg.ShippingPhone,
+		arg.ShippingAddress,
+	)
+	var i DonationReward
+	err := row.Scan(
+		&i.ID,
+		&i.DonationID,
+		&i.RewardTierID,
+		&i.CampaignID,
+		&i.Status,
+		&i.ShippingName,
+		&i.ShippingPhone,
+		&i.ShippingAddress,
+		&i.FulfilledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const createDonatur = `-- name: CreateDonatur :one
-INSERT INTO donaturs (name, email, user_id, campaign_id)
-VALUES ($1, $2, $3, $4) RETURNING id, user_id, campaign_id, name, email, created_at, updated_at
+INSERT INTO donaturs (name, email, user_id, campaign_id, is_anonymous)
+VALUES ($1, $2, $3, $4, $5) RETURNING id, user_id, campaign_id, name, email, created_at, updated_at, is_anonymous
 `
 
 type CreateDonaturParams struct {
-	Name       string         `json:"name"`
-	Email      sql.NullString `json:"email"`
-	UserID     int32          `json:"user_id"`
-	CampaignID int32          `json:"campaign_id"`
+	Name        string         `json:"name"`
+	Email       sql.NullString `json:"email"`
+	UserID      int32          `json:"user_id"`
+	CampaignID  int32          `json:"campaign_id"`
+	IsAnonymous bool           `json:"is_anonymous"`
 }
 
 func (q *Queries) CreateDonatur(ctx context.Context, arg CreateDonaturParams) (Donatur, error) {
@@ -116,6 +521,7 @@ func (q *Queries) CreateDonatur(ctx context.Context, arg CreateDonaturParams) (D
 		arg.Email,
 		arg.UserID,
 		arg.CampaignID,
+		arg.IsAnonymous,
 	)
 	var i Donatur
 	err := row.Scan(
@@ -126,6 +532,100 @@ func (q *Queries) CreateDonatur(ctx context.Context, arg CreateDonaturParams) (D
 		&i.Email,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsAnonymous,
+	)
+	return i, err
+}
+
+const createFundraiser = `-- name: CreateFundraiser :one
+INSERT INTO campaign_fundraisers (campaign_id, user_id, slug, title, story, target_amount)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, campaign_id, user_id, slug, title, story, target_amount, current_amount, created_at, updated_at, deleted_at
+`
+
+type CreateFundraiserParams struct {
+	CampaignID   int32           `json:"campaign_id"`
+	UserID       int32           `json:"user_id"`
+	Slug         string          `json:"slug"`
+	Title        string          `json:"title"`
+	Story        string          `json:"story"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+}
+
+func (q *Queries) CreateFundraiser(ctx context.Context, arg CreateFundraiserParams) (CampaignFundraiser, error) {
+	row := q.db.QueryRowContext(ctx, createFundraiser,
+		arg.CampaignID,
+		arg.UserID,
+		arg.Slug,
+		arg.Title,
+		arg.Story,
+		arg.TargetAmount,
+	)
+	var i CampaignFundraiser
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.UserID,
+		&i.Slug,
+		&i.Title,
+		&i.Story,
+		&i.TargetAmount,
+		&i.CurrentAmount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createMatchingPool = `-- name: CreateMatchingPool :one
+INSERT INTO campaign_matching_pools (campaign_id, sponsor_name, sponsor_email, ratio, cap, starts_at, ends_at, created_by, confirmation_token)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, campaign_id, sponsor_name, sponsor_email, ratio, cap, matched_amount, starts_at, ends_at, created_by, statement_sent_at, created_at, updated_at, deleted_at, confirmation_token, confirmed_at
+`
+
+type CreateMatchingPoolParams struct {
+	CampaignID        int32           `json:"campaign_id"`
+	SponsorName       string          `json:"sponsor_name"`
+	SponsorEmail      string          `json:"sponsor_email"`
+	Ratio             decimal.Decimal `json:"ratio"`
+	Cap               decimal.Decimal `json:"cap"`
+	StartsAt          time.Time       `json:"starts_at"`
+	EndsAt            time.Time       `json:"ends_at"`
+	CreatedBy         sql.NullInt32   `json:"created_by"`
+	ConfirmationToken sql.NullString  `json:"confirmation_token"`
+}
+
+func (q *Queries) CreateMatchingPool(ctx context.Context, arg CreateMatchingPoolParams) (CampaignMatchingPool, error) {
+	row := q.db.QueryRowContext(ctx, createMatchingPool,
+		arg.CampaignID,
+		arg.SponsorName,
+		arg.SponsorEmail,
+		arg.Ratio,
+		arg.Cap,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.CreatedBy,
+		arg.ConfirmationToken,
+	)
+	var i CampaignMatchingPool
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.SponsorName,
+		&i.SponsorEmail,
+		&i.Ratio,
+		&i.Cap,
+		&i.MatchedAmount,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedBy,
+		&i.StatementSentAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ConfirmationToken,
+		&i.ConfirmedAt,
 	)
 	return i, err
 }
@@ -179,91 +679,272 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 	return i, err
 }
 
-const findAndLockDonationForUpdate = `-- name: FindAndLockDonationForUpdate :one
-SELECT id, donatur_id, campaign_id, amount, note, created_at, updated_at FROM donations WHERE id = $1 FOR UPDATE
+const createRewardTier = `-- name: CreateRewardTier :one
+INSERT INTO reward_tiers (campaign_id, title, description, min_amount, quantity, requires_shipping)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, campaign_id, title, description, min_amount, quantity, reserved, claimed, requires_shipping, created_at, updated_at, deleted_at
 `
 
-func (q *Queries) FindAndLockDonationForUpdate(ctx context.Context, id int32) (Donation, error) {
-	row := q.db.QueryRowContext(ctx, findAndLockDonationForUpdate, id)
-	var i Donation
-	err := row.Scan(
-		&i.ID,
-		&i.DonaturID,
-		&i.CampaignID,
-		&i.Amount,
-		&i.Note,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+type CreateRewardTierParams struct {
+	CampaignID       int32           `json:"campaign_id"`
+	Title            string          `json:"title"`
+	Description      sql.NullString  `json:"description"`
+	MinAmount        decimal.Decimal `json:"min_amount"`
+	Quantity         sql.NullInt32   `json:"quantity"`
+	RequiresShipping bool            `json:"requires_shipping"`
 }
 
-const findAndLockPaymentForUpdate = `-- name: FindAndLockPaymentForUpdate :one
-SELECT id, transaction_id, donatur_id, donation_id, campaign_id, vendor, method, amount, link, note, status, response, payment_date, created_at, updated_at FROM payments WHERE transaction_id = $1 FOR UPDATE
-`
-
-func (q *Queries) FindAndLockPaymentForUpdate(ctx context.Context, transactionID uuid.UUID) (Payment, error) {
-	row := q.db.QueryRowContext(ctx, findAndLockPaymentForUpdate, transactionID)
-	var i Payment
+func (q *Queries) CreateRewardTier(ctx context.Context, arg CreateRewardTierParams) (RewardTier, error) {
+	row := q.db.QueryRowContext(ctx, createRewardTier,
+		arg.CampaignID,
+		arg.Title,
+		arg.Description,
+		arg.MinAmount,
+		arg.Quantity,
+		arg.RequiresShipping,
+	)
+	var i RewardTier
 	err := row.Scan(
 		&i.ID,
-		&i.TransactionID,
-		&i.DonaturID,
-		&i.DonationID,
 		&i.CampaignID,
-		&i.Vendor,
-		&i.Method,
-		&i.Amount,
-		&i.Link,
-		&i.Note,
-		&i.Status,
-		&i.Response,
-		&i.PaymentDate,
+		&i.Title,
+		&i.Description,
+		&i.MinAmount,
+		&i.Quantity,
+		&i.Reserved,
+		&i.Claimed,
+		&i.RequiresShipping,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const findCampaignByIdForUpdate = `-- name: FindCampaignByIdForUpdate :one
-SELECT id, user_id, status FROM campaigns
-WHERE id = $1 AND deleted_at IS NULL
-FOR UPDATE
+const createShortLink = `-- name: CreateShortLink :exec
+INSERT INTO campaign_short_links (campaign_id, code)
+VALUES ($1, $2)
+ON CONFLICT (campaign_id) DO NOTHING
 `
 
-type FindCampaignByIdForUpdateRow struct {
+type CreateShortLinkParams struct {
+	CampaignID int32  `json:"campaign_id"`
+	Code       string `json:"code"`
+}
+
+func (q *Queries) CreateShortLink(ctx context.Context, arg CreateShortLinkParams) error {
+	_, err := q.db.ExecContext(ctx, createShortLink, arg.CampaignID, arg.Code)
+	return err
+}
+
+const createSlugRedirect = `-- name: CreateSlugRedirect :exec
+INSERT INTO campaign_slug_redirects (slug, campaign_id)
+VALUES ($1, $2)
+ON CONFLICT (slug) DO NOTHING
+`
+
+type CreateSlugRedirectParams struct {
+	Slug       string `json:"slug"`
+	CampaignID int32  `json:"campaign_id"`
+}
+
+func (q *Queries) CreateSlugRedirect(ctx context.Context, arg CreateSlugRedirectParams) error {
+	_, err := q.db.ExecContext(ctx, createSlugRedirect, arg.Slug, arg.CampaignID)
+	return err
+}
+
+const declineInvitation = `-- name: DeclineInvitation :execrows
+UPDATE campaign_members
+SET status = 3, token = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 1
+`
+
+func (q *Queries) DeclineInvitation(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, declineInvitation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteCampaignLocation = `-- name: DeleteCampaignLocation :exec
+DELETE FROM campaign_locations WHERE campaign_id = $1
+`
+
+func (q *Queries) DeleteCampaignLocation(ctx context.Context, campaignID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteCampaignLocation, campaignID)
+	return err
+}
+
+const deleteCampaignMember = `-- name: DeleteCampaignMember :execrows
+DELETE FROM campaign_members
+WHERE id = $1 AND campaign_id = $2 AND role <> 1
+`
+
+type DeleteCampaignMemberParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) DeleteCampaignMember(ctx context.Context, arg DeleteCampaignMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteCampaignMember, arg.ID, arg.CampaignID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteCampaignMilestones = `-- name: DeleteCampaignMilestones :exec
+DELETE FROM campaign_milestones WHERE campaign_id = $1
+`
+
+func (q *Queries) DeleteCampaignMilestones(ctx context.Context, campaignID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteCampaignMilestones, campaignID)
+	return err
+}
+
+const deleteCampaignUpdate = `-- name: DeleteCampaignUpdate :execrows
+UPDATE campaign_updates
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteCampaignUpdateParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) DeleteCampaignUpdate(ctx context.Context, arg DeleteCampaignUpdateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteCampaignUpdate, arg.ID, arg.CampaignID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFundraiser = `-- name: DeleteFundraiser :execrows
+UPDATE campaign_fundraisers
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteFundraiserParams struct {
 	ID     int32 `json:"id"`
 	UserID int32 `json:"user_id"`
-	Status int32 `json:"status"`
 }
 
-func (q *Queries) FindCampaignByIdForUpdate(ctx context.Context, id int32) (FindCampaignByIdForUpdateRow, error) {
-	row := q.db.QueryRowContext(ctx, findCampaignByIdForUpdate, id)
-	var i FindCampaignByIdForUpdateRow
-	err := row.Scan(&i.ID, &i.UserID, &i.Status)
+func (q *Queries) DeleteFundraiser(ctx context.Context, arg DeleteFundraiserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFundraiser, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteSlugRedirect = `-- name: DeleteSlugRedirect :exec
+DELETE FROM campaign_slug_redirects WHERE slug = $1 AND campaign_id = $2
+`
+
+type DeleteSlugRedirectParams struct {
+	Slug       string `json:"slug"`
+	CampaignID int32  `json:"campaign_id"`
+}
+
+func (q *Queries) DeleteSlugRedirect(ctx context.Context, arg DeleteSlugRedirectParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSlugRedirect, arg.Slug, arg.CampaignID)
+	return err
+}
+
+const findAndLockDonationForUpdate = `-- name: FindAndLockDonationForUpdate :one
+SELECT id, donatur_id, campaign_id, amount, note, created_at, updated_at, fundraiser_id FROM donations WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) FindAndLockDonationForUpdate(ctx context.Context, id int32) (Donation, error) {
+	row := q.db.QueryRowContext(ctx, findAndLockDonationForUpdate, id)
+	var i Donation
+	err := row.Scan(
+		&i.ID,
+		&i.DonaturID,
+		&i.CampaignID,
+		&i.Amount,
+		&i.Note,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FundraiserID,
+	)
 	return i, err
 }
 
-const findCampaignsBySlugForUpdate = `-- name: FindCampaignsBySlugForUpdate :one
+const findAndLockDonationRewardForUpdate = `-- name: FindAndLockDonationRewardForUpdate :one
+SELECT id, donation_id, reward_tier_id, campaign_id, status, shipping_name, shipping_phone, shipping_address, fulfilled_at, created_at, updated_at FROM donation_rewards WHERE donation_id = $1 FOR UPDATE
+`
+
+func (q *Queries) FindAndLockDonationRewardForUpdate(ctx context.Context, donationID int32) (DonationReward, error) {
+	row := q.db.QueryRowContext(ctx, findAndLockDonationRewardForUpdate, donationID)
+	var i DonationReward
+	err := row.Scan(
+		&i.ID,
+		&i.DonationID,
+		&i.RewardTierID,
+		&i.CampaignID,
+		&i.Status,
+		&i.ShippingName,
+		&i.ShippingPhone,
+		&i.ShippingAddress,
+		&i.FulfilledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findAndLockPaymentForUpdate = `-- name: FindAndLockPaymentForUpdate :one
+SELECT id, transaction_id, donatur_id, donation_id, campaign_id, vendor, method, amount, link, note, status, response, payment_date, created_at, updated_at FROM payments WHERE transaction_id = $1 FOR UPDATE
+`
+
+func (q *Queries) FindAndLockPaymentForUpdate(ctx context.Context, transactionID uuid.UUID) (Payment, error) {
+	row := q.db.QueryRowContext(ctx, findAndLockPaymentForUpdate, transactionID)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.TransactionID,
+		&i.DonaturID,
+		&i.DonationID,
+		&i.CampaignID,
+		&i.Vendor,
+		&i.Method,
+		&i.Amount,
+		&i.Link,
+		&i.Note,
+		&i.Status,
+		&i.Response,
+		&i.PaymentDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findCampaignByIdForUpdate = `-- name: FindCampaignByIdForUpdate :one
 SELECT id, user_id, status FROM campaigns
-WHERE slug = $1 AND deleted_at IS NULL
+WHERE id = $1 AND deleted_at IS NULL
 FOR UPDATE
 `
 
-type FindCampaignsBySlugForUpdateRow struct {
+type FindCampaignByIdForUpdateRow struct {
 	ID     int32 `json:"id"`
 	UserID int32 `json:"user_id"`
 	Status int32 `json:"status"`
 }
 
-func (q *Queries) FindCampaignsBySlugForUpdate(ctx context.Context, slug string) (FindCampaignsBySlugForUpdateRow, error) {
-	row := q.db.QueryRowContext(ctx, findCampaignsBySlugForUpdate, slug)
-	var i FindCampaignsBySlugForUpdateRow
+func (q *Queries) FindCampaignByIdForUpdate(ctx context.Context, id int32) (FindCampaignByIdForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, findCampaignByIdForUpdate, id)
+	var i FindCampaignByIdForUpdateRow
 	err := row.Scan(&i.ID, &i.UserID, &i.Status)
 	return i, err
 }
 
-const getCampaignBySlug = `-- name: GetCampaignBySlug :one
+const findCampaignByPreviewToken = `-- name: FindCampaignByPreviewToken :one
 SELECT 
 campaigns.id, 
 campaigns.user_id as user_id, 
@@ -275,17 +956,22 @@ campaigns.current_amount::numeric as current_amount,
 campaigns.start_date, 
 campaigns.end_date,
 campaigns.status,
+campaigns.tags,
+campaigns.images,
+campaigns.category,
+campaigns.suspended_at,
 	users.name as user_name, users.email as user_email,
 	CASE 
 		WHEN campaigns.current_amount = 0 THEN 0 
 		ELSE campaigns.target_amount / campaigns.current_amount 
 	END::numeric AS progress
-FROM campaigns
+FROM campaign_previews p
+JOIN campaigns ON campaigns.id = p.campaign_id
 JOIN users ON campaigns.user_id = users.id
-WHERE campaigns.slug = $1
+WHERE p.token = $1 AND p.revoked_at IS NULL AND p.expires_at > CURRENT_TIMESTAMP AND campaigns.deleted_at IS NULL
 `
 
-type GetCampaignBySlugRow struct {
+type FindCampaignByPreviewTokenRow struct {
 	ID            int32           `json:"id"`
 	UserID        int32           `json:"user_id"`
 	Title         string          `json:"title"`
@@ -296,14 +982,18 @@ type GetCampaignBySlugRow struct {
 	StartDate     time.Time       `json:"start_date"`
 	EndDate       time.Time       `json:"end_date"`
 	Status        int32           `json:"status"`
+	Tags          []string        `json:"tags"`
+	Images        []string        `json:"images"`
+	Category      *string         `json:"category"`
+	SuspendedAt   sql.NullTime    `json:"suspended_at"`
 	UserName      string          `json:"user_name"`
 	UserEmail     string          `json:"user_email"`
 	Progress      decimal.Decimal `json:"progress"`
 }
 
-func (q *Queries) GetCampaignBySlug(ctx context.Context, slug string) (GetCampaignBySlugRow, error) {
-	row := q.db.QueryRowContext(ctx, getCampaignBySlug, slug)
-	var i GetCampaignBySlugRow
+func (q *Queries) FindCampaignByPreviewToken(ctx context.Context, token string) (FindCampaignByPreviewTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, findCampaignByPreviewToken, token)
+	var i FindCampaignByPreviewTokenRow
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
@@ -315,6 +1005,10 @@ func (q *Queries) GetCampaignBySlug(ctx context.Context, slug string) (GetCampai
 		&i.StartDate,
 		&i.EndDate,
 		&i.Status,
+		pq.Array(&i.Tags),
+		pq.Array(&i.Images),
+		&i.Category,
+		&i.SuspendedAt,
 		&i.UserName,
 		&i.UserEmail,
 		&i.Progress,
@@ -322,85 +1016,134 @@ func (q *Queries) GetCampaignBySlug(ctx context.Context, slug string) (GetCampai
 	return i, err
 }
 
-const getCampaignTotalPaidDonaturs = `-- name: GetCampaignTotalPaidDonaturs :one
-SELECT COUNT(*) AS total FROM donaturs
-WHERE donaturs.campaign_id IN (
-        SELECT id FROM campaigns WHERE slug  = $1 AND deleted_at IS NULL 
-    ) 
-	AND EXISTS (
-		SELECT 1 FROM payments
-		WHERE status = 5 AND donatur_id = donaturs.id
+const findCampaignForReview = `-- name: FindCampaignForReview :one
+SELECT c.id, c.title, c.status, u.email AS owner_email
+FROM campaigns c
+JOIN users u ON u.id = c.user_id
+WHERE c.id = $1 AND c.deleted_at IS NULL
+FOR UPDATE OF c
+`
+
+type FindCampaignForReviewRow struct {
+	ID         int32  `json:"id"`
+	Title      string `json:"title"`
+	Status     int32  `json:"status"`
+	OwnerEmail string `json:"owner_email"`
+}
+
+func (q *Queries) FindCampaignForReview(ctx context.Context, id int32) (FindCampaignForReviewRow, error) {
+	row := q.db.QueryRowContext(ctx, findCampaignForReview, id)
+	var i FindCampaignForReviewRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.OwnerEmail,
 	)
+	return i, err
+}
+
+const findCampaignsBySlugForUpdate = `-- name: FindCampaignsBySlugForUpdate :one
+SELECT id, user_id, status FROM campaigns
+WHERE slug = $1 AND deleted_at IS NULL
+FOR UPDATE
 `
 
-func (q *Queries) GetCampaignTotalPaidDonaturs(ctx context.Context, slug string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getCampaignTotalPaidDonaturs, slug)
-	var total int64
-	err := row.Scan(&total)
-	return total, err
+type FindCampaignsBySlugForUpdateRow struct {
+	ID     int32 `json:"id"`
+	UserID int32 `json:"user_id"`
+	Status int32 `json:"status"`
 }
 
-const getCampaigns = `-- name: GetCampaigns :many
-SELECT id, title, slug,
-		current_amount::numeric, target_amount::numeric,
-	   CASE 
-		   WHEN target_amount = 0 THEN 0 
-		   ELSE current_amount /target_amount * 100  
-	   END::numeric AS progress, 
-	   start_date, end_date,
-	   CASE
-	   	   	WHEN status = 1 THEN 'Draft'
-	   	   	WHEN status = 2 THEN 'Active'
-	   	   	WHEN status = 3 THEN 'Completed'
-	   	   	WHEN status = 4 THEN 'Cancelled'
-	   	   ELSE 'Unknown'
-	   END AS status
-FROM campaigns
-WHERE 
-	deleted_at IS NULL AND
-	status = 2 AND
-	start_date <= CURRENT_TIMESTAMP AND
-	end_date >= CURRENT_TIMESTAMP
-ORDER BY start_date DESC
-LIMIT $1 OFFSET $2
+func (q *Queries) FindCampaignsBySlugForUpdate(ctx context.Context, slug string) (FindCampaignsBySlugForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, findCampaignsBySlugForUpdate, slug)
+	var i FindCampaignsBySlugForUpdateRow
+	err := row.Scan(&i.ID, &i.UserID, &i.Status)
+	return i, err
+}
+
+const findFundraiserForDonation = `-- name: FindFundraiserForDonation :one
+SELECT f.id FROM campaign_fundraisers f
+JOIN campaigns c ON c.id = f.campaign_id
+WHERE f.slug = $1 AND f.campaign_id = $2 AND f.deleted_at IS NULL
+	AND c.deleted_at IS NULL AND c.suspended_at IS NULL AND c.status = 2
 `
 
-type GetCampaignsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type FindFundraiserForDonationParams struct {
+	Slug       string `json:"slug"`
+	CampaignID int32  `json:"campaign_id"`
 }
 
-type GetCampaignsRow struct {
+func (q *Queries) FindFundraiserForDonation(ctx context.Context, arg FindFundraiserForDonationParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, findFundraiserForDonation, arg.Slug, arg.CampaignID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const findInvitationByToken = `-- name: FindInvitationByToken :one
+SELECT id, campaign_id, user_id, email, role, status, token, invited_by, accepted_at, created_at, updated_at FROM campaign_members
+WHERE token = $1 AND status = 1
+`
+
+func (q *Queries) FindInvitationByToken(ctx context.Context, token sql.NullString) (CampaignMember, error) {
+	row := q.db.QueryRowContext(ctx, findInvitationByToken, token)
+	var i CampaignMember
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.UserID,
+		&i.Email,
+		&i.Role,
+		&i.Status,
+		&i.Token,
+		&i.InvitedBy,
+		&i.AcceptedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findMatchingPoolsForUpdate = `-- name: FindMatchingPoolsForUpdate :many
+SELECT id, ratio::numeric AS ratio, cap::numeric AS cap, matched_amount::numeric AS matched_amount
+FROM campaign_matching_pools
+WHERE campaign_id = $1
+	AND deleted_at IS NULL
+	AND confirmed_at IS NOT NULL
+	AND starts_at <= $2::timestamp
+	AND ends_at > $2::timestamp
+	AND matched_amount < cap
+ORDER BY created_at ASC, id ASC
+FOR UPDATE
+`
+
+type FindMatchingPoolsForUpdateParams struct {
+	CampaignID int32     `json:"campaign_id"`
+	DonatedAt  time.Time `json:"donated_at"`
+}
+
+type FindMatchingPoolsForUpdateRow struct {
 	ID            int32           `json:"id"`
-	Title         string          `json:"title"`
-	Slug          string          `json:"slug"`
-	CurrentAmount decimal.Decimal `json:"current_amount"`
-	TargetAmount  decimal.Decimal `json:"target_amount"`
-	Progress      decimal.Decimal `json:"progress"`
-	StartDate     time.Time       `json:"start_date"`
-	EndDate       time.Time       `json:"end_date"`
-	Status        string          `json:"status"`
+	Ratio         decimal.Decimal `json:"ratio"`
+	Cap           decimal.Decimal `json:"cap"`
+	MatchedAmount decimal.Decimal `json:"matched_amount"`
 }
 
-func (q *Queries) GetCampaigns(ctx context.Context, arg GetCampaignsParams) ([]GetCampaignsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getCampaigns, arg.Limit, arg.Offset)
+func (q *Queries) FindMatchingPoolsForUpdate(ctx context.Context, arg FindMatchingPoolsForUpdateParams) ([]FindMatchingPoolsForUpdateRow, error) {
+	rows, err := q.db.QueryContext(ctx, findMatchingPoolsForUpdate, arg.CampaignID, arg.DonatedAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetCampaignsRow
+	var items []FindMatchingPoolsForUpdateRow
 	for rows.Next() {
-		var i GetCampaignsRow
+		var i FindMatchingPoolsForUpdateRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Title,
-			&i.Slug,
-			&i.CurrentAmount,
-			&i.TargetAmount,
-			&i.Progress,
-			&i.StartDate,
-			&i.EndDate,
-			&i.Status,
+			&i.Ratio,
+			&i.Cap,
+			&i.MatchedAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -415,56 +1158,2613 @@ func (q *Queries) GetCampaigns(ctx context.Context, arg GetCampaignsParams) ([]G
 	return items, nil
 }
 
-const getPaginatedDonaturs = `-- name: GetPaginatedDonaturs :many
-SELECT 
-	d.id, 
-	d.name, 
-	COALESCE(d.email, '') AS email,
-	p.amount::numeric AS total_donated
-FROM donaturs d
-JOIN (
-	SELECT donatur_id, SUM(amount) AS amount
-	FROM donations
-	GROUP BY donatur_id
-) p ON d.id = p.donatur_id
-WHERE d.campaign_id = (
-	SELECT id FROM campaigns WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
-)
-AND EXISTS (
-	SELECT 1 FROM payments
-	WHERE status = 5 AND donatur_id = d.id
-)
-ORDER BY d.created_at DESC
-LIMIT $2 OFFSET $3
+const findRedirectedSlug = `-- name: FindRedirectedSlug :one
+SELECT c.slug AS current_slug FROM campaign_slug_redirects r
+JOIN campaigns c ON c.id = r.campaign_id
+WHERE r.slug = $1 AND c.deleted_at IS NULL AND c.approved_at IS NOT NULL
 `
 
-type GetPaginatedDonatursParams struct {
-	Slug   string `json:"slug"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+func (q *Queries) FindRedirectedSlug(ctx context.Context, slug string) (string, error) {
+	row := q.db.QueryRowContext(ctx, findRedirectedSlug, slug)
+	var current_slug string
+	err := row.Scan(&current_slug)
+	return current_slug, err
 }
 
-type GetPaginatedDonatursRow struct {
-	ID           int32           `json:"id"`
-	Name         string          `json:"name"`
-	Email        string          `json:"email"`
-	TotalDonated decimal.Decimal `json:"total_donated"`
-}
+const findRewardTierForUpdate = `-- name: FindRewardTierForUpdate :one
+SELECT id, campaign_id, title, description, min_amount, quantity, reserved, claimed, requires_shipping, created_at, updated_at, deleted_at FROM reward_tiers
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type FindRewardTierForUpdateParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) FindRewardTierForUpdate(ctx context.Context, arg FindRewardTierForUpdateParams) (RewardTier, error) {
+	row := q.db.QueryRowContext(ctx, findRewardTierForUpdate, arg.ID, arg.CampaignID)
+	var i RewardTier
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.Title,
+		&i.Description,
+		&i.MinAmount,
+		&i.Quantity,
+		&i.Reserved,
+		&i.Claimed,
+		&i.RequiresShipping,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getActiveMatchingPools = `-- name: GetActiveMatchingPools :many
+SELECT id, sponsor_name, ratio::numeric AS ratio, cap::numeric AS cap, matched_amount::numeric AS matched_amount, starts_at, ends_at
+FROM campaign_matching_pools
+WHERE campaign_id = $1
+	AND deleted_at IS NULL
+	AND confirmed_at IS NOT NULL
+	AND starts_at <= CURRENT_TIMESTAMP
+	AND ends_at > CURRENT_TIMESTAMP
+	AND matched_amount < cap
+ORDER BY ends_at ASC, id ASC
+`
+
+type GetActiveMatchingPoolsRow struct {
+	ID            int32           `json:"id"`
+	SponsorName   string          `json:"sponsor_name"`
+	Ratio         decimal.Decimal `json:"ratio"`
+	Cap           decimal.Decimal `json:"cap"`
+	MatchedAmount decimal.Decimal `json:"matched_amount"`
+	StartsAt      time.Time       `json:"starts_at"`
+	EndsAt        time.Time       `json:"ends_at"`
+}
+
+func (q *Queries) GetActiveMatchingPools(ctx context.Context, campaignID int32) ([]GetActiveMatchingPoolsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveMatchingPools, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActiveMatchingPoolsRow
+	for rows.Next() {
+		var i GetActiveMatchingPoolsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SponsorName,
+			&i.Ratio,
+			&i.Cap,
+			&i.MatchedAmount,
+			&i.StartsAt,
+			&i.EndsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignBySlug = `-- name: GetCampaignBySlug :one
+SELECT 
+campaigns.id, 
+campaigns.user_id as user_id, 
+campaigns.title, 
+campaigns.description, 
+campaigns.slug, 
+campaigns.target_amount::numeric as target_amount, 
+campaigns.current_amount::numeric as current_amount, 
+campaigns.start_date, 
+campaigns.end_date,
+campaigns.status,
+campaigns.tags,
+campaigns.images,
+campaigns.category,
+campaigns.suspended_at,
+	users.name as user_name, users.email as user_email,
+	CASE 
+		WHEN campaigns.current_amount = 0 THEN 0 
+		ELSE campaigns.target_amount / campaigns.current_amount 
+	END::numeric AS progress
+FROM campaigns
+JOIN users ON campaigns.user_id = users.id
+WHERE campaigns.slug = $1 AND campaigns.approved_at IS NOT NULL AND campaigns.deleted_at IS NULL
+`
+
+type GetCampaignBySlugRow struct {
+	ID            int32           `json:"id"`
+	UserID        int32           `json:"user_id"`
+	Title         string          `json:"title"`
+	Description   *string         `json:"description"`
+	Slug          string          `json:"slug"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	StartDate     time.Time       `json:"start_date"`
+	EndDate       time.Time       `json:"end_date"`
+	Status        int32           `json:"status"`
+	Tags          []string        `json:"tags"`
+	Images        []string        `json:"images"`
+	Category      *string         `json:"category"`
+	SuspendedAt   sql.NullTime    `json:"suspended_at"`
+	UserName      string          `json:"user_name"`
+	UserEmail     string          `json:"user_email"`
+	Progress      decimal.Decimal `json:"progress"`
+}
+
+func (q *Queries) GetCampaignBySlug(ctx context.Context, slug string) (GetCampaignBySlugRow, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignBySlug, slug)
+	var i GetCampaignBySlugRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Description,
+		&i.Slug,
+		&i.TargetAmount,
+		&i.CurrentAmount,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Status,
+		pq.Array(&i.Tags),
+		pq.Array(&i.Images),
+		&i.Category,
+		&i.SuspendedAt,
+		&i.UserName,
+		&i.UserEmail,
+		&i.Progress,
+	)
+	return i, err
+}
+
+const getCampaignCategoryStats = `-- name: GetCampaignCategoryStats :many
+SELECT
+	COALESCE(c.category, 'other')::text AS category,
+	COUNT(*) FILTER (
+		WHERE c.suspended_at IS NULL
+			AND c.status = 2
+			AND c.start_date <= CURRENT_TIMESTAMP
+			AND c.end_date >= CURRENT_TIMESTAMP
+	) AS active_campaigns,
+	COUNT(*) FILTER (WHERE c.current_amount >= c.target_amount) AS funded_campaigns,
+	COALESCE(SUM(c.current_amount), 0)::numeric AS total_raised
+FROM campaigns c
+WHERE c.deleted_at IS NULL AND c.approved_at IS NOT NULL
+GROUP BY 1
+`
+
+type GetCampaignCategoryStatsRow struct {
+	Category        string          `json:"category"`
+	ActiveCampaigns int64           `json:"active_campaigns"`
+	FundedCampaigns int64           `json:"funded_campaigns"`
+	TotalRaised     decimal.Decimal `json:"total_raised"`
+}
+
+func (q *Queries) GetCampaignCategoryStats(ctx context.Context) ([]GetCampaignCategoryStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignCategoryStats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignCategoryStatsRow
+	for rows.Next() {
+		var i GetCampaignCategoryStatsRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.ActiveCampaigns,
+			&i.FundedCampaigns,
+			&i.TotalRaised,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignDailyDonations = `-- name: GetCampaignDailyDonations :many
+SELECT d.day::date AS day, COUNT(p.id) AS donations, COALESCE(SUM(p.amount), 0)::numeric AS total
+FROM generate_series($1::date, $2::date, interval '1 day') AS d(day)
+LEFT JOIN payments p ON p.campaign_id = $3
+	AND p.status = 5
+	AND COALESCE(p.payment_date, p.updated_at)::date = d.day::date
+GROUP BY d.day
+ORDER BY d.day
+`
+
+type GetCampaignDailyDonationsParams struct {
+	FromDate   time.Time `json:"from_date"`
+	ToDate     time.Time `json:"to_date"`
+	CampaignID int32     `json:"campaign_id"`
+}
+
+type GetCampaignDailyDonationsRow struct {
+	Day       time.Time       `json:"day"`
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+}
+
+func (q *Queries) GetCampaignDailyDonations(ctx context.Context, arg GetCampaignDailyDonationsParams) ([]GetCampaignDailyDonationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignDailyDonations, arg.FromDate, arg.ToDate, arg.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignDailyDonationsRow
+	for rows.Next() {
+		var i GetCampaignDailyDonationsRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Donations,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignDonationFunnel = `-- name: GetCampaignDonationFunnel :one
+SELECT COUNT(dn.id) AS intents,
+	   COUNT(p.id) FILTER (WHERE p.link IS NOT NULL) AS invoices,
+	   COUNT(p.id) FILTER (WHERE p.status = 5) AS paid
+FROM donations dn
+LEFT JOIN payments p ON p.donation_id = dn.id
+WHERE dn.campaign_id = $1
+	AND dn.created_at >= $2::date
+	AND dn.created_at < $3::date + 1
+`
+
+type GetCampaignDonationFunnelParams struct {
+	CampaignID int32     `json:"campaign_id"`
+	FromDate   time.Time `json:"from_date"`
+	ToDate     time.Time `json:"to_date"`
+}
+
+type GetCampaignDonationFunnelRow struct {
+	Intents  int64 `json:"intents"`
+	Invoices int64 `json:"invoices"`
+	Paid     int64 `json:"paid"`
+}
+
+func (q *Queries) GetCampaignDonationFunnel(ctx context.Context, arg GetCampaignDonationFunnelParams) (GetCampaignDonationFunnelRow, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignDonationFunnel, arg.CampaignID, arg.FromDate, arg.ToDate)
+	var i GetCampaignDonationFunnelRow
+	err := row.Scan(
+		&i.Intents,
+		&i.Invoices,
+		&i.Paid,
+	)
+	return i, err
+}
+
+const getCampaignDonationSummary = `-- name: GetCampaignDonationSummary :one
+SELECT COUNT(*) AS donations,
+	   COALESCE(SUM(amount), 0)::numeric AS total,
+	   COALESCE(AVG(amount), 0)::numeric AS average,
+	   COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY amount), 0)::numeric AS median,
+	   COALESCE(MIN(amount), 0)::numeric AS smallest,
+	   COALESCE(MAX(amount), 0)::numeric AS largest
+FROM payments
+WHERE campaign_id = $1
+	AND status = 5
+	AND COALESCE(payment_date, updated_at) >= $2::date
+	AND COALESCE(payment_date, updated_at) < $3::date + 1
+`
+
+type GetCampaignDonationSummaryParams struct {
+	CampaignID int32     `json:"campaign_id"`
+	FromDate   time.Time `json:"from_date"`
+	ToDate     time.Time `json:"to_date"`
+}
+
+type GetCampaignDonationSummaryRow struct {
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+	Average   decimal.Decimal `json:"average"`
+	Median    decimal.Decimal `json:"median"`
+	Smallest  decimal.Decimal `json:"smallest"`
+	Largest   decimal.Decimal `json:"largest"`
+}
+
+func (q *Queries) GetCampaignDonationSummary(ctx context.Context, arg GetCampaignDonationSummaryParams) (GetCampaignDonationSummaryRow, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignDonationSummary, arg.CampaignID, arg.FromDate, arg.ToDate)
+	var i GetCampaignDonationSummaryRow
+	err := row.Scan(
+		&i.Donations,
+		&i.Total,
+		&i.Average,
+		&i.Median,
+		&i.Smallest,
+		&i.Largest,
+	)
+	return i, err
+}
+
+const getCampaignFundraisers = `-- name: GetCampaignFundraisers :many
+SELECT
+	f.id, f.slug, f.title,
+	f.target_amount::numeric AS target_amount,
+	f.current_amount::numeric AS current_amount,
+	f.created_at::timestamp AS created_at,
+	u.name AS owner_name
+FROM campaign_fundraisers f
+JOIN users u ON u.id = f.user_id
+WHERE f.campaign_id = $1 AND f.deleted_at IS NULL
+ORDER BY f.current_amount DESC, f.created_at ASC, f.id ASC
+LIMIT $2
+`
+
+type GetCampaignFundraisersParams struct {
+	CampaignID int32 `json:"campaign_id"`
+	Limit      int32 `json:"limit"`
+}
+
+type GetCampaignFundraisersRow struct {
+	ID            int32           `json:"id"`
+	Slug          string          `json:"slug"`
+	Title         string          `json:"title"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	CreatedAt     time.Time       `json:"created_at"`
+	OwnerName     string          `json:"owner_name"`
+}
+
+func (q *Queries) GetCampaignFundraisers(ctx context.Context, arg GetCampaignFundraisersParams) ([]GetCampaignFundraisersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignFundraisers, arg.CampaignID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignFundraisersRow
+	for rows.Next() {
+		var i GetCampaignFundraisersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.TargetAmount,
+			&i.CurrentAmount,
+			&i.CreatedAt,
+			&i.OwnerName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignLeaderboard = `-- name: GetCampaignLeaderboard :many
+SELECT
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE MAX(d.name) END)::text AS name,
+	d.is_anonymous,
+	COUNT(p.id) AS donations,
+	SUM(p.amount)::numeric AS total
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+WHERE p.campaign_id = $1 AND p.status = 5
+GROUP BY d.user_id, d.is_anonymous
+ORDER BY total DESC, MIN(COALESCE(p.payment_date, p.updated_at)) ASC
+LIMIT $2
+`
+
+type GetCampaignLeaderboardParams struct {
+	CampaignID int32 `json:"campaign_id"`
+	Limit      int32 `json:"limit"`
+}
+
+type GetCampaignLeaderboardRow struct {
+	Name        string          `json:"name"`
+	IsAnonymous bool            `json:"is_anonymous"`
+	Donations   int64           `json:"donations"`
+	Total       decimal.Decimal `json:"total"`
+}
+
+func (q *Queries) GetCampaignLeaderboard(ctx context.Context, arg GetCampaignLeaderboardParams) ([]GetCampaignLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignLeaderboard, arg.CampaignID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignLeaderboardRow
+	for rows.Next() {
+		var i GetCampaignLeaderboardRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.IsAnonymous,
+			&i.Donations,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignLocation = `-- name: GetCampaignLocation :one
+SELECT campaign_id, province, city, latitude, longitude, created_at, updated_at FROM campaign_locations WHERE campaign_id = $1
+`
+
+func (q *Queries) GetCampaignLocation(ctx context.Context, campaignID int32) (CampaignLocation, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignLocation, campaignID)
+	var i CampaignLocation
+	err := row.Scan(
+		&i.CampaignID,
+		&i.Province,
+		&i.City,
+		&i.Latitude,
+		&i.Longitude,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCampaignMatchingPools = `-- name: GetCampaignMatchingPools :many
+SELECT id, campaign_id, sponsor_name, sponsor_email, ratio, cap, matched_amount, starts_at, ends_at, created_by, statement_sent_at, created_at, updated_at, deleted_at, confirmation_token, confirmed_at FROM campaign_matching_pools
+WHERE campaign_id = $1 AND deleted_at IS NULL
+ORDER BY starts_at ASC, id ASC
+`
+
+func (q *Queries) GetCampaignMatchingPools(ctx context.Context, campaignID int32) ([]CampaignMatchingPool, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignMatchingPools, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CampaignMatchingPool
+	for rows.Next() {
+		var i CampaignMatchingPool
+		if err := rows.Scan(
+			&i.ID,
+			&i.CampaignID,
+			&i.SponsorName,
+			&i.SponsorEmail,
+			&i.Ratio,
+			&i.Cap,
+			&i.MatchedAmount,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedBy,
+			&i.StatementSentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ConfirmationToken,
+			&i.ConfirmedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignMembers = `-- name: GetCampaignMembers :many
+SELECT cm.id, cm.user_id, cm.email, COALESCE(u.name, '')::text AS name, cm.role, cm.status, cm.accepted_at, cm.created_at::TIMESTAMP
+FROM campaign_members cm
+LEFT JOIN users u ON u.id = cm.user_id
+WHERE cm.campaign_id = $1 AND cm.status <> 3
+ORDER BY cm.role ASC, cm.id ASC
+`
+
+type GetCampaignMembersRow struct {
+	ID         int32         `json:"id"`
+	UserID     sql.NullInt32 `json:"user_id"`
+	Email      string        `json:"email"`
+	Name       string        `json:"name"`
+	Role       int32         `json:"role"`
+	Status     int32         `json:"status"`
+	AcceptedAt sql.NullTime  `json:"accepted_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+func (q *Queries) GetCampaignMembers(ctx context.Context, campaignID int32) ([]GetCampaignMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignMembers, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignMembersRow
+	for rows.Next() {
+		var i GetCampaignMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Email,
+			&i.Name,
+			&i.Role,
+			&i.Status,
+			&i.AcceptedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignMilestones = `-- name: GetCampaignMilestones :many
+SELECT
+	m.id,
+	m.amount::numeric AS amount,
+	m.description,
+	(m.amount > c.target_amount)::boolean AS stretch,
+	m.reached_at
+FROM campaign_milestones m
+JOIN campaigns c ON c.id = m.campaign_id
+WHERE m.campaign_id = $1
+ORDER BY m.amount ASC
+`
+
+type GetCampaignMilestonesRow struct {
+	ID          int32           `json:"id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	Stretch     bool            `json:"stretch"`
+	ReachedAt   sql.NullTime    `json:"reached_at"`
+}
+
+func (q *Queries) GetCampaignMilestones(ctx context.Context, campaignID int32) ([]GetCampaignMilestonesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignMilestones, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignMilestonesRow
+	for rows.Next() {
+		var i GetCampaignMilestonesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.Description,
+			&i.Stretch,
+			&i.ReachedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignOwnerContact = `-- name: GetCampaignOwnerContact :one
+SELECT c.title, c.slug, u.name AS owner_name, u.email AS owner_email
+FROM campaigns c
+JOIN users u ON u.id = c.user_id
+WHERE c.id = $1 AND c.deleted_at IS NULL
+`
+
+type GetCampaignOwnerContactRow struct {
+	Title      string `json:"title"`
+	Slug       string `json:"slug"`
+	OwnerName  string `json:"owner_name"`
+	OwnerEmail string `json:"owner_email"`
+}
+
+func (q *Queries) GetCampaignOwnerContact(ctx context.Context, id int32) (GetCampaignOwnerContactRow, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignOwnerContact, id)
+	var i GetCampaignOwnerContactRow
+	err := row.Scan(
+		&i.Title,
+		&i.Slug,
+		&i.OwnerName,
+		&i.OwnerEmail,
+	)
+	return i, err
+}
+
+const getCampaignPaymentMethods = `-- name: GetCampaignPaymentMethods :many
+SELECT COALESCE(method, 'unknown')::text AS method, COUNT(*) AS donations, SUM(amount)::numeric AS total
+FROM payments
+WHERE campaign_id = $1
+	AND status = 5
+	AND COALESCE(payment_date, updated_at) >= $2::date
+	AND COALESCE(payment_date, updated_at) < $3::date + 1
+GROUP BY 1
+ORDER BY total DESC
+`
+
+type GetCampaignPaymentMethodsParams struct {
+	CampaignID int32     `json:"campaign_id"`
+	FromDate   time.Time `json:"from_date"`
+	ToDate     time.Time `json:"to_date"`
+}
+
+type GetCampaignPaymentMethodsRow struct {
+	Method    string          `json:"method"`
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+}
+
+func (q *Queries) GetCampaignPaymentMethods(ctx context.Context, arg GetCampaignPaymentMethodsParams) ([]GetCampaignPaymentMethodsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignPaymentMethods, arg.CampaignID, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignPaymentMethodsRow
+	for rows.Next() {
+		var i GetCampaignPaymentMethodsRow
+		if err := rows.Scan(
+			&i.Method,
+			&i.Donations,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignPreviews = `-- name: GetCampaignPreviews :many
+SELECT p.id, p.token, p.expires_at, p.revoked_at, COALESCE(u.name, '')::text AS created_by_name, p.created_at::TIMESTAMP
+FROM campaign_previews p
+LEFT JOIN users u ON u.id = p.created_by
+WHERE p.campaign_id = $1
+ORDER BY p.id DESC
+`
+
+type GetCampaignPreviewsRow struct {
+	ID            int32        `json:"id"`
+	Token         string       `json:"token"`
+	ExpiresAt     time.Time    `json:"expires_at"`
+	RevokedAt     sql.NullTime `json:"revoked_at"`
+	CreatedByName string       `json:"created_by_name"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+func (q *Queries) GetCampaignPreviews(ctx context.Context, campaignID int32) ([]GetCampaignPreviewsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignPreviews, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignPreviewsRow
+	for rows.Next() {
+		var i GetCampaignPreviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Token,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedByName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignReports = `-- name: GetCampaignReports :many
+SELECT id, category, description, status, resolution_note, resolved_at, created_at::TIMESTAMP
+FROM campaign_reports
+WHERE campaign_id = $1
+ORDER BY id DESC
+`
+
+type GetCampaignReportsRow struct {
+	ID             int32          `json:"id"`
+	Category       string         `json:"category"`
+	Description    sql.NullString `json:"description"`
+	Status         int32          `json:"status"`
+	ResolutionNote sql.NullString `json:"resolution_note"`
+	ResolvedAt     sql.NullTime   `json:"resolved_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+func (q *Queries) GetCampaignReports(ctx context.Context, campaignID int32) ([]GetCampaignReportsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignReports, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignReportsRow
+	for rows.Next() {
+		var i GetCampaignReportsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Category,
+			&i.Description,
+			&i.Status,
+			&i.ResolutionNote,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignReviews = `-- name: GetCampaignReviews :many
+SELECT r.id, r.decision, r.reason, COALESCE(u.name, '')::text AS reviewer_name, r.created_at::TIMESTAMP
+FROM campaign_reviews r
+LEFT JOIN users u ON u.id = r.reviewer_id
+WHERE r.campaign_id = $1
+ORDER BY r.id DESC
+`
+
+type GetCampaignReviewsRow struct {
+	ID           int32     `json:"id"`
+	Decision     int32     `json:"decision"`
+	Reason       string    `json:"reason"`
+	ReviewerName string    `json:"reviewer_name"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (q *Queries) GetCampaignReviews(ctx context.Context, campaignID int32) ([]GetCampaignReviewsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignReviews, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignReviewsRow
+	for rows.Next() {
+		var i GetCampaignReviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Decision,
+			&i.Reason,
+			&i.ReviewerName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignRevision = `-- name: GetCampaignRevision :one
+SELECT r.id, r.revision, r.title, r.description, r.slug, r.target_amount, r.start_date, r.end_date, r.status, r.images, r.tags,
+	r.author_id, COALESCE(u.name, '')::text AS author_name, r.created_at
+FROM campaign_revisions r
+LEFT JOIN users u ON u.id = r.author_id
+WHERE r.campaign_id = $1 AND r.revision = $2
+`
+
+type GetCampaignRevisionParams struct {
+	CampaignID int32 `json:"campaign_id"`
+	Revision   int32 `json:"revision"`
+}
+
+type GetCampaignRevisionRow struct {
+	ID           int32           `json:"id"`
+	Revision     int32           `json:"revision"`
+	Title        string          `json:"title"`
+	Description  sql.NullString  `json:"description"`
+	Slug         string          `json:"slug"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Status       int32           `json:"status"`
+	Images       []string        `json:"images"`
+	Tags         []string        `json:"tags"`
+	AuthorID     sql.NullInt32   `json:"author_id"`
+	AuthorName   string          `json:"author_name"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+func (q *Queries) GetCampaignRevision(ctx context.Context, arg GetCampaignRevisionParams) (GetCampaignRevisionRow, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignRevision, arg.CampaignID, arg.Revision)
+	var i GetCampaignRevisionRow
+	err := row.Scan(
+		&i.ID,
+		&i.Revision,
+		&i.Title,
+		&i.Description,
+		&i.Slug,
+		&i.TargetAmount,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Status,
+		pq.Array(&i.Images),
+		pq.Array(&i.Tags),
+		&i.AuthorID,
+		&i.AuthorName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCampaignRevisions = `-- name: GetCampaignRevisions :many
+SELECT r.id, r.revision, r.title, r.status, r.author_id, COALESCE(u.name, '')::text AS author_name, r.created_at
+FROM campaign_revisions r
+LEFT JOIN users u ON u.id = r.author_id
+WHERE r.campaign_id = $1
+ORDER BY r.revision DESC
+`
+
+type GetCampaignRevisionsRow struct {
+	ID         int32         `json:"id"`
+	Revision   int32         `json:"revision"`
+	Title      string        `json:"title"`
+	Status     int32         `json:"status"`
+	AuthorID   sql.NullInt32 `json:"author_id"`
+	AuthorName string        `json:"author_name"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+func (q *Queries) GetCampaignRevisions(ctx context.Context, campaignID int32) ([]GetCampaignRevisionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignRevisions, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignRevisionsRow
+	for rows.Next() {
+		var i GetCampaignRevisionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Revision,
+			&i.Title,
+			&i.Status,
+			&i.AuthorID,
+			&i.AuthorName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignRewardTiers = `-- name: GetCampaignRewardTiers :many
+SELECT id, campaign_id, title, description, min_amount, quantity, reserved, claimed, requires_shipping, created_at, updated_at, deleted_at FROM reward_tiers
+WHERE campaign_id = $1 AND deleted_at IS NULL
+ORDER BY min_amount ASC, id ASC
+`
+
+func (q *Queries) GetCampaignRewardTiers(ctx context.Context, campaignID int32) ([]RewardTier, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignRewardTiers, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RewardTier
+	for rows.Next() {
+		var i RewardTier
+		if err := rows.Scan(
+			&i.ID,
+			&i.CampaignID,
+			&i.Title,
+			&i.Description,
+			&i.MinAmount,
+			&i.Quantity,
+			&i.Reserved,
+			&i.Claimed,
+			&i.RequiresShipping,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignTopDonors = `-- name: GetCampaignTopDonors :many
+SELECT
+	(CASE WHEN d.is_anonymous THEN 0 ELSE d.user_id END)::int AS user_id,
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE MAX(d.name) END)::text AS name,
+	d.is_anonymous,
+	COUNT(p.id) AS donations,
+	SUM(p.amount)::numeric AS total
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+WHERE p.campaign_id = $1
+	AND p.status = 5
+	AND COALESCE(p.payment_date, p.updated_at) >= $2::date
+	AND COALESCE(p.payment_date, p.updated_at) < $3::date + 1
+GROUP BY d.user_id, d.is_anonymous
+ORDER BY total DESC, d.user_id ASC
+LIMIT $4
+`
+
+type GetCampaignTopDonorsParams struct {
+	CampaignID int32     `json:"campaign_id"`
+	FromDate   time.Time `json:"from_date"`
+	ToDate     time.Time `json:"to_date"`
+	Limit      int32     `json:"limit"`
+}
+
+type GetCampaignTopDonorsRow struct {
+	UserID      int32           `json:"user_id"`
+	Name        string          `json:"name"`
+	IsAnonymous bool            `json:"is_anonymous"`
+	Donations   int64           `json:"donations"`
+	Total       decimal.Decimal `json:"total"`
+}
+
+func (q *Queries) GetCampaignTopDonors(ctx context.Context, arg GetCampaignTopDonorsParams) ([]GetCampaignTopDonorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignTopDonors,
+		arg.CampaignID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignTopDonorsRow
+	for rows.Next() {
+		var i GetCampaignTopDonorsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Name,
+			&i.IsAnonymous,
+			&i.Donations,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCampaignTotalPaidDonaturs = `-- name: GetCampaignTotalPaidDonaturs :one
+SELECT COUNT(*) AS total FROM donaturs
+WHERE donaturs.campaign_id IN (
+        SELECT id FROM campaigns WHERE slug  = $1 AND deleted_at IS NULL 
+    ) 
+	AND EXISTS (
+		SELECT 1 FROM payments
+		WHERE status = 5 AND donatur_id = donaturs.id
+	)
+`
+
+func (q *Queries) GetCampaignTotalPaidDonaturs(ctx context.Context, slug string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getCampaignTotalPaidDonaturs, slug)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getCampaignUpdates = `-- name: GetCampaignUpdates :many
+SELECT u.id, u.title, u.body, COALESCE(users.name, '')::text AS author_name,
+	   u.created_at::TIMESTAMP, u.updated_at::TIMESTAMP
+FROM campaign_updates u
+LEFT JOIN users ON users.id = u.author_id
+WHERE u.campaign_id = $1 AND u.deleted_at IS NULL
+ORDER BY u.created_at DESC, u.id DESC
+LIMIT $2
+`
+
+type GetCampaignUpdatesParams struct {
+	CampaignID int32 `json:"campaign_id"`
+	Limit      int32 `json:"limit"`
+}
+
+type GetCampaignUpdatesRow struct {
+	ID         int32     `json:"id"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	AuthorName string    `json:"author_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (q *Queries) GetCampaignUpdates(ctx context.Context, arg GetCampaignUpdatesParams) ([]GetCampaignUpdatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCampaignUpdates, arg.CampaignID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCampaignUpdatesRow
+	for rows.Next() {
+		var i GetCampaignUpdatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Body,
+			&i.AuthorName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDonationCategoryStats = `-- name: GetDonationCategoryStats :many
+SELECT
+	COALESCE(c.category, 'other')::text AS category,
+	COUNT(p.id) AS donations,
+	COUNT(DISTINCT d.user_id) AS donors
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.status = 5 AND c.deleted_at IS NULL
+GROUP BY 1
+`
+
+type GetDonationCategoryStatsRow struct {
+	Category  string `json:"category"`
+	Donations int64  `json:"donations"`
+	Donors    int64  `json:"donors"`
+}
+
+func (q *Queries) GetDonationCategoryStats(ctx context.Context) ([]GetDonationCategoryStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDonationCategoryStats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDonationCategoryStatsRow
+	for rows.Next() {
+		var i GetDonationCategoryStatsRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.Donations,
+			&i.Donors,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDonatursAfterCursor = `-- name: GetDonatursAfterCursor :many
+SELECT 
+	d.id, 
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END)::text AS name, 
+	(CASE WHEN d.is_anonymous THEN '' ELSE COALESCE(d.email, '') END)::text AS email,
+	p.amount::numeric AS total_donated,
+	COALESCE((
+		SELECT (CASE dd.type WHEN 'memory' THEN 'In memory of ' ELSE 'In honor of ' END) || dd.honoree_name
+		FROM donation_dedications dd
+		JOIN donations dn ON dn.id = dd.donation_id
+		WHERE dn.donatur_id = d.id AND dd.is_public
+		ORDER BY dd.id DESC
+		LIMIT 1
+	), '')::text AS dedication,
+	d.created_at::timestamp AS created_at
+FROM donaturs d
+JOIN (
+	SELECT donatur_id, SUM(amount) AS amount
+	FROM donations
+	GROUP BY donatur_id
+) p ON d.id = p.donatur_id
+WHERE d.campaign_id = (
+	SELECT id FROM campaigns WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+)
+AND EXISTS (
+	SELECT 1 FROM payments
+	WHERE status = 5 AND donatur_id = d.id
+)
+AND (
+	$3::integer IS NULL OR
+	(d.created_at, d.id) < ($4::timestamp, $3::integer)
+)
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT $2
+`
+
+type GetDonatursAfterCursorParams struct {
+	Slug            string        `json:"slug"`
+	Limit           int32         `json:"limit"`
+	CursorID        sql.NullInt32 `json:"cursor_id"`
+	CursorCreatedAt sql.NullTime  `json:"cursor_created_at"`
+}
+
+type GetDonatursAfterCursorRow struct {
+	ID           int32           `json:"id"`
+	Name         string          `json:"name"`
+	Email        string          `json:"email"`
+	TotalDonated decimal.Decimal `json:"total_donated"`
+	Dedication   string          `json:"dedication"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+func (q *Queries) GetDonatursAfterCursor(ctx context.Context, arg GetDonatursAfterCursorParams) ([]GetDonatursAfterCursorRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDonatursAfterCursor,
+		arg.Slug,
+		arg.Limit,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDonatursAfterCursorRow
+	for rows.Next() {
+		var i GetDonatursAfterCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.TotalDonated,
+			&i.Dedication,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDonatursBeforeCursor = `-- name: GetDonatursBeforeCursor :many
+SELECT 
+	d.id, 
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END)::text AS name, 
+	(CASE WHEN d.is_anonymous THEN '' ELSE COALESCE(d.email, '') END)::text AS email,
+	p.amount::numeric AS total_donated,
+	COALESCE((
+		SELECT (CASE dd.type WHEN 'memory' THEN 'In memory of ' ELSE 'In honor of ' END) || dd.honoree_name
+		FROM donation_dedications dd
+		JOIN donations dn ON dn.id = dd.donation_id
+		WHERE dn.donatur_id = d.id AND dd.is_public
+		ORDER BY dd.id DESC
+		LIMIT 1
+	), '')::text AS dedication,
+	d.created_at::timestamp AS created_at
+FROM donaturs d
+JOIN (
+	SELECT donatur_id, SUM(amount) AS amount
+	FROM donations
+	GROUP BY donatur_id
+) p ON d.id = p.donatur_id
+WHERE d.campaign_id = (
+	SELECT id FROM campaigns WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+)
+AND EXISTS (
+	SELECT 1 FROM payments
+	WHERE status = 5 AND donatur_id = d.id
+)
+AND (d.created_at, d.id) > ($3::timestamp, $4::integer)
+ORDER BY d.created_at ASC, d.id ASC
+LIMIT $2
+`
+
+type GetDonatursBeforeCursorParams struct {
+	Slug            string    `json:"slug"`
+	Limit           int32     `json:"limit"`
+	CursorCreatedAt time.Time `json:"cursor_created_at"`
+	CursorID        int32     `json:"cursor_id"`
+}
+
+type GetDonatursBeforeCursorRow struct {
+	ID           int32           `json:"id"`
+	Name         string          `json:"name"`
+	Email        string          `json:"email"`
+	TotalDonated decimal.Decimal `json:"total_donated"`
+	Dedication   string          `json:"dedication"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+func (q *Queries) GetDonatursBeforeCursor(ctx context.Context, arg GetDonatursBeforeCursorParams) ([]GetDonatursBeforeCursorRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDonatursBeforeCursor,
+		arg.Slug,
+		arg.Limit,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDonatursBeforeCursorRow
+	for rows.Next() {
+		var i GetDonatursBeforeCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.TotalDonated,
+			&i.Dedication,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFundraiserBySlug = `-- name: GetFundraiserBySlug :one
+SELECT
+	f.id, f.slug, f.title, f.story,
+	f.target_amount::numeric AS target_amount,
+	f.current_amount::numeric AS current_amount,
+	f.created_at::timestamp AS created_at,
+	u.name AS owner_name,
+	c.id AS campaign_id, c.slug AS campaign_slug, c.title AS campaign_title,
+	(SELECT COUNT(*) FROM donations d JOIN payments p ON p.donation_id = d.id WHERE d.fundraiser_id = f.id AND p.status = 5) AS donations
+FROM campaign_fundraisers f
+JOIN campaigns c ON c.id = f.campaign_id
+JOIN users u ON u.id = f.user_id
+WHERE f.slug = $1 AND f.deleted_at IS NULL
+	AND c.deleted_at IS NULL AND c.approved_at IS NOT NULL AND c.suspended_at IS NULL
+`
+
+type GetFundraiserBySlugRow struct {
+	ID            int32           `json:"id"`
+	Slug          string          `json:"slug"`
+	Title         string          `json:"title"`
+	Story         string          `json:"story"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	CreatedAt     time.Time       `json:"created_at"`
+	OwnerName     string          `json:"owner_name"`
+	CampaignID    int32           `json:"campaign_id"`
+	CampaignSlug  string          `json:"campaign_slug"`
+	CampaignTitle string          `json:"campaign_title"`
+	Donations     int64           `json:"donations"`
+}
+
+func (q *Queries) GetFundraiserBySlug(ctx context.Context, slug string) (GetFundraiserBySlugRow, error) {
+	row := q.db.QueryRowContext(ctx, getFundraiserBySlug, slug)
+	var i GetFundraiserBySlugRow
+	err := row.Scan(
+		&i.ID,
+		&i.Slug,
+		&i.Title,
+		&i.Story,
+		&i.TargetAmount,
+		&i.CurrentAmount,
+		&i.CreatedAt,
+		&i.OwnerName,
+		&i.CampaignID,
+		&i.CampaignSlug,
+		&i.CampaignTitle,
+		&i.Donations,
+	)
+	return i, err
+}
+
+const getLatestCampaignRevision = `-- name: GetLatestCampaignRevision :one
+SELECT revision, created_at FROM campaign_revisions
+WHERE campaign_id = $1
+ORDER BY revision DESC
+LIMIT 1
+`
+
+type GetLatestCampaignRevisionRow struct {
+	Revision  int32     `json:"revision"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) GetLatestCampaignRevision(ctx context.Context, campaignID int32) (GetLatestCampaignRevisionRow, error) {
+	row := q.db.QueryRowContext(ctx, getLatestCampaignRevision, campaignID)
+	var i GetLatestCampaignRevisionRow
+	err := row.Scan(
+		&i.Revision,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMatchingPool = `-- name: GetMatchingPool :one
+SELECT id, campaign_id, sponsor_name, sponsor_email, ratio, cap, matched_amount, starts_at, ends_at, created_by, statement_sent_at, created_at, updated_at, deleted_at, confirmation_token, confirmed_at FROM campaign_matching_pools
+WHERE id = $1 AND campaign_id = $2
+`
+
+type GetMatchingPoolParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) GetMatchingPool(ctx context.Context, arg GetMatchingPoolParams) (CampaignMatchingPool, error) {
+	row := q.db.QueryRowContext(ctx, getMatchingPool, arg.ID, arg.CampaignID)
+	var i CampaignMatchingPool
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.SponsorName,
+		&i.SponsorEmail,
+		&i.Ratio,
+		&i.Cap,
+		&i.MatchedAmount,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedBy,
+		&i.StatementSentAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ConfirmationToken,
+		&i.ConfirmedAt,
+	)
+	return i, err
+}
+
+const getMatchingPoolStatement = `-- name: GetMatchingPoolStatement :many
+SELECT
+	m.donation_id,
+	(CASE WHEN dr.is_anonymous THEN 'Anonymous' ELSE dr.name END)::text AS donor_name,
+	m.donation_amount::numeric AS donation_amount,
+	m.amount::numeric AS amount,
+	m.created_at::timestamp AS created_at
+FROM donation_matches m
+JOIN donations d ON d.id = m.donation_id
+JOIN donaturs dr ON dr.id = d.donatur_id
+WHERE m.pool_id = $1
+ORDER BY m.created_at ASC, m.id ASC
+`
+
+type GetMatchingPoolStatementRow struct {
+	DonationID     int32           `json:"donation_id"`
+	DonorName      string          `json:"donor_name"`
+	DonationAmount decimal.Decimal `json:"donation_amount"`
+	Amount         decimal.Decimal `json:"amount"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+func (q *Queries) GetMatchingPoolStatement(ctx context.Context, poolID int32) ([]GetMatchingPoolStatementRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMatchingPoolStatement, poolID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMatchingPoolStatementRow
+	for rows.Next() {
+		var i GetMatchingPoolStatementRow
+		if err := rows.Scan(
+			&i.DonationID,
+			&i.DonorName,
+			&i.DonationAmount,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaginatedDonaturs = `-- name: GetPaginatedDonaturs :many
+SELECT 
+	d.id, 
+	(CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END)::text AS name, 
+	(CASE WHEN d.is_anonymous THEN '' ELSE COALESCE(d.email, '') END)::text AS email,
+	p.amount::numeric AS total_donated,
+	COALESCE((
+		SELECT (CASE dd.type WHEN 'memory' THEN 'In memory of ' ELSE 'In honor of ' END) || dd.honoree_name
+		FROM donation_dedications dd
+		JOIN donations dn ON dn.id = dd.donation_id
+		WHERE dn.donatur_id = d.id AND dd.is_public
+		ORDER BY dd.id DESC
+		LIMIT 1
+	), '')::text AS dedication
+FROM donaturs d
+JOIN (
+	SELECT donatur_id, SUM(amount) AS amount
+	FROM donations
+	GROUP BY donatur_id
+) p ON d.id = p.donatur_id
+WHERE d.campaign_id = (
+	SELECT id FROM campaigns WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+)
+AND EXISTS (
+	SELECT 1 FROM payments
+	WHERE status = 5 AND donatur_id = d.id
+)
+ORDER BY d.created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetPaginatedDonatursParams struct {
+	Slug   string `json:"slug"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+type GetPaginatedDonatursRow struct {
+	ID           int32           `json:"id"`
+	Name         string          `json:"name"`
+	Email        string          `json:"email"`
+	TotalDonated decimal.Decimal `json:"total_donated"`
+	Dedication   string          `json:"dedication"`
+}
+
+func (q *Queries) GetPaginatedDonaturs(ctx context.Context, arg GetPaginatedDonatursParams) ([]GetPaginatedDonatursRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPaginatedDonaturs, arg.Slug, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPaginatedDonatursRow
+	for rows.Next() {
+		var i GetPaginatedDonatursRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.TotalDonated,
+			&i.Dedication,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaginatedUserCampaign = `-- name: GetPaginatedUserCampaign :many
+SELECT id, title, images,
+	   CASE 
+		   WHEN target_amount = 0 THEN 0 
+		   ELSE current_amount / target_amount  * 100
+	   END::DECIMAL(10, 2) AS progress, 
+	   start_date, end_date, status,
+	   CASE
+	   	   	WHEN status = 1 THEN 'Draft'
+	   	   	WHEN status = 2 THEN 'Active'
+	   	   	WHEN status = 3 THEN 'Completed'
+	   	   	WHEN status = 4 THEN 'Cancelled'
+	   	   	WHEN status = 5 THEN 'Pending Review'
+	   	   	WHEN status = 6 THEN 'Rejected'
+	   	   ELSE 'Unknown'
+	   END AS status_label,
+	   CASE
+		   WHEN $4::text IS NULL THEN 0
+		   ELSE ts_rank_cd(search_vector, websearch_to_tsquery('simple', $4::text)) + word_similarity($4::text, title)
+	   END::real AS rank,
+	   CASE
+		   WHEN $4::text IS NULL THEN ''
+		   ELSE ts_headline('simple', REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), websearch_to_tsquery('simple', $4::text), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')
+	   END::text AS title_highlight
+FROM campaigns
+WHERE 
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
+	deleted_at IS NULL AND
+	(
+		$4::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', $4::text) OR
+		$4::text <% title
+	) AND
+    ($5::integer IS NULL OR status = $5::integer)
+ORDER BY rank DESC, start_date DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetPaginatedUserCampaignParams struct {
+	UserID int32          `json:"user_id"`
+	Limit  int32          `json:"limit"`
+	Offset int32          `json:"offset"`
+	Query  sql.NullString `json:"query"`
+	Status sql.NullInt32  `json:"status"`
+}
+
+type GetPaginatedUserCampaignRow struct {
+	ID             int32           `json:"id"`
+	Title          string          `json:"title"`
+	Images         []string        `json:"images"`
+	Progress       decimal.Decimal `json:"progress"`
+	StartDate      time.Time       `json:"start_date"`
+	EndDate        time.Time       `json:"end_date"`
+	Status         int32           `json:"status"`
+	StatusLabel    string          `json:"status_label"`
+	Rank           float32         `json:"rank"`
+	TitleHighlight string          `json:"title_highlight"`
+}
+
+func (q *Queries) GetPaginatedUserCampaign(ctx context.Context, arg GetPaginatedUserCampaignParams) ([]GetPaginatedUserCampaignRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPaginatedUserCampaign,
+		arg.UserID,
+		arg.Limit,
+		arg.Offset,
+		arg.Query,
+		arg.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPaginatedUserCampaignRow
+	for rows.Next() {
+		var i GetPaginatedUserCampaignRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			pq.Array(&i.Images),
+			&i.Progress,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Status,
+			&i.StatusLabel,
+			&i.Rank,
+			&i.TitleHighlight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaymentByTransactionId = `-- name: GetPaymentByTransactionId :one
+SELECT id, transaction_id, donatur_id, donation_id, campaign_id, vendor, method, amount, link, note, status, response, payment_date, created_at, updated_at FROM payments WHERE transaction_id = $1
+`
+
+func (q *Queries) GetPaymentByTransactionId(ctx context.Context, transactionID uuid.UUID) (Payment, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentByTransactionId, transactionID)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.TransactionID,
+		&i.DonaturID,
+		&i.DonationID,
+		&i.CampaignID,
+		&i.Vendor,
+		&i.Method,
+		&i.Amount,
+		&i.Link,
+		&i.Note,
+		&i.Status,
+		&i.Response,
+		&i.PaymentDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPendingInvitations = `-- name: GetPendingInvitations :many
+SELECT cm.id, cm.campaign_id, c.title AS campaign_title, cm.role, cm.token, cm.created_at::TIMESTAMP
+FROM campaign_members cm
+JOIN campaigns c ON c.id = cm.campaign_id
+WHERE cm.email = $1 AND cm.status = 1 AND c.deleted_at IS NULL
+ORDER BY cm.id DESC
+`
+
+type GetPendingInvitationsRow struct {
+	ID            int32          `json:"id"`
+	CampaignID    int32          `json:"campaign_id"`
+	CampaignTitle string         `json:"campaign_title"`
+	Role          int32          `json:"role"`
+	Token         sql.NullString `json:"token"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+func (q *Queries) GetPendingInvitations(ctx context.Context, email string) ([]GetPendingInvitationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingInvitations, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPendingInvitationsRow
+	for rows.Next() {
+		var i GetPendingInvitationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CampaignID,
+			&i.CampaignTitle,
+			&i.Role,
+			&i.Token,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingMatchingPool = `-- name: GetPendingMatchingPool :one
+SELECT p.sponsor_name, p.ratio::numeric AS ratio, p.cap::numeric AS cap, p.starts_at, p.ends_at, c.title AS campaign_title
+FROM campaign_matching_pools p
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.confirmation_token = $1 AND p.deleted_at IS NULL
+`
+
+type GetPendingMatchingPoolRow struct {
+	SponsorName   string          `json:"sponsor_name"`
+	Ratio         decimal.Decimal `json:"ratio"`
+	Cap           decimal.Decimal `json:"cap"`
+	StartsAt      time.Time       `json:"starts_at"`
+	EndsAt        time.Time       `json:"ends_at"`
+	CampaignTitle string          `json:"campaign_title"`
+}
+
+func (q *Queries) GetPendingMatchingPool(ctx context.Context, confirmationToken sql.NullString) (GetPendingMatchingPoolRow, error) {
+	row := q.db.QueryRowContext(ctx, getPendingMatchingPool, confirmationToken)
+	var i GetPendingMatchingPoolRow
+	err := row.Scan(
+		&i.SponsorName,
+		&i.Ratio,
+		&i.Cap,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CampaignTitle,
+	)
+	return i, err
+}
+
+const getPlatformDonorCount = `-- name: GetPlatformDonorCount :one
+SELECT COUNT(DISTINCT d.user_id)
+FROM payments p
+JOIN donaturs d ON d.id = p.donatur_id
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.status = 5 AND c.deleted_at IS NULL
+`
+
+func (q *Queries) GetPlatformDonorCount(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getPlatformDonorCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getPoolsDueForStatement = `-- name: GetPoolsDueForStatement :many
+SELECT p.id, p.campaign_id, c.title AS campaign_title
+FROM campaign_matching_pools p
+JOIN campaigns c ON c.id = p.campaign_id
+WHERE p.confirmed_at IS NOT NULL
+	AND p.statement_sent_at IS NULL
+	AND (
+		p.deleted_at IS NULL AND (p.ends_at <= CURRENT_TIMESTAMP OR p.matched_amount >= p.cap)
+		-- cancelled pools still owe what they matched before
+		OR p.deleted_at IS NOT NULL AND p.matched_amount > 0
+	)
+ORDER BY p.id ASC
+`
+
+type GetPoolsDueForStatementRow struct {
+	ID            int32  `json:"id"`
+	CampaignID    int32  `json:"campaign_id"`
+	CampaignTitle string `json:"campaign_title"`
+}
+
+func (q *Queries) GetPoolsDueForStatement(ctx context.Context) ([]GetPoolsDueForStatementRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPoolsDueForStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPoolsDueForStatementRow
+	for rows.Next() {
+		var i GetPoolsDueForStatementRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CampaignID,
+			&i.CampaignTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReportedCampaigns = `-- name: GetReportedCampaigns :many
+SELECT c.id, c.title, c.slug, c.suspended_at, c.suspension_reason,
+	   COUNT(*) FILTER (WHERE r.status = 1) AS open_reports,
+	   COUNT(*) AS total_reports,
+	   array_agg(DISTINCT r.category)::text[] AS categories,
+	   MAX(r.created_at)::TIMESTAMP AS last_reported_at
+FROM campaigns c
+JOIN campaign_reports r ON r.campaign_id = c.id
+WHERE c.deleted_at IS NULL
+GROUP BY c.id
+HAVING COUNT(*) FILTER (WHERE r.status = 1) > 0
+ORDER BY open_reports DESC, last_reported_at DESC
+LIMIT $1 OFFSET $2
+`
+
+type GetReportedCampaignsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type GetReportedCampaignsRow struct {
+	ID               int32          `json:"id"`
+	Title            string         `json:"title"`
+	Slug             string         `json:"slug"`
+	SuspendedAt      sql.NullTime   `json:"suspended_at"`
+	SuspensionReason sql.NullString `json:"suspension_reason"`
+	OpenReports      int64          `json:"open_reports"`
+	TotalReports     int64          `json:"total_reports"`
+	Categories       []string       `json:"categories"`
+	LastReportedAt   time.Time      `json:"last_reported_at"`
+}
+
+func (q *Queries) GetReportedCampaigns(ctx context.Context, arg GetReportedCampaignsParams) ([]GetReportedCampaignsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReportedCampaigns, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReportedCampaignsRow
+	for rows.Next() {
+		var i GetReportedCampaignsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.SuspendedAt,
+			&i.SuspensionReason,
+			&i.OpenReports,
+			&i.TotalReports,
+			pq.Array(&i.Categories),
+			&i.LastReportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewQueue = `-- name: GetReviewQueue :many
+SELECT c.id, c.title, c.slug, c.description, c.target_amount::numeric AS target_amount, c.start_date, c.end_date, c.images, c.tags,
+	   u.id AS owner_id, u.name AS owner_name, u.email AS owner_email,
+	   c.updated_at::TIMESTAMP AS submitted_at
+FROM campaigns c
+JOIN users u ON u.id = c.user_id
+WHERE c.status = 5 AND c.deleted_at IS NULL
+ORDER BY c.updated_at ASC, c.id ASC
+LIMIT $1 OFFSET $2
+`
+
+type GetReviewQueueParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type GetReviewQueueRow struct {
+	ID           int32           `json:"id"`
+	Title        string          `json:"title"`
+	Slug         string          `json:"slug"`
+	Description  *string         `json:"description"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Images       []string        `json:"images"`
+	Tags         []string        `json:"tags"`
+	OwnerID      int32           `json:"owner_id"`
+	OwnerName    string          `json:"owner_name"`
+	OwnerEmail   string          `json:"owner_email"`
+	SubmittedAt  time.Time       `json:"submitted_at"`
+}
+
+func (q *Queries) GetReviewQueue(ctx context.Context, arg GetReviewQueueParams) ([]GetReviewQueueRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReviewQueue, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewQueueRow
+	for rows.Next() {
+		var i GetReviewQueueRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.TargetAmount,
+			&i.StartDate,
+			&i.EndDate,
+			pq.Array(&i.Images),
+			pq.Array(&i.Tags),
+			&i.OwnerID,
+			&i.OwnerName,
+			&i.OwnerEmail,
+			&i.SubmittedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRewardFulfilments = `-- name: GetRewardFulfilments :many
+SELECT
+	dr.id,
+	dr.reward_tier_id,
+	rt.title AS reward_title,
+	dn.name AS donatur_name,
+	COALESCE(dn.email, '') AS donatur_email,
+	d.amount::numeric AS amount,
+	COALESCE(dr.shipping_name, '') AS shipping_name,
+	COALESCE(dr.shipping_phone, '') AS shipping_phone,
+	COALESCE(dr.shipping_address, '') AS shipping_address,
+	dr.fulfilled_at,
+	dr.created_at::timestamp AS created_at
+FROM donation_rewards dr
+JOIN reward_tiers rt ON rt.id = dr.reward_tier_id
+JOIN donations d ON d.id = dr.donation_id
+JOIN donaturs dn ON dn.id = d.donatur_id
+WHERE dr.campaign_id = $1 AND dr.status = 2
+AND ($4::integer IS NULL OR dr.reward_tier_id = $4::integer)
+AND ($5::boolean IS NULL OR (dr.fulfilled_at IS NOT NULL) = $5::boolean)
+ORDER BY dr.created_at ASC, dr.id ASC
+LIMIT $2 OFFSET $3
+`
+
+type GetRewardFulfilmentsParams struct {
+	CampaignID   int32         `json:"campaign_id"`
+	Limit        int32         `json:"limit"`
+	Offset       int32         `json:"offset"`
+	RewardTierID sql.NullInt32 `json:"reward_tier_id"`
+	Fulfilled    sql.NullBool  `json:"fulfilled"`
+}
+
+type GetRewardFulfilmentsRow struct {
+	ID              int32           `json:"id"`
+	RewardTierID    int32           `json:"reward_tier_id"`
+	RewardTitle     string          `json:"reward_title"`
+	DonaturName     string          `json:"donatur_name"`
+	DonaturEmail    string          `json:"donatur_email"`
+	Amount          decimal.Decimal `json:"amount"`
+	ShippingName    string          `json:"shipping_name"`
+	ShippingPhone   string          `json:"shipping_phone"`
+	ShippingAddress string          `json:"shipping_address"`
+	FulfilledAt     sql.NullTime    `json:"fulfilled_at"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+func (q *Queries) GetRewardFulfilments(ctx context.Context, arg GetRewardFulfilmentsParams) ([]GetRewardFulfilmentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRewardFulfilments,
+		arg.CampaignID,
+		arg.Limit,
+		arg.Offset,
+		arg.RewardTierID,
+		arg.Fulfilled,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRewardFulfilmentsRow
+	for rows.Next() {
+		var i GetRewardFulfilmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RewardTierID,
+			&i.RewardTitle,
+			&i.DonaturName,
+			&i.DonaturEmail,
+			&i.Amount,
+			&i.ShippingName,
+			&i.ShippingPhone,
+			&i.ShippingAddress,
+			&i.FulfilledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRewardTierById = `-- name: GetRewardTierById :one
+SELECT id, campaign_id, title, description, min_amount, quantity, reserved, claimed, requires_shipping, created_at, updated_at, deleted_at FROM reward_tiers
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+`
+
+type GetRewardTierByIdParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) GetRewardTierById(ctx context.Context, arg GetRewardTierByIdParams) (RewardTier, error) {
+	row := q.db.QueryRowContext(ctx, getRewardTierById, arg.ID, arg.CampaignID)
+	var i RewardTier
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.Title,
+		&i.Description,
+		&i.MinAmount,
+		&i.Quantity,
+		&i.Reserved,
+		&i.Claimed,
+		&i.RequiresShipping,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getShortLinkByCampaign = `-- name: GetShortLinkByCampaign :one
+SELECT code, clicks FROM campaign_short_links
+WHERE campaign_id = $1
+`
+
+type GetShortLinkByCampaignRow struct {
+	Code   string `json:"code"`
+	Clicks int64  `json:"clicks"`
+}
+
+func (q *Queries) GetShortLinkByCampaign(ctx context.Context, campaignID int32) (GetShortLinkByCampaignRow, error) {
+	row := q.db.QueryRowContext(ctx, getShortLinkByCampaign, campaignID)
+	var i GetShortLinkByCampaignRow
+	err := row.Scan(
+		&i.Code,
+		&i.Clicks,
+	)
+	return i, err
+}
+
+const getSimilarCampaigns = `-- name: GetSimilarCampaigns :many
+SELECT
+	c.id, c.title, c.slug, c.category,
+	c.current_amount::numeric AS current_amount,
+	c.target_amount::numeric AS target_amount,
+	(CASE WHEN c.target_amount = 0 THEN 0 ELSE c.current_amount / c.target_amount * 100 END)::numeric AS progress,
+	c.end_date,
+	s.score
+FROM campaign_similarities s
+JOIN campaigns c ON c.id = s.similar_campaign_id
+WHERE s.campaign_id = $1
+	-- scores are recomputed periodically, a campaign may have ended or been
+	-- suspended since
+	AND c.deleted_at IS NULL
+	AND c.suspended_at IS NULL
+	AND c.status = 2
+	AND c.start_date <= CURRENT_TIMESTAMP
+	AND c.end_date >= CURRENT_TIMESTAMP
+ORDER BY s.score DESC, c.id DESC
+LIMIT $2
+`
+
+type GetSimilarCampaignsParams struct {
+	CampaignID int32 `json:"campaign_id"`
+	Limit      int32 `json:"limit"`
+}
+
+type GetSimilarCampaignsRow struct {
+	ID            int32           `json:"id"`
+	Title         string          `json:"title"`
+	Slug          string          `json:"slug"`
+	Category      *string         `json:"category"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	Progress      decimal.Decimal `json:"progress"`
+	EndDate       time.Time       `json:"end_date"`
+	Score         decimal.Decimal `json:"score"`
+}
+
+func (q *Queries) GetSimilarCampaigns(ctx context.Context, arg GetSimilarCampaignsParams) ([]GetSimilarCampaignsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSimilarCampaigns, arg.CampaignID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSimilarCampaignsRow
+	for rows.Next() {
+		var i GetSimilarCampaignsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Category,
+			&i.CurrentAmount,
+			&i.TargetAmount,
+			&i.Progress,
+			&i.EndDate,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSitemapChanges = `-- name: GetSitemapChanges :many
+SELECT id, slug, category, updated_at::TIMESTAMP,
+	   (deleted_at IS NULL AND approved_at IS NOT NULL AND suspended_at IS NULL AND status IN (2, 3))::boolean AS listed,
+	   GREATEST(updated_at, deleted_at)::TIMESTAMP AS changed_at
+FROM campaigns
+WHERE GREATEST(updated_at, deleted_at) >= $1::TIMESTAMP
+ORDER BY changed_at, id
+`
+
+type GetSitemapChangesRow struct {
+	ID        int32     `json:"id"`
+	Slug      string    `json:"slug"`
+	Category  *string   `json:"category"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Listed    bool      `json:"listed"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (q *Queries) GetSitemapChanges(ctx context.Context, since time.Time) ([]GetSitemapChangesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSitemapChanges, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSitemapChangesRow
+	for rows.Next() {
+		var i GetSitemapChangesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Category,
+			&i.UpdatedAt,
+			&i.Listed,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTakenFundraiserSlugs = `-- name: GetTakenFundraiserSlugs :many
+SELECT slug FROM campaign_fundraisers
+WHERE deleted_at IS NULL AND (slug = $1 OR slug LIKE $1 || '-%')
+`
+
+func (q *Queries) GetTakenFundraiserSlugs(ctx context.Context, slug string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getTakenFundraiserSlugs, slug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return nil, err
+		}
+		items = append(items, slug)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTakenSlugs = `-- name: GetTakenSlugs :many
+SELECT slug FROM campaigns
+WHERE deleted_at IS NULL AND (slug = $1 OR slug LIKE $1 || '-%')
+UNION
+SELECT slug FROM campaign_slug_redirects
+WHERE slug = $1 OR slug LIKE $1 || '-%'
+`
+
+func (q *Queries) GetTakenSlugs(ctx context.Context, slug string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getTakenSlugs, slug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return nil, err
+		}
+		items = append(items, slug)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTotalReportedCampaigns = `-- name: GetTotalReportedCampaigns :one
+SELECT COUNT(DISTINCT r.campaign_id) AS total
+FROM campaign_reports r
+JOIN campaigns c ON c.id = r.campaign_id
+WHERE r.status = 1 AND c.deleted_at IS NULL
+`
+
+func (q *Queries) GetTotalReportedCampaigns(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTotalReportedCampaigns)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getTotalReviewQueue = `-- name: GetTotalReviewQueue :one
+SELECT COUNT(*) AS total
+FROM campaigns
+WHERE status = 5 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetTotalReviewQueue(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTotalReviewQueue)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getTotalRewardFulfilments = `-- name: GetTotalRewardFulfilments :one
+SELECT COUNT(*) AS total
+FROM donation_rewards dr
+WHERE dr.campaign_id = $1 AND dr.status = 2
+AND ($2::integer IS NULL OR dr.reward_tier_id = $2::integer)
+AND ($3::boolean IS NULL OR (dr.fulfilled_at IS NOT NULL) = $3::boolean)
+`
+
+type GetTotalRewardFulfilmentsParams struct {
+	CampaignID   int32         `json:"campaign_id"`
+	RewardTierID sql.NullInt32 `json:"reward_tier_id"`
+	Fulfilled    sql.NullBool  `json:"fulfilled"`
+}
+
+func (q *Queries) GetTotalRewardFulfilments(ctx context.Context, arg GetTotalRewardFulfilmentsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTotalRewardFulfilments, arg.CampaignID, arg.RewardTierID, arg.Fulfilled)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getTotalUserCampaigns = `-- name: GetTotalUserCampaigns :one
+SELECT COUNT(*) AS total
+FROM campaigns
+WHERE 
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
+	deleted_at IS NULL AND
+	(
+		$2::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', $2::text) OR
+		$2::text <% title
+	) AND
+    ($3::integer IS NULL OR status = $3::integer)
+`
+
+type GetTotalUserCampaignsParams struct {
+	UserID int32          `json:"user_id"`
+	Query  sql.NullString `json:"query"`
+	Status sql.NullInt32  `json:"status"`
+}
+
+func (q *Queries) GetTotalUserCampaigns(ctx context.Context, arg GetTotalUserCampaignsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTotalUserCampaigns, arg.UserID, arg.Query, arg.Status)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const getUnsentECard = `-- name: GetUnsentECard :one
+SELECT
+	dd.id,
+	dd.type,
+	dd.honoree_name,
+	dd.recipient_email::text AS recipient_email,
+	COALESCE(dd.message, '')::text AS message,
+	(CASE WHEN dr.is_anonymous THEN 'Someone' ELSE dr.name END)::text AS donor_name,
+	c.title AS campaign_title,
+	c.slug AS campaign_slug
+FROM donation_dedications dd
+JOIN donations d ON d.id = dd.donation_id
+JOIN donaturs dr ON dr.id = d.donatur_id
+JOIN campaigns c ON c.id = d.campaign_id
+WHERE dd.donation_id = $1
+	AND dd.recipient_email IS NOT NULL
+	AND dd.ecard_sent_at IS NULL
+`
+
+type GetUnsentECardRow struct {
+	ID             int32  `json:"id"`
+	Type           string `json:"type"`
+	HonoreeName    string `json:"honoree_name"`
+	RecipientEmail string `json:"recipient_email"`
+	Message        string `json:"message"`
+	DonorName      string `json:"donor_name"`
+	CampaignTitle  string `json:"campaign_title"`
+	CampaignSlug   string `json:"campaign_slug"`
+}
+
+func (q *Queries) GetUnsentECard(ctx context.Context, donationID int32) (GetUnsentECardRow, error) {
+	row := q.db.QueryRowContext(ctx, getUnsentECard, donationID)
+	var i GetUnsentECardRow
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.HonoreeName,
+		&i.RecipientEmail,
+		&i.Message,
+		&i.DonorName,
+		&i.CampaignTitle,
+		&i.CampaignSlug,
+	)
+	return i, err
+}
+
+const getUnsentECardDonations = `-- name: GetUnsentECardDonations :many
+SELECT dd.donation_id
+FROM donation_dedications dd
+JOIN payments p ON p.donation_id = dd.donation_id
+WHERE dd.recipient_email IS NOT NULL
+	AND dd.ecard_sent_at IS NULL
+	AND p.status = 5
+	AND p.updated_at > $1::timestamp
+	AND p.updated_at <= $2::timestamp
+ORDER BY dd.id ASC
+LIMIT $3::int
+`
+
+type GetUnsentECardDonationsParams struct {
+	PaidAfter  time.Time `json:"paid_after"`
+	PaidBefore time.Time `json:"paid_before"`
+	Limit      int32     `json:"limit"`
+}
+
+func (q *Queries) GetUnsentECardDonations(ctx context.Context, arg GetUnsentECardDonationsParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, getUnsentECardDonations, arg.PaidAfter, arg.PaidBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var donation_id int32
+		if err := rows.Scan(&donation_id); err != nil {
+			return nil, err
+		}
+		items = append(items, donation_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserCampaignById = `-- name: GetUserCampaignById :one
+SELECT c.id, c.title, c.description, c.slug, c.user_id, c.target_amount, c.current_amount, c.start_date, c.end_date, c.status, c.images, c.tags, c.category,
+	   c.created_at::TIMESTAMP, c.updated_at::TIMESTAMP, c.approved_at, cm.role AS member_role
+FROM campaigns c
+JOIN campaign_members cm ON cm.campaign_id = c.id AND cm.user_id = $2 AND cm.status = 2
+WHERE c.id = $1 AND c.deleted_at IS NULL
+`
+
+type GetUserCampaignByIdParams struct {
+	ID     int32 `json:"id"`
+	UserID int32 `json:"user_id"`
+}
+
+type GetUserCampaignByIdRow struct {
+	ID            int32        `json:"id"`
+	Title         string       `json:"title"`
+	Description   *string      `json:"description"`
+	Slug          string       `json:"slug"`
+	UserID        int32        `json:"user_id"`
+	TargetAmount  *float32     `json:"target_amount"`
+	CurrentAmount *float32     `json:"current_amount"`
+	StartDate     time.Time    `json:"start_date"`
+	EndDate       time.Time    `json:"end_date"`
+	Status        int32        `json:"status"`
+	Images        []string     `json:"images"`
+	Tags          []string     `json:"tags"`
+	Category      *string      `json:"category"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+	ApprovedAt    sql.NullTime `json:"approved_at"`
+	MemberRole    int32        `json:"member_role"`
+}
+
+func (q *Queries) GetUserCampaignById(ctx context.Context, arg GetUserCampaignByIdParams) (GetUserCampaignByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserCampaignById, arg.ID, arg.UserID)
+	var i GetUserCampaignByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Slug,
+		&i.UserID,
+		&i.TargetAmount,
+		&i.CurrentAmount,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Status,
+		pq.Array(&i.Images),
+		pq.Array(&i.Tags),
+		&i.Category,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ApprovedAt,
+		&i.MemberRole,
+	)
+	return i, err
+}
 
-func (q *Queries) GetPaginatedDonaturs(ctx context.Context, arg GetPaginatedDonatursParams) ([]GetPaginatedDonatursRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPaginatedDonaturs, arg.Slug, arg.Limit, arg.Offset)
+const getUserCampaignsAfterCursor = `-- name: GetUserCampaignsAfterCursor :many
+SELECT id, title, images,
+	   CASE 
+		   WHEN target_amount = 0 THEN 0 
+		   ELSE current_amount / target_amount  * 100
+	   END::DECIMAL(10, 2) AS progress, 
+	   start_date, end_date, status,
+	   CASE
+	   	   	WHEN status = 1 THEN 'Draft'
+	   	   	WHEN status = 2 THEN 'Active'
+	   	   	WHEN status = 3 THEN 'Completed'
+	   	   	WHEN status = 4 THEN 'Cancelled'
+	   	   	WHEN status = 5 THEN 'Pending Review'
+	   	   	WHEN status = 6 THEN 'Rejected'
+	   	   ELSE 'Unknown'
+	   END AS status_label,
+	   CASE
+		   WHEN $3::text IS NULL THEN 0
+		   ELSE ts_rank_cd(search_vector, websearch_to_tsquery('simple', $3::text)) + word_similarity($3::text, title)
+	   END::real AS rank,
+	   CASE
+		   WHEN $3::text IS NULL THEN ''
+		   ELSE ts_headline('simple', REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), websearch_to_tsquery('simple', $3::text), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')
+	   END::text AS title_highlight
+FROM campaigns
+WHERE 
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
+	deleted_at IS NULL AND
+	(
+		$3::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', $3::text) OR
+		$3::text <% title
+	) AND
+    ($4::integer IS NULL OR status = $4::integer) AND
+	(
+		$5::integer IS NULL OR
+		(start_date, id) < ($6::timestamp, $5::integer)
+	)
+ORDER BY start_date DESC, id DESC
+LIMIT $2
+`
+
+type GetUserCampaignsAfterCursorParams struct {
+	UserID          int32          `json:"user_id"`
+	Limit           int32          `json:"limit"`
+	Query           sql.NullString `json:"query"`
+	Status          sql.NullInt32  `json:"status"`
+	CursorID        sql.NullInt32  `json:"cursor_id"`
+	CursorStartDate sql.NullTime   `json:"cursor_start_date"`
+}
+
+type GetUserCampaignsAfterCursorRow struct {
+	ID             int32           `json:"id"`
+	Title          string          `json:"title"`
+	Images         []string        `json:"images"`
+	Progress       decimal.Decimal `json:"progress"`
+	StartDate      time.Time       `json:"start_date"`
+	EndDate        time.Time       `json:"end_date"`
+	Status         int32           `json:"status"`
+	StatusLabel    string          `json:"status_label"`
+	Rank           float32         `json:"rank"`
+	TitleHighlight string          `json:"title_highlight"`
+}
+
+func (q *Queries) GetUserCampaignsAfterCursor(ctx context.Context, arg GetUserCampaignsAfterCursorParams) ([]GetUserCampaignsAfterCursorRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserCampaignsAfterCursor,
+		arg.UserID,
+		arg.Limit,
+		arg.Query,
+		arg.Status,
+		arg.CursorID,
+		arg.CursorStartDate,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPaginatedDonatursRow
+	var items []GetUserCampaignsAfterCursorRow
 	for rows.Next() {
-		var i GetPaginatedDonatursRow
+		var i GetUserCampaignsAfterCursorRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Email,
-			&i.TotalDonated,
+			&i.Title,
+			pq.Array(&i.Images),
+			&i.Progress,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Status,
+			&i.StatusLabel,
+			&i.Rank,
+			&i.TitleHighlight,
 		); err != nil {
 			return nil, err
 		}
@@ -479,7 +3779,7 @@ func (q *Queries) GetPaginatedDonaturs(ctx context.Context, arg GetPaginatedDona
 	return items, nil
 }
 
-const getPaginatedUserCampaign = `-- name: GetPaginatedUserCampaign :many
+const getUserCampaignsBeforeCursor = `-- name: GetUserCampaignsBeforeCursor :many
 SELECT id, title, images,
 	   CASE 
 		   WHEN target_amount = 0 THEN 0 
@@ -491,52 +3791,74 @@ SELECT id, title, images,
 	   	   	WHEN status = 2 THEN 'Active'
 	   	   	WHEN status = 3 THEN 'Completed'
 	   	   	WHEN status = 4 THEN 'Cancelled'
+	   	   	WHEN status = 5 THEN 'Pending Review'
+	   	   	WHEN status = 6 THEN 'Rejected'
 	   	   ELSE 'Unknown'
-	   END AS status_label
+	   END AS status_label,
+	   CASE
+		   WHEN $3::text IS NULL THEN 0
+		   ELSE ts_rank_cd(search_vector, websearch_to_tsquery('simple', $3::text)) + word_similarity($3::text, title)
+	   END::real AS rank,
+	   CASE
+		   WHEN $3::text IS NULL THEN ''
+		   ELSE ts_headline('simple', REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), websearch_to_tsquery('simple', $3::text), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')
+	   END::text AS title_highlight
 FROM campaigns
 WHERE 
-	user_id = $1 AND
+	EXISTS (
+		SELECT 1 FROM campaign_members cm
+		WHERE cm.campaign_id = campaigns.id AND cm.user_id = $1 AND cm.status = 2
+	) AND
 	deleted_at IS NULL AND
-	($4::text IS NULL OR title ILIKE '%' || $4::text || '%') AND
-    ($5::integer IS NULL OR status = $5::integer)
-ORDER BY start_date DESC
-LIMIT $2 OFFSET $3
+	(
+		$3::text IS NULL OR
+		search_vector @@ websearch_to_tsquery('simple', $3::text) OR
+		$3::text <% title
+	) AND
+    ($4::integer IS NULL OR status = $4::integer) AND
+	(start_date, id) > ($5::timestamp, $6::integer)
+ORDER BY start_date ASC, id ASC
+LIMIT $2
 `
 
-type GetPaginatedUserCampaignParams struct {
-	UserID int32          `json:"user_id"`
-	Limit  int32          `json:"limit"`
-	Offset int32          `json:"offset"`
-	Title  sql.NullString `json:"title"`
-	Status sql.NullInt32  `json:"status"`
+type GetUserCampaignsBeforeCursorParams struct {
+	UserID          int32          `json:"user_id"`
+	Limit           int32          `json:"limit"`
+	Query           sql.NullString `json:"query"`
+	Status          sql.NullInt32  `json:"status"`
+	CursorStartDate time.Time      `json:"cursor_start_date"`
+	CursorID        int32          `json:"cursor_id"`
 }
 
-type GetPaginatedUserCampaignRow struct {
-	ID          int32           `json:"id"`
-	Title       string          `json:"title"`
-	Images      []string        `json:"images"`
-	Progress    decimal.Decimal `json:"progress"`
-	StartDate   time.Time       `json:"start_date"`
-	EndDate     time.Time       `json:"end_date"`
-	Status      int32           `json:"status"`
-	StatusLabel string          `json:"status_label"`
+type GetUserCampaignsBeforeCursorRow struct {
+	ID             int32           `json:"id"`
+	Title          string          `json:"title"`
+	Images         []string        `json:"images"`
+	Progress       decimal.Decimal `json:"progress"`
+	StartDate      time.Time       `json:"start_date"`
+	EndDate        time.Time       `json:"end_date"`
+	Status         int32           `json:"status"`
+	StatusLabel    string          `json:"status_label"`
+	Rank           float32         `json:"rank"`
+	TitleHighlight string          `json:"title_highlight"`
 }
 
-func (q *Queries) GetPaginatedUserCampaign(ctx context.Context, arg GetPaginatedUserCampaignParams) ([]GetPaginatedUserCampaignRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPaginatedUserCampaign,
+func (q *Queries) GetUserCampaignsBeforeCursor(ctx context.Context, arg GetUserCampaignsBeforeCursorParams) ([]GetUserCampaignsBeforeCursorRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserCampaignsBeforeCursor,
 		arg.UserID,
 		arg.Limit,
-		arg.Offset,
-		arg.Title,
+		arg.Query,
 		arg.Status,
+		arg.CursorStartDate,
+		arg.CursorID,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPaginatedUserCampaignRow
+	var items []GetUserCampaignsBeforeCursorRow
 	for rows.Next() {
-		var i GetPaginatedUserCampaignRow
+		var i GetUserCampaignsBeforeCursorRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -546,6 +3868,328 @@ func (q *Queries) GetPaginatedUserCampaign(ctx context.Context, arg GetPaginated
 			&i.EndDate,
 			&i.Status,
 			&i.StatusLabel,
+			&i.Rank,
+			&i.TitleHighlight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserEmail = `-- name: GetUserEmail :one
+SELECT email FROM users WHERE id = $1
+`
+
+func (q *Queries) GetUserEmail(ctx context.Context, id int32) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserEmail, id)
+	var email string
+	err := row.Scan(&email)
+	return email, err
+}
+
+const getUserFundraisers = `-- name: GetUserFundraisers :many
+SELECT
+	f.id, f.slug, f.title, f.story,
+	f.target_amount::numeric AS target_amount,
+	f.current_amount::numeric AS current_amount,
+	f.created_at::timestamp AS created_at,
+	c.id AS campaign_id, c.slug AS campaign_slug, c.title AS campaign_title
+FROM campaign_fundraisers f
+JOIN campaigns c ON c.id = f.campaign_id
+WHERE f.user_id = $1 AND f.deleted_at IS NULL
+ORDER BY f.created_at DESC, f.id DESC
+`
+
+type GetUserFundraisersRow struct {
+	ID            int32           `json:"id"`
+	Slug          string          `json:"slug"`
+	Title         string          `json:"title"`
+	Story         string          `json:"story"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	CreatedAt     time.Time       `json:"created_at"`
+	CampaignID    int32           `json:"campaign_id"`
+	CampaignSlug  string          `json:"campaign_slug"`
+	CampaignTitle string          `json:"campaign_title"`
+}
+
+func (q *Queries) GetUserFundraisers(ctx context.Context, userID int32) ([]GetUserFundraisersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserFundraisers, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserFundraisersRow
+	for rows.Next() {
+		var i GetUserFundraisersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.Story,
+			&i.TargetAmount,
+			&i.CurrentAmount,
+			&i.CreatedAt,
+			&i.CampaignID,
+			&i.CampaignSlug,
+			&i.CampaignTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const increaseCampaignCurrentAmount = `-- name: IncreaseCampaignCurrentAmount :exec
+UPDATE campaigns
+SET current_amount = current_amount + $2::numeric	
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type IncreaseCampaignCurrentAmountParams struct {
+	ID     int32           `json:"id"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+func (q *Queries) IncreaseCampaignCurrentAmount(ctx context.Context, arg IncreaseCampaignCurrentAmountParams) error {
+	_, err := q.db.ExecContext(ctx, increaseCampaignCurrentAmount, arg.ID, arg.Amount)
+	return err
+}
+
+const increaseFundraiserCurrentAmount = `-- name: IncreaseFundraiserCurrentAmount :exec
+UPDATE campaign_fundraisers f
+SET current_amount = f.current_amount + $1::numeric, updated_at = CURRENT_TIMESTAMP
+FROM donations d
+WHERE d.id = $2 AND f.id = d.fundraiser_id
+`
+
+type IncreaseFundraiserCurrentAmountParams struct {
+	Amount     decimal.Decimal `json:"amount"`
+	DonationID int32           `json:"donation_id"`
+}
+
+func (q *Queries) IncreaseFundraiserCurrentAmount(ctx context.Context, arg IncreaseFundraiserCurrentAmountParams) error {
+	_, err := q.db.ExecContext(ctx, increaseFundraiserCurrentAmount, arg.Amount, arg.DonationID)
+	return err
+}
+
+const increaseMatchingPoolAmount = `-- name: IncreaseMatchingPoolAmount :exec
+UPDATE campaign_matching_pools
+SET matched_amount = matched_amount + $1::numeric, updated_at = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type IncreaseMatchingPoolAmountParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     int32           `json:"id"`
+}
+
+func (q *Queries) IncreaseMatchingPoolAmount(ctx context.Context, arg IncreaseMatchingPoolAmountParams) error {
+	_, err := q.db.ExecContext(ctx, increaseMatchingPoolAmount, arg.Amount, arg.ID)
+	return err
+}
+
+const inviteCampaignMember = `-- name: InviteCampaignMember :one
+INSERT INTO campaign_members (campaign_id, email, role, status, token, invited_by)
+VALUES ($1, $2, $3, 1, $4, $5)
+ON CONFLICT (campaign_id, email) DO UPDATE
+SET role = EXCLUDED.role, status = 1, token = EXCLUDED.token, invited_by = EXCLUDED.invited_by, updated_at = CURRENT_TIMESTAMP
+WHERE campaign_members.status <> 2
+RETURNING id, campaign_id, user_id, email, role, status, token, invited_by, accepted_at, created_at, updated_at
+`
+
+type InviteCampaignMemberParams struct {
+	CampaignID int32          `json:"campaign_id"`
+	Email      string         `json:"email"`
+	Role       int32          `json:"role"`
+	Token      sql.NullString `json:"token"`
+	InvitedBy  sql.NullInt32  `json:"invited_by"`
+}
+
+func (q *Queries) InviteCampaignMember(ctx context.Context, arg InviteCampaignMemberParams) (CampaignMember, error) {
+	row := q.db.QueryRowContext(ctx, inviteCampaignMember,
+		arg.CampaignID,
+		arg.Email,
+		arg.Role,
+		arg.Token,
+		arg.InvitedBy,
+	)
+	var i CampaignMember
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.UserID,
+		&i.Email,
+		&i.Role,
+		&i.Status,
+		&i.Token,
+		&i.InvitedBy,
+		&i.AcceptedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const isSlugRedirected = `-- name: IsSlugRedirected :one
+SELECT EXISTS (
+	SELECT 1 FROM campaign_slug_redirects
+	WHERE slug = $1 AND campaign_id <> $2
+)
+`
+
+type IsSlugRedirectedParams struct {
+	Slug       string `json:"slug"`
+	CampaignID int32  `json:"campaign_id"`
+}
+
+func (q *Queries) IsSlugRedirected(ctx context.Context, arg IsSlugRedirectedParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isSlugRedirected, arg.Slug, arg.CampaignID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isUserAdmin = `-- name: IsUserAdmin :one
+SELECT is_admin FROM users WHERE id = $1
+`
+
+func (q *Queries) IsUserAdmin(ctx context.Context, id int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isUserAdmin, id)
+	var is_admin bool
+	err := row.Scan(&is_admin)
+	return is_admin, err
+}
+
+const liftReportSuspension = `-- name: LiftReportSuspension :execrows
+UPDATE campaigns
+SET suspended_at = NULL, suspension_reason = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND suspended_at IS NOT NULL
+	-- a suspension an admin upheld stays in place
+	AND NOT EXISTS (
+		SELECT 1 FROM campaign_reports r
+		WHERE r.campaign_id = campaigns.id AND r.status = 3
+	)
+`
+
+func (q *Queries) LiftReportSuspension(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, liftReportSuspension, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markDonationRewardFulfilled = `-- name: MarkDonationRewardFulfilled :execrows
+UPDATE donation_rewards
+SET fulfilled_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND status = 2 AND fulfilled_at IS NULL
+`
+
+type MarkDonationRewardFulfilledParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) MarkDonationRewardFulfilled(ctx context.Context, arg MarkDonationRewardFulfilledParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markDonationRewardFulfilled, arg.ID, arg.CampaignID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markECardSent = `-- name: MarkECardSent :exec
+UPDATE donation_dedications
+SET ecard_sent_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) MarkECardSent(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, markECardSent, id)
+	return err
+}
+
+const markMatchingPoolStatementSent = `-- name: MarkMatchingPoolStatementSent :exec
+UPDATE campaign_matching_pools
+SET statement_sent_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) MarkMatchingPoolStatementSent(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, markMatchingPoolStatementSent, id)
+	return err
+}
+
+const markPaymentInvoiceCreated = `-- name: MarkPaymentInvoiceCreated :exec
+UPDATE payments SET link = $1, vendor = $2, status = $3
+WHERE id = $4
+`
+
+type MarkPaymentInvoiceCreatedParams struct {
+	Link   sql.NullString `json:"link"`
+	Vendor sql.NullString `json:"vendor"`
+	Status int32          `json:"status"`
+	ID     int32          `json:"id"`
+}
+
+func (q *Queries) MarkPaymentInvoiceCreated(ctx context.Context, arg MarkPaymentInvoiceCreatedParams) error {
+	_, err := q.db.ExecContext(ctx, markPaymentInvoiceCreated,
+		arg.Link,
+		arg.Vendor,
+		arg.Status,
+		arg.ID,
+	)
+	return err
+}
+
+const markReachedMilestones = `-- name: MarkReachedMilestones :many
+UPDATE campaign_milestones m
+SET reached_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+FROM campaigns c
+WHERE m.campaign_id = c.id
+	AND m.campaign_id = $1
+	AND m.reached_at IS NULL
+	AND m.amount <= c.current_amount
+RETURNING m.id, m.amount::numeric AS amount, m.description, (m.amount > c.target_amount)::boolean AS stretch
+`
+
+type MarkReachedMilestonesRow struct {
+	ID          int32           `json:"id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	Stretch     bool            `json:"stretch"`
+}
+
+func (q *Queries) MarkReachedMilestones(ctx context.Context, campaignID int32) ([]MarkReachedMilestonesRow, error) {
+	rows, err := q.db.QueryContext(ctx, markReachedMilestones, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarkReachedMilestonesRow
+	for rows.Next() {
+		var i MarkReachedMilestonesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.Description,
+			&i.Stretch,
 		); err != nil {
 			return nil, err
 		}
@@ -560,165 +4204,266 @@ func (q *Queries) GetPaginatedUserCampaign(ctx context.Context, arg GetPaginated
 	return items, nil
 }
 
-const getPaymentByTransactionId = `-- name: GetPaymentByTransactionId :one
-SELECT id, transaction_id, donatur_id, donation_id, campaign_id, vendor, method, amount, link, note, status, response, payment_date, created_at, updated_at FROM payments WHERE transaction_id = $1
+const notifyDonationPaid = `-- name: NotifyDonationPaid :exec
+SELECT pg_notify($1::text, json_build_object(
+	'campaign_id', c.id,
+	'donation_id', $2::int,
+	'donor_name', (CASE WHEN d.is_anonymous THEN 'Anonymous' ELSE d.name END),
+	'anonymous', d.is_anonymous,
+	'amount', $3::numeric,
+	'matched', $4::numeric,
+	'current_amount', c.current_amount,
+	'target_amount', c.target_amount,
+	'paid_at', $5::timestamptz
+)::text)
+FROM campaigns c
+JOIN donaturs d ON d.campaign_id = c.id
+WHERE c.id = $6 AND d.id = $7
 `
 
-func (q *Queries) GetPaymentByTransactionId(ctx context.Context, transactionID uuid.UUID) (Payment, error) {
-	row := q.db.QueryRowContext(ctx, getPaymentByTransactionId, transactionID)
-	var i Payment
-	err := row.Scan(
-		&i.ID,
-		&i.TransactionID,
-		&i.DonaturID,
-		&i.DonationID,
-		&i.CampaignID,
-		&i.Vendor,
-		&i.Method,
-		&i.Amount,
-		&i.Link,
-		&i.Note,
-		&i.Status,
-		&i.Response,
-		&i.PaymentDate,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+type NotifyDonationPaidParams struct {
+	Channel    string          `json:"channel"`
+	DonationID int32           `json:"donation_id"`
+	Amount     decimal.Decimal `json:"amount"`
+	Matched    decimal.Decimal `json:"matched"`
+	PaidAt     *time.Time      `json:"paid_at"`
+	CampaignID int32           `json:"campaign_id"`
+	DonaturID  int32           `json:"donatur_id"`
 }
 
-const getTotalCampaigns = `-- name: GetTotalCampaigns :one
-SELECT COUNT(*) AS total
-FROM campaigns
-WHERE 
-	deleted_at IS NULL AND
-	status = 2 AND
-	start_date <= CURRENT_TIMESTAMP AND
-	end_date >= CURRENT_TIMESTAMP
-`
-
-func (q *Queries) GetTotalCampaigns(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getTotalCampaigns)
-	var total int64
-	err := row.Scan(&total)
-	return total, err
+func (q *Queries) NotifyDonationPaid(ctx context.Context, arg NotifyDonationPaidParams) error {
+	_, err := q.db.ExecContext(ctx, notifyDonationPaid,
+		arg.Channel,
+		arg.DonationID,
+		arg.Amount,
+		arg.Matched,
+		arg.PaidAt,
+		arg.CampaignID,
+		arg.DonaturID,
+	)
+	return err
 }
 
-const getTotalUserCampaigns = `-- name: GetTotalUserCampaigns :one
-SELECT COUNT(*) AS total
-FROM campaigns
-WHERE 
-	user_id = $1 AND
-	deleted_at IS NULL AND
-	($2::text IS NULL OR title ILIKE '%' || $2::text || '%') AND
-    ($3::integer IS NULL OR status = $3::integer)
+const refreshCampaignSimilarities = `-- name: RefreshCampaignSimilarities :exec
+WITH listed AS (
+	SELECT
+		id, category, tags, title,
+		LEFT(COALESCE(description, ''), 500) AS description,
+		(suspended_at IS NULL AND status = 2 AND start_date <= CURRENT_TIMESTAMP AND end_date >= CURRENT_TIMESTAMP) AS active
+	FROM campaigns
+	WHERE deleted_at IS NULL AND approved_at IS NOT NULL AND status IN (2, 3)
+), donors AS (
+	SELECT DISTINCT p.campaign_id, d.user_id
+	FROM payments p
+	JOIN donaturs d ON d.id = p.donatur_id
+	WHERE p.status = 5
+), donor_counts AS (
+	SELECT campaign_id, COUNT(*) AS donors
+	FROM donors
+	GROUP BY campaign_id
+), overlap AS (
+	SELECT a.campaign_id, b.campaign_id AS similar_campaign_id, COUNT(*) AS shared
+	FROM donors a
+	JOIN donors b ON b.user_id = a.user_id AND b.campaign_id <> a.campaign_id
+	GROUP BY a.campaign_id, b.campaign_id
+), candidates AS (
+	-- only the pairs that can score, scoring every pair grows with the
+	-- square of the listed campaigns
+	SELECT s.id AS campaign_id, c.id AS similar_campaign_id
+	FROM listed s
+	JOIN listed c ON c.category = s.category
+	UNION
+	SELECT s.id, c.id
+	FROM listed s
+	JOIN listed c ON c.tags && s.tags
+	UNION
+	SELECT campaign_id, similar_campaign_id
+	FROM overlap
+	UNION
+	-- % is answered by idx_campaigns_title_trgm
+	SELECT s.id, c.id
+	FROM listed s
+	JOIN campaigns c ON c.title % s.title
+), scored AS (
+	-- a shared category counts 1, tags 2 (jaccard), titles 1.5 and
+	-- descriptions 1 (trigram similarity), donors 2 (share of the smaller
+	-- donor base that gave to both)
+	SELECT
+		s.id AS campaign_id,
+		c.id AS similar_campaign_id,
+		(
+			(CASE WHEN s.category = c.category THEN 1 ELSE 0 END) +
+			2 * COALESCE(
+				cardinality(ARRAY(SELECT UNNEST(s.tags) INTERSECT SELECT UNNEST(c.tags)))::float8 /
+				NULLIF(cardinality(ARRAY(SELECT UNNEST(s.tags) UNION SELECT UNNEST(c.tags))), 0),
+				0
+			) +
+			1.5 * similarity(s.title, c.title) +
+			similarity(s.description, c.description) +
+			2 * COALESCE(o.shared::float8 / NULLIF(LEAST(sd.donors, cd.donors), 0), 0)
+		)::numeric(10, 4) AS score
+	FROM candidates p
+	JOIN listed s ON s.id = p.campaign_id
+	JOIN listed c ON c.id = p.similar_campaign_id AND c.active AND c.id <> s.id
+	LEFT JOIN overlap o ON o.campaign_id = s.id AND o.similar_campaign_id = c.id
+	LEFT JOIN donor_counts sd ON sd.campaign_id = s.id
+	LEFT JOIN donor_counts cd ON cd.campaign_id = c.id
+), ranked AS (
+	SELECT campaign_id, similar_campaign_id, score
+	FROM (
+		SELECT *, ROW_NUMBER() OVER (PARTITION BY campaign_id ORDER BY score DESC, similar_campaign_id DESC) AS position
+		FROM scored
+		WHERE score >= $1::numeric
+	) r
+	WHERE position <= $2::int
+), stale AS (
+	DELETE FROM campaign_similarities cs
+	WHERE NOT EXISTS (
+		SELECT 1 FROM ranked r
+		WHERE r.campaign_id = cs.campaign_id AND r.similar_campaign_id = cs.similar_campaign_id
+	)
+)
+INSERT INTO campaign_similarities (campaign_id, similar_campaign_id, score, computed_at)
+SELECT campaign_id, similar_campaign_id, score, CURRENT_TIMESTAMP
+FROM ranked
+ON CONFLICT (campaign_id, similar_campaign_id) DO UPDATE
+SET
+	score = EXCLUDED.score,
+	computed_at = EXCLUDED.computed_at
 `
 
-type GetTotalUserCampaignsParams struct {
-	UserID int32          `json:"user_id"`
-	Title  sql.NullString `json:"title"`
-	Status sql.NullInt32  `json:"status"`
+type RefreshCampaignSimilaritiesParams struct {
+	MinScore    decimal.Decimal `json:"min_score"`
+	PerCampaign int32           `json:"per_campaign"`
 }
 
-func (q *Queries) GetTotalUserCampaigns(ctx context.Context, arg GetTotalUserCampaignsParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getTotalUserCampaigns, arg.UserID, arg.Title, arg.Status)
-	var total int64
-	err := row.Scan(&total)
-	return total, err
+func (q *Queries) RefreshCampaignSimilarities(ctx context.Context, arg RefreshCampaignSimilaritiesParams) error {
+	_, err := q.db.ExecContext(ctx, refreshCampaignSimilarities, arg.MinScore, arg.PerCampaign)
+	return err
 }
 
-const getUserCampaignById = `-- name: GetUserCampaignById :one
-SELECT id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, status, images,
-	   created_at::TIMESTAMP, updated_at::TIMESTAMP
-FROM campaigns
-WHERE id = $1 AND user_id = $2
+const refreshTrendingScores = `-- name: RefreshTrendingScores :exec
+WITH recent AS (
+	SELECT
+		p.campaign_id,
+		SUM(
+			LN(1 + p.amount::float8 / 10000) *
+			POWER(0.5, EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - COALESCE(p.payment_date, p.updated_at))::float8 / 3600 / $1::float8)
+		)::numeric(14, 4) AS score,
+		COUNT(p.id)::int AS recent_donations,
+		SUM(p.amount)::numeric AS recent_amount
+	FROM payments p
+	WHERE p.status = 5 AND COALESCE(p.payment_date, p.updated_at) >= $2::timestamp
+	GROUP BY p.campaign_id
+), stale AS (
+	DELETE FROM campaign_trending_scores t
+	WHERE NOT EXISTS (SELECT 1 FROM recent r WHERE r.campaign_id = t.campaign_id)
+)
+INSERT INTO campaign_trending_scores (campaign_id, score, recent_donations, recent_amount, computed_at)
+SELECT campaign_id, score, recent_donations, recent_amount, CURRENT_TIMESTAMP
+FROM recent
+ON CONFLICT (campaign_id) DO UPDATE
+SET
+	score = EXCLUDED.score,
+	recent_donations = EXCLUDED.recent_donations,
+	recent_amount = EXCLUDED.recent_amount,
+	computed_at = EXCLUDED.computed_at
 `
 
-type GetUserCampaignByIdParams struct {
-	ID     int32 `json:"id"`
-	UserID int32 `json:"user_id"`
+type RefreshTrendingScoresParams struct {
+	HalfLifeHours float64   `json:"half_life_hours"`
+	Since         time.Time `json:"since"`
 }
 
-type GetUserCampaignByIdRow struct {
-	ID            int32     `json:"id"`
-	Title         string    `json:"title"`
-	Description   *string   `json:"description"`
-	Slug          string    `json:"slug"`
-	UserID        int32     `json:"user_id"`
-	TargetAmount  *float32  `json:"target_amount"`
-	CurrentAmount *float32  `json:"current_amount"`
-	StartDate     time.Time `json:"start_date"`
-	EndDate       time.Time `json:"end_date"`
-	Status        int32     `json:"status"`
-	Images        []string  `json:"images"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+func (q *Queries) RefreshTrendingScores(ctx context.Context, arg RefreshTrendingScoresParams) error {
+	_, err := q.db.ExecContext(ctx, refreshTrendingScores, arg.HalfLifeHours, arg.Since)
+	return err
 }
 
-func (q *Queries) GetUserCampaignById(ctx context.Context, arg GetUserCampaignByIdParams) (GetUserCampaignByIdRow, error) {
-	row := q.db.QueryRowContext(ctx, getUserCampaignById, arg.ID, arg.UserID)
-	var i GetUserCampaignByIdRow
-	err := row.Scan(
-		&i.ID,
-		&i.Title,
-		&i.Description,
-		&i.Slug,
-		&i.UserID,
-		&i.TargetAmount,
-		&i.CurrentAmount,
-		&i.StartDate,
-		&i.EndDate,
-		&i.Status,
-		pq.Array(&i.Images),
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+const rejectCampaign = `-- name: RejectCampaign :exec
+UPDATE campaigns
+SET status = 6, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) RejectCampaign(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, rejectCampaign, id)
+	return err
 }
 
-const increaseCampaignCurrentAmount = `-- name: IncreaseCampaignCurrentAmount :exec
-UPDATE campaigns
-SET current_amount = current_amount + $2::numeric	
-WHERE id = $1 AND deleted_at IS NULL
+const releaseRewardTier = `-- name: ReleaseRewardTier :exec
+UPDATE reward_tiers SET reserved = reserved - 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
 `
 
-type IncreaseCampaignCurrentAmountParams struct {
-	ID     int32           `json:"id"`
-	Amount decimal.Decimal `json:"amount"`
+func (q *Queries) ReleaseRewardTier(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, releaseRewardTier, id)
+	return err
 }
 
-func (q *Queries) IncreaseCampaignCurrentAmount(ctx context.Context, arg IncreaseCampaignCurrentAmountParams) error {
-	_, err := q.db.ExecContext(ctx, increaseCampaignCurrentAmount, arg.ID, arg.Amount)
+const reserveRewardTier = `-- name: ReserveRewardTier :exec
+UPDATE reward_tiers SET reserved = reserved + 1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) ReserveRewardTier(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, reserveRewardTier, id)
 	return err
 }
 
-const markPaymentInvoiceCreated = `-- name: MarkPaymentInvoiceCreated :exec
-UPDATE payments SET link = $1, vendor = $2, status = $3
-WHERE id = $4
+const resolveCampaignReports = `-- name: ResolveCampaignReports :execrows
+UPDATE campaign_reports
+SET status = $2, resolved_by = $3, resolution_note = $4, resolved_at = CURRENT_TIMESTAMP
+WHERE campaign_id = $1 AND status = 1
 `
 
-type MarkPaymentInvoiceCreatedParams struct {
-	Link   sql.NullString `json:"link"`
-	Vendor sql.NullString `json:"vendor"`
-	Status int32          `json:"status"`
-	ID     int32          `json:"id"`
+type ResolveCampaignReportsParams struct {
+	CampaignID     int32          `json:"campaign_id"`
+	Status         int32          `json:"status"`
+	ResolvedBy     sql.NullInt32  `json:"resolved_by"`
+	ResolutionNote sql.NullString `json:"resolution_note"`
 }
 
-func (q *Queries) MarkPaymentInvoiceCreated(ctx context.Context, arg MarkPaymentInvoiceCreatedParams) error {
-	_, err := q.db.ExecContext(ctx, markPaymentInvoiceCreated,
-		arg.Link,
-		arg.Vendor,
+func (q *Queries) ResolveCampaignReports(ctx context.Context, arg ResolveCampaignReportsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, resolveCampaignReports,
+		arg.CampaignID,
 		arg.Status,
-		arg.ID,
+		arg.ResolvedBy,
+		arg.ResolutionNote,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeCampaignPreview = `-- name: RevokeCampaignPreview :execrows
+UPDATE campaign_previews
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeCampaignPreviewParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) RevokeCampaignPreview(ctx context.Context, arg RevokeCampaignPreviewParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeCampaignPreview, arg.ID, arg.CampaignID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const softDeleteCampaign = `-- name: SoftDeleteCampaign :one
 UPDATE campaigns
 SET deleted_at = CURRENT_TIMESTAMP
-WHERE id = $1 AND user_id = $2
-RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, status, created_at, updated_at, deleted_at, images
+WHERE id = $1 AND EXISTS (
+	SELECT 1 FROM campaign_members cm
+	WHERE cm.campaign_id = campaigns.id AND cm.user_id = $2 AND cm.status = 2 AND cm.role = 1
+)
+RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, status, created_at, updated_at, deleted_at, images, tags, search_vector, approved_at, suspended_at, suspension_reason, category
 `
 
 type SoftDeleteCampaignParams struct {
@@ -744,28 +4489,88 @@ func (q *Queries) SoftDeleteCampaign(ctx context.Context, arg SoftDeleteCampaign
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		pq.Array(&i.Images),
+		pq.Array(&i.Tags),
+		&i.SearchVector,
+		&i.ApprovedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.Category,
 	)
 	return i, err
 }
 
+const softDeleteRewardTier = `-- name: SoftDeleteRewardTier :execrows
+UPDATE reward_tiers
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteRewardTierParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+}
+
+func (q *Queries) SoftDeleteRewardTier(ctx context.Context, arg SoftDeleteRewardTierParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteRewardTier, arg.ID, arg.CampaignID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const suspendCampaign = `-- name: SuspendCampaign :execrows
+UPDATE campaigns
+SET suspended_at = CURRENT_TIMESTAMP, suspension_reason = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND suspended_at IS NULL
+`
+
+type SuspendCampaignParams struct {
+	ID               int32          `json:"id"`
+	SuspensionReason sql.NullString `json:"suspension_reason"`
+}
+
+func (q *Queries) SuspendCampaign(ctx context.Context, arg SuspendCampaignParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, suspendCampaign, arg.ID, arg.SuspensionReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const tryJobLock = `-- name: TryJobLock :one
+SELECT pg_try_advisory_xact_lock(hashtext($1::text))
+`
+
+func (q *Queries) TryJobLock(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, tryJobLock, name)
+	var pg_try_advisory_xact_lock bool
+	err := row.Scan(&pg_try_advisory_xact_lock)
+	return pg_try_advisory_xact_lock, err
+}
+
 const updateCampaign = `-- name: UpdateCampaign :one
 UPDATE campaigns
-SET title = $1, description = $2, slug = $3, target_amount = $4, start_date = $5, end_date = $6, status = $7, updated_at = CURRENT_TIMESTAMP, images = $9
-WHERE id = $8 AND user_id = $10
-RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, images, status, created_at::TIMESTAMP, updated_at::TIMESTAMP
+SET title = $1, description = $2, slug = $3, target_amount = $4::numeric, start_date = $5, end_date = $6, status = $7, updated_at = CURRENT_TIMESTAMP, images = $9, tags = $11, category = $12
+WHERE id = $8 AND EXISTS (
+	SELECT 1 FROM campaign_members cm
+	WHERE cm.campaign_id = campaigns.id AND cm.user_id = $10 AND cm.status = 2 AND cm.role IN (1, 2)
+)
+RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, images, tags, category, status, created_at::TIMESTAMP, updated_at::TIMESTAMP
 `
 
 type UpdateCampaignParams struct {
-	Title        string    `json:"title"`
-	Description  *string   `json:"description"`
-	Slug         string    `json:"slug"`
-	TargetAmount *float32  `json:"target_amount"`
-	StartDate    time.Time `json:"start_date"`
-	EndDate      time.Time `json:"end_date"`
-	Status       int32     `json:"status"`
-	ID           int32     `json:"id"`
-	Images       []string  `json:"images"`
-	UserID       int32     `json:"user_id"`
+	Title        string          `json:"title"`
+	Description  *string         `json:"description"`
+	Slug         string          `json:"slug"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Status       int32           `json:"status"`
+	ID           int32           `json:"id"`
+	Images       []string        `json:"images"`
+	UserID       int32           `json:"user_id"`
+	Tags         []string        `json:"tags"`
+	Category     *string         `json:"category"`
 }
 
 type UpdateCampaignRow struct {
@@ -779,6 +4584,8 @@ type UpdateCampaignRow struct {
 	StartDate     time.Time `json:"start_date"`
 	EndDate       time.Time `json:"end_date"`
 	Images        []string  `json:"images"`
+	Tags          []string  `json:"tags"`
+	Category      *string   `json:"category"`
 	Status        int32     `json:"status"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
@@ -796,6 +4603,8 @@ func (q *Queries) UpdateCampaign(ctx context.Context, arg UpdateCampaignParams)
 		arg.ID,
 		pq.Array(arg.Images),
 		arg.UserID,
+		pq.Array(arg.Tags),
+		arg.Category,
 	)
 	var i UpdateCampaignRow
 	err := row.Scan(
@@ -809,6 +4618,8 @@ func (q *Queries) UpdateCampaign(ctx context.Context, arg UpdateCampaignParams)
 		&i.StartDate,
 		&i.EndDate,
 		pq.Array(&i.Images),
+		pq.Array(&i.Tags),
+		&i.Category,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -816,6 +4627,25 @@ func (q *Queries) UpdateCampaign(ctx context.Context, arg UpdateCampaignParams)
 	return i, err
 }
 
+const updateCampaignMemberRole = `-- name: UpdateCampaignMemberRole :execrows
+UPDATE campaign_members SET role = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND role <> 1
+`
+
+type UpdateCampaignMemberRoleParams struct {
+	ID         int32 `json:"id"`
+	CampaignID int32 `json:"campaign_id"`
+	Role       int32 `json:"role"`
+}
+
+func (q *Queries) UpdateCampaignMemberRole(ctx context.Context, arg UpdateCampaignMemberRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateCampaignMemberRole, arg.ID, arg.CampaignID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateCampaignStatus = `-- name: UpdateCampaignStatus :one
 UPDATE campaigns SET status = $1 where id = $2
 RETURNING id, title, description, slug, user_id, target_amount, current_amount, start_date, end_date, images, status, created_at::TIMESTAMP, updated_at::TIMESTAMP
@@ -863,6 +4693,61 @@ func (q *Queries) UpdateCampaignStatus(ctx context.Context, arg UpdateCampaignSt
 	return i, err
 }
 
+const updateDonationRewardStatus = `-- name: UpdateDonationRewardStatus :exec
+UPDATE donation_rewards SET status = $1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type UpdateDonationRewardStatusParams struct {
+	Status int32 `json:"status"`
+	ID     int32 `json:"id"`
+}
+
+func (q *Queries) UpdateDonationRewardStatus(ctx context.Context, arg UpdateDonationRewardStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateDonationRewardStatus, arg.Status, arg.ID)
+	return err
+}
+
+const updateFundraiser = `-- name: UpdateFundraiser :one
+UPDATE campaign_fundraisers
+SET title = $3, story = $4, target_amount = $5, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+RETURNING id, campaign_id, user_id, slug, title, story, target_amount, current_amount, created_at, updated_at, deleted_at
+`
+
+type UpdateFundraiserParams struct {
+	ID           int32           `json:"id"`
+	UserID       int32           `json:"user_id"`
+	Title        string          `json:"title"`
+	Story        string          `json:"story"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+}
+
+func (q *Queries) UpdateFundraiser(ctx context.Context, arg UpdateFundraiserParams) (CampaignFundraiser, error) {
+	row := q.db.QueryRowContext(ctx, updateFundraiser,
+		arg.ID,
+		arg.UserID,
+		arg.Title,
+		arg.Story,
+		arg.TargetAmount,
+	)
+	var i CampaignFundraiser
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.UserID,
+		&i.Slug,
+		&i.Title,
+		&i.Story,
+		&i.TargetAmount,
+		&i.CurrentAmount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const updatePaymentFromWebhookCallback = `-- name: UpdatePaymentFromWebhookCallback :one
 UPDATE payments
 SET 
@@ -929,3 +4814,109 @@ func (q *Queries) UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStat
 	_, err := q.db.ExecContext(ctx, updatePaymentStatus, arg.Status, arg.ID)
 	return err
 }
+
+const updateRewardTier = `-- name: UpdateRewardTier :one
+UPDATE reward_tiers
+SET title = $3, description = $4, min_amount = $5, quantity = $6, requires_shipping = $7, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND campaign_id = $2 AND deleted_at IS NULL
+RETURNING id, campaign_id, title, description, min_amount, quantity, reserved, claimed, requires_shipping, created_at, updated_at, deleted_at
+`
+
+type UpdateRewardTierParams struct {
+	ID               int32           `json:"id"`
+	CampaignID       int32           `json:"campaign_id"`
+	Title            string          `json:"title"`
+	Description      sql.NullString  `json:"description"`
+	MinAmount        decimal.Decimal `json:"min_amount"`
+	Quantity         sql.NullInt32   `json:"quantity"`
+	RequiresShipping bool            `json:"requires_shipping"`
+}
+
+func (q *Queries) UpdateRewardTier(ctx context.Context, arg UpdateRewardTierParams) (RewardTier, error) {
+	row := q.db.QueryRowContext(ctx, updateRewardTier,
+		arg.ID,
+		arg.CampaignID,
+		arg.Title,
+		arg.Description,
+		arg.MinAmount,
+		arg.Quantity,
+		arg.RequiresShipping,
+	)
+	var i RewardTier
+	err := row.Scan(
+		&i.ID,
+		&i.CampaignID,
+		&i.Title,
+		&i.Description,
+		&i.MinAmount,
+		&i.Quantity,
+		&i.Reserved,
+		&i.Claimed,
+		&i.RequiresShipping,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const upholdCampaignSuspension = `-- name: UpholdCampaignSuspension :exec
+UPDATE campaigns
+SET suspended_at = COALESCE(suspended_at, CURRENT_TIMESTAMP), suspension_reason = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type UpholdCampaignSuspensionParams struct {
+	ID               int32          `json:"id"`
+	SuspensionReason sql.NullString `json:"suspension_reason"`
+}
+
+func (q *Queries) UpholdCampaignSuspension(ctx context.Context, arg UpholdCampaignSuspensionParams) error {
+	_, err := q.db.ExecContext(ctx, upholdCampaignSuspension, arg.ID, arg.SuspensionReason)
+	return err
+}
+
+const upsertCampaignLocation = `-- name: UpsertCampaignLocation :exec
+INSERT INTO campaign_locations (campaign_id, province, city, latitude, longitude)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (campaign_id) DO UPDATE
+SET province = EXCLUDED.province,
+	city = EXCLUDED.city,
+	latitude = EXCLUDED.latitude,
+	longitude = EXCLUDED.longitude,
+	updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertCampaignLocationParams struct {
+	CampaignID int32           `json:"campaign_id"`
+	Province   sql.NullString  `json:"province"`
+	City       sql.NullString  `json:"city"`
+	Latitude   sql.NullFloat64 `json:"latitude"`
+	Longitude  sql.NullFloat64 `json:"longitude"`
+}
+
+func (q *Queries) UpsertCampaignLocation(ctx context.Context, arg UpsertCampaignLocationParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCampaignLocation,
+		arg.CampaignID,
+		arg.Province,
+		arg.City,
+		arg.Latitude,
+		arg.Longitude,
+	)
+	return err
+}
+
+const visitShortLink = `-- name: VisitShortLink :one
+UPDATE campaign_short_links l
+SET clicks = l.clicks + 1
+FROM campaigns c
+WHERE l.code = $1 AND c.id = l.campaign_id AND c.deleted_at IS NULL AND c.approved_at IS NOT NULL
+RETURNING c.slug
+`
+
+func (q *Queries) VisitShortLink(ctx context.Context, code string) (string, error) {
+	row := q.db.QueryRowContext(ctx, visitShortLink, code)
+	var slug string
+	err := row.Scan(&slug)
+	return slug, err
+}
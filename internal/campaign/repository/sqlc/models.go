@@ -14,42 +14,242 @@ import (
 )
 
 type Campaign struct {
-	ID            int32        `json:"id"`
-	Title         string       `json:"title"`
-	Description   *string      `json:"description"`
-	Slug          string       `json:"slug"`
-	UserID        int32        `json:"user_id"`
-	TargetAmount  *float32     `json:"target_amount"`
-	CurrentAmount *float32     `json:"current_amount"`
-	StartDate     time.Time    `json:"start_date"`
-	EndDate       time.Time    `json:"end_date"`
-	Status        int32        `json:"status"`
-	CreatedAt     sql.NullTime `json:"created_at"`
-	UpdatedAt     sql.NullTime `json:"updated_at"`
-	DeletedAt     sql.NullTime `json:"deleted_at"`
-	Images        []string     `json:"images"`
+	ID               int32          `json:"id"`
+	Title            string         `json:"title"`
+	Description      *string        `json:"description"`
+	Slug             string         `json:"slug"`
+	UserID           int32          `json:"user_id"`
+	TargetAmount     *float32       `json:"target_amount"`
+	CurrentAmount    *float32       `json:"current_amount"`
+	StartDate        time.Time      `json:"start_date"`
+	EndDate          time.Time      `json:"end_date"`
+	Status           int32          `json:"status"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
+	UpdatedAt        sql.NullTime   `json:"updated_at"`
+	DeletedAt        sql.NullTime   `json:"deleted_at"`
+	Images           []string       `json:"images"`
+	Tags             []string       `json:"tags"`
+	SearchVector     interface{}    `json:"search_vector"`
+	ApprovedAt       sql.NullTime   `json:"approved_at"`
+	SuspendedAt      sql.NullTime   `json:"suspended_at"`
+	SuspensionReason sql.NullString `json:"suspension_reason"`
+	Category         *string        `json:"category"`
 }
 
-type Donation struct {
-	ID         int32           `json:"id"`
-	DonaturID  int32           `json:"donatur_id"`
+type CampaignFundraiser struct {
+	ID            int32           `json:"id"`
+	CampaignID    int32           `json:"campaign_id"`
+	UserID        int32           `json:"user_id"`
+	Slug          string          `json:"slug"`
+	Title         string          `json:"title"`
+	Story         string          `json:"story"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	CreatedAt     sql.NullTime    `json:"created_at"`
+	UpdatedAt     sql.NullTime    `json:"updated_at"`
+	DeletedAt     sql.NullTime    `json:"deleted_at"`
+}
+
+type CampaignLocation struct {
 	CampaignID int32           `json:"campaign_id"`
-	Amount     decimal.Decimal `json:"amount"`
-	Note       sql.NullString  `json:"note"`
+	Province   sql.NullString  `json:"province"`
+	City       sql.NullString  `json:"city"`
+	Latitude   sql.NullFloat64 `json:"latitude"`
+	Longitude  sql.NullFloat64 `json:"longitude"`
 	CreatedAt  sql.NullTime    `json:"created_at"`
 	UpdatedAt  sql.NullTime    `json:"updated_at"`
 }
 
-type Donatur struct {
+type CampaignMatchingPool struct {
+	ID                int32           `json:"id"`
+	CampaignID        int32           `json:"campaign_id"`
+	SponsorName       string          `json:"sponsor_name"`
+	SponsorEmail      string          `json:"sponsor_email"`
+	Ratio             decimal.Decimal `json:"ratio"`
+	Cap               decimal.Decimal `json:"cap"`
+	MatchedAmount     decimal.Decimal `json:"matched_amount"`
+	StartsAt          time.Time       `json:"starts_at"`
+	EndsAt            time.Time       `json:"ends_at"`
+	CreatedBy         sql.NullInt32   `json:"created_by"`
+	StatementSentAt   sql.NullTime    `json:"statement_sent_at"`
+	CreatedAt         sql.NullTime    `json:"created_at"`
+	UpdatedAt         sql.NullTime    `json:"updated_at"`
+	DeletedAt         sql.NullTime    `json:"deleted_at"`
+	ConfirmationToken sql.NullString  `json:"confirmation_token"`
+	ConfirmedAt       sql.NullTime    `json:"confirmed_at"`
+}
+
+type CampaignMember struct {
 	ID         int32          `json:"id"`
-	UserID     int32          `json:"user_id"`
 	CampaignID int32          `json:"campaign_id"`
-	Name       string         `json:"name"`
-	Email      sql.NullString `json:"email"`
+	UserID     sql.NullInt32  `json:"user_id"`
+	Email      string         `json:"email"`
+	Role       int32          `json:"role"`
+	Status     int32          `json:"status"`
+	Token      sql.NullString `json:"token"`
+	InvitedBy  sql.NullInt32  `json:"invited_by"`
+	AcceptedAt sql.NullTime   `json:"accepted_at"`
 	CreatedAt  sql.NullTime   `json:"created_at"`
 	UpdatedAt  sql.NullTime   `json:"updated_at"`
 }
 
+type CampaignMilestone struct {
+	ID          int32           `json:"id"`
+	CampaignID  int32           `json:"campaign_id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	ReachedAt   sql.NullTime    `json:"reached_at"`
+	CreatedAt   sql.NullTime    `json:"created_at"`
+	UpdatedAt   sql.NullTime    `json:"updated_at"`
+}
+
+type CampaignPreview struct {
+	ID         int32         `json:"id"`
+	CampaignID int32         `json:"campaign_id"`
+	Token      string        `json:"token"`
+	CreatedBy  sql.NullInt32 `json:"created_by"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	RevokedAt  sql.NullTime  `json:"revoked_at"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+}
+
+type CampaignReport struct {
+	ID             int32          `json:"id"`
+	CampaignID     int32          `json:"campaign_id"`
+	ReporterKey    string         `json:"reporter_key"`
+	Category       string         `json:"category"`
+	Description    sql.NullString `json:"description"`
+	Status         int32          `json:"status"`
+	ResolvedBy     sql.NullInt32  `json:"resolved_by"`
+	ResolvedAt     sql.NullTime   `json:"resolved_at"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	ResolutionNote sql.NullString `json:"resolution_note"`
+}
+
+type CampaignReview struct {
+	ID         int32         `json:"id"`
+	CampaignID int32         `json:"campaign_id"`
+	ReviewerID sql.NullInt32 `json:"reviewer_id"`
+	Decision   int32         `json:"decision"`
+	Reason     string        `json:"reason"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+}
+
+type CampaignRevision struct {
+	ID           int32           `json:"id"`
+	CampaignID   int32           `json:"campaign_id"`
+	Revision     int32           `json:"revision"`
+	AuthorID     sql.NullInt32   `json:"author_id"`
+	Title        string          `json:"title"`
+	Description  sql.NullString  `json:"description"`
+	Slug         string          `json:"slug"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Status       int32           `json:"status"`
+	Images       []string        `json:"images"`
+	Tags         []string        `json:"tags"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+type CampaignShortLink struct {
+	ID         int32        `json:"id"`
+	CampaignID int32        `json:"campaign_id"`
+	Code       string       `json:"code"`
+	Clicks     int64        `json:"clicks"`
+	CreatedAt  sql.NullTime `json:"created_at"`
+}
+
+type CampaignSimilarity struct {
+	CampaignID        int32           `json:"campaign_id"`
+	SimilarCampaignID int32           `json:"similar_campaign_id"`
+	Score             decimal.Decimal `json:"score"`
+	ComputedAt        time.Time       `json:"computed_at"`
+}
+
+type CampaignSlugRedirect struct {
+	Slug       string       `json:"slug"`
+	CampaignID int32        `json:"campaign_id"`
+	CreatedAt  sql.NullTime `json:"created_at"`
+}
+
+type CampaignTrendingScore struct {
+	CampaignID      int32           `json:"campaign_id"`
+	Score           decimal.Decimal `json:"score"`
+	RecentDonations int32           `json:"recent_donations"`
+	RecentAmount    decimal.Decimal `json:"recent_amount"`
+	ComputedAt      time.Time       `json:"computed_at"`
+}
+
+type CampaignUpdate struct {
+	ID         int32         `json:"id"`
+	CampaignID int32         `json:"campaign_id"`
+	AuthorID   sql.NullInt32 `json:"author_id"`
+	Title      string        `json:"title"`
+	Body       string        `json:"body"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+	UpdatedAt  sql.NullTime  `json:"updated_at"`
+	DeletedAt  sql.NullTime  `json:"deleted_at"`
+}
+
+type Donation struct {
+	ID           int32           `json:"id"`
+	DonaturID    int32           `json:"donatur_id"`
+	CampaignID   int32           `json:"campaign_id"`
+	Amount       decimal.Decimal `json:"amount"`
+	Note         sql.NullString  `json:"note"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	UpdatedAt    sql.NullTime    `json:"updated_at"`
+	FundraiserID sql.NullInt32   `json:"fundraiser_id"`
+}
+
+type DonationDedication struct {
+	ID             int32          `json:"id"`
+	DonationID     int32          `json:"donation_id"`
+	Type           string         `json:"type"`
+	HonoreeName    string         `json:"honoree_name"`
+	RecipientEmail sql.NullString `json:"recipient_email"`
+	Message        sql.NullString `json:"message"`
+	IsPublic       bool           `json:"is_public"`
+	EcardSentAt    sql.NullTime   `json:"ecard_sent_at"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+}
+
+type DonationMatch struct {
+	ID             int32           `json:"id"`
+	PoolID         int32           `json:"pool_id"`
+	DonationID     int32           `json:"donation_id"`
+	CampaignID     int32           `json:"campaign_id"`
+	DonationAmount decimal.Decimal `json:"donation_amount"`
+	Amount         decimal.Decimal `json:"amount"`
+	CreatedAt      sql.NullTime    `json:"created_at"`
+}
+
+type DonationReward struct {
+	ID              int32          `json:"id"`
+	DonationID      int32          `json:"donation_id"`
+	RewardTierID    int32          `json:"reward_tier_id"`
+	CampaignID      int32          `json:"campaign_id"`
+	Status          int32          `json:"status"`
+	ShippingName    sql.NullString `json:"shipping_name"`
+	ShippingPhone   sql.NullString `json:"shipping_phone"`
+	ShippingAddress sql.NullString `json:"shipping_address"`
+	FulfilledAt     sql.NullTime   `json:"fulfilled_at"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+}
+
+type Donatur struct {
+	ID          int32          `json:"id"`
+	UserID      int32          `json:"user_id"`
+	CampaignID  int32          `json:"campaign_id"`
+	Name        string         `json:"name"`
+	Email       sql.NullString `json:"email"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	IsAnonymous bool           `json:"is_anonymous"`
+}
+
 type Payment struct {
 	ID            int32                 `json:"id"`
 	TransactionID uuid.UUID             `json:"transaction_id"`
@@ -68,6 +268,21 @@ type Payment struct {
 	UpdatedAt     sql.NullTime          `json:"updated_at"`
 }
 
+type RewardTier struct {
+	ID               int32           `json:"id"`
+	CampaignID       int32           `json:"campaign_id"`
+	Title            string          `json:"title"`
+	Description      sql.NullString  `json:"description"`
+	MinAmount        decimal.Decimal `json:"min_amount"`
+	Quantity         sql.NullInt32   `json:"quantity"`
+	Reserved         int32           `json:"reserved"`
+	Claimed          int32           `json:"claimed"`
+	RequiresShipping bool            `json:"requires_shipping"`
+	CreatedAt        sql.NullTime    `json:"created_at"`
+	UpdatedAt        sql.NullTime    `json:"updated_at"`
+	DeletedAt        sql.NullTime    `json:"deleted_at"`
+}
+
 type User struct {
 	ID        int32        `json:"id"`
 	Name      string       `json:"name"`
@@ -75,4 +290,5 @@ type User struct {
 	Password  string       `json:"password"`
 	CreatedAt sql.NullTime `json:"created_at"`
 	UpdatedAt sql.NullTime `json:"updated_at"`
+	IsAdmin   bool         `json:"is_admin"`
 }
@@ -44,3 +44,35 @@ const (
 	DonationPaymentStatusPaid
 	DonationPaymentStatusRetry
 )
+
+var donationPaymentStatusNames = []string{"pending", "processing", "success", "failed", "expired", "paid", "retry"}
+
+func (s DonationPaymentStatus) String() string {
+	if s < 0 || int(s) >= len(donationPaymentStatusNames) {
+		return "unknown"
+	}
+
+	return donationPaymentStatusNames[s]
+}
+
+// ParseDonationPaymentStatus maps a status name back to its value.
+func ParseDonationPaymentStatus(name string) (DonationPaymentStatus, bool) {
+	for i, n := range donationPaymentStatusNames {
+		if n == name {
+			return DonationPaymentStatus(i), true
+		}
+	}
+
+	return 0, false
+}
+
+type DonationRewardStatus int
+
+const (
+	DonationRewardStatusReserved DonationRewardStatus = iota + 1
+	DonationRewardStatusClaimed
+	DonationRewardStatusReleased
+	// DonationRewardStatusUnavailable is a reward paid for after its
+	// reservation was released and the tier sold out in the meantime
+	DonationRewardStatusUnavailable
+)
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+type AnalyticsService struct {
+	q *sqlc.Queries
+}
+
+func NewAnalyticsService(q *sqlc.Queries) *AnalyticsService {
+	return &AnalyticsService{
+		q: q,
+	}
+}
+
+// GetCampaignAnalytics computes the owner dashboard of a campaign between
+// From and To, both inclusive. Paid figures are dated by the payment date,
+// the funnel by the day the donation was created.
+func (s *AnalyticsService) GetCampaignAnalytics(ctx context.Context, req CampaignAnalyticsRequest) (*CampaignAnalytics, error) {
+	daily, err := s.q.GetCampaignDailyDonations(ctx, sqlc.GetCampaignDailyDonationsParams{
+		FromDate:   req.From,
+		ToDate:     req.To,
+		CampaignID: req.CampaignID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily donations: %w", err)
+	}
+
+	funnel, err := s.q.GetCampaignDonationFunnel(ctx, sqlc.GetCampaignDonationFunnelParams{
+		CampaignID: req.CampaignID,
+		FromDate:   req.From,
+		ToDate:     req.To,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get donation funnel: %w", err)
+	}
+
+	summary, err := s.q.GetCampaignDonationSummary(ctx, sqlc.GetCampaignDonationSummaryParams{
+		CampaignID: req.CampaignID,
+		FromDate:   req.From,
+		ToDate:     req.To,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get donation summary: %w", err)
+	}
+
+	methods, err := s.q.GetCampaignPaymentMethods(ctx, sqlc.GetCampaignPaymentMethodsParams{
+		CampaignID: req.CampaignID,
+		FromDate:   req.From,
+		ToDate:     req.To,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment methods: %w", err)
+	}
+
+	donors, err := s.q.GetCampaignTopDonors(ctx, sqlc.GetCampaignTopDonorsParams{
+		CampaignID: req.CampaignID,
+		FromDate:   req.From,
+		ToDate:     req.To,
+		Limit:      req.TopDonors,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top donors: %w", err)
+	}
+
+	analytics := &CampaignAnalytics{
+		From:  req.From.Format(time.DateOnly),
+		To:    req.To.Format(time.DateOnly),
+		Daily: make([]DailyDonations, 0, len(daily)),
+		Funnel: DonationFunnel{
+			Intents:     funnel.Intents,
+			Invoices:    funnel.Invoices,
+			Paid:        funnel.Paid,
+			InvoiceRate: percentOf(funnel.Invoices, funnel.Intents),
+			PaidRate:    percentOf(funnel.Paid, funnel.Intents),
+		},
+		Summary: DonationSummary{
+			Donations: summary.Donations,
+			Total:     summary.Total,
+			Average:   summary.Average.Round(2),
+			Median:    summary.Median.Round(2),
+			Smallest:  summary.Smallest,
+			Largest:   summary.Largest,
+		},
+		Methods:   make([]PaymentMethod, 0, len(methods)),
+		TopDonors: make([]AnalyticsDonor, 0, len(donors)),
+	}
+
+	for _, day := range daily {
+		analytics.Daily = append(analytics.Daily, DailyDonations{
+			Date:      day.Day.Format(time.DateOnly),
+			Donations: day.Donations,
+			Total:     day.Total,
+		})
+	}
+
+	for _, method := range methods {
+		analytics.Methods = append(analytics.Methods, PaymentMethod(method))
+	}
+
+	for _, donor := range donors {
+		analytics.TopDonors = append(analytics.TopDonors, AnalyticsDonor(donor))
+	}
+
+	return analytics, nil
+}
+
+func percentOf(part, whole int64) decimal.Decimal {
+	if whole == 0 {
+		return decimal.Zero
+	}
+
+	return decimal.NewFromInt(part).Mul(decimal.NewFromInt(100)).Div(decimal.NewFromInt(whole)).Round(2)
+}
@@ -2,9 +2,14 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/shopspring/decimal"
 	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/events"
 	"go-campaign.com/internal/shared/http/request"
 	"go-campaign.com/internal/shared/services/payment"
 )
@@ -13,31 +18,36 @@ type CampaignService struct {
 	p                  payment.PaymentGateway
 	donationRepository repository.DonationRepository
 	campaignRepository repository.CampaignRepository
+	events             *events.Bus
 }
 
 func NewCampaignService(
 	p payment.PaymentGateway,
 	donationRepository repository.DonationRepository,
 	campaignRepository repository.CampaignRepository,
+	eventBus *events.Bus,
 ) *CampaignService {
 	return &CampaignService{
 		p:                  p,
 		donationRepository: donationRepository,
 		campaignRepository: campaignRepository,
+		events:             eventBus,
 	}
 }
 
-func (s *CampaignService) GetCampaigns(ctx context.Context, offset, limit int32) ([]repository.CampaignList, int, error) {
-	campaigns, err := s.campaignRepository.GetPaginatedCampaigns(ctx, request.PaginationRequest{
-		Offset: offset,
-		Limit:  limit,
+func (s *CampaignService) GetCampaigns(ctx context.Context, req GetCampaignListRequest) ([]repository.CampaignList, int, error) {
+	filter := campaignFilter(req)
+
+	campaigns, err := s.campaignRepository.GetPaginatedCampaigns(ctx, filter, request.PaginationRequest{
+		Offset: req.Offset,
+		Limit:  req.Limit,
 	})
 
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get campaigns: %w", err)
 	}
 
-	totalCount, err := s.campaignRepository.GetTotalCampaign(ctx)
+	totalCount, err := s.campaignRepository.GetTotalCampaign(ctx, filter)
 
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get total campaigns count: %w", err)
@@ -46,15 +56,67 @@ func (s *CampaignService) GetCampaigns(ctx context.Context, offset, limit int32)
 	return campaigns, int(totalCount), nil
 }
 
+func (s *CampaignService) GetCampaignsByCursor(ctx context.Context, req GetCampaignListRequest) (*request.CursorPage[repository.CampaignList], error) {
+	page, err := s.campaignRepository.GetCampaignsByCursor(ctx, campaignFilter(req), request.CursorPaginationRequest{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaigns: %w", err)
+	}
+
+	return page, nil
+}
+
 func (s *CampaignService) GetCampaignBySlug(ctx context.Context, slug string) (*repository.DetailCampaign, error) {
 	campaign, err := s.campaignRepository.GetCampaignBySlug(ctx, slug)
 	if err != nil {
 		return nil, err
 	}
 
+	return s.detailCampaign(ctx, campaign)
+}
+
+// detailCampaign adds what the campaign page shows next to the campaign
+// itself.
+func (s *CampaignService) detailCampaign(ctx context.Context, campaign *repository.DetailCampaign) (*repository.DetailCampaign, error) {
+	var err error
+
+	campaign.Milestones, err = s.campaignRepository.GetCampaignMilestones(ctx, campaign.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign.MatchingPools, err = s.campaignRepository.GetActiveMatchingPools(ctx, campaign.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return campaign, nil
 }
 
+// FindRedirectedSlug returns the slug a renamed campaign moved to, empty when
+// slug was never used by a published campaign.
+func (s *CampaignService) FindRedirectedSlug(ctx context.Context, slug string) (string, error) {
+	return s.campaignRepository.FindRedirectedSlug(ctx, slug)
+}
+
+// GetCampaignPreview shows a campaign through its preview link, the same way
+// GetCampaignBySlug shows a published one.
+func (s *CampaignService) GetCampaignPreview(ctx context.Context, token string) (*repository.DetailCampaign, error) {
+	campaign, err := s.campaignRepository.GetCampaignByPreviewToken(ctx, token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPreviewNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.detailCampaign(ctx, campaign)
+}
+
 func (s *CampaignService) Donate(ctx context.Context, request DonationRequest) (string, error) {
 	var url string
 
@@ -82,7 +144,7 @@ func (s *CampaignService) Donate(ctx context.Context, request DonationRequest) (
 	})
 
 	if err != nil {
-		invoiceErr := s.donationRepository.MarkInvoiceFailed(ctx, invoiceIntent.PaymentID)
+		invoiceErr := s.donationRepository.MarkInvoiceFailed(ctx, invoiceIntent.PaymentID, invoiceIntent.DonationID)
 
 		if invoiceErr != nil {
 			return "", fmt.Errorf("failed to mark invoice as failed: %w", invoiceErr)
@@ -104,10 +166,10 @@ func (s *CampaignService) UpdatePaymentFromCallback(ctx context.Context, webhook
 	status, exists := payment.MapPaymentStatus[webhookEvent.Status]
 
 	if !exists {
-		return fmt.Errorf("payment status is invalid: %w", webhookEvent.Status)
+		return fmt.Errorf("payment status is invalid: %s", webhookEvent.Status)
 	}
 
-	err := s.donationRepository.UpdateDonationPaymentFromWebhook(ctx, repository.UpdatePayment{
+	result, err := s.donationRepository.UpdateDonationPaymentFromWebhook(ctx, repository.UpdatePayment{
 		ExternalID:    webhookEvent.ExternalID,
 		RawData:       webhookEvent.RawData,
 		PaidAt:        webhookEvent.PaidAt,
@@ -115,7 +177,33 @@ func (s *CampaignService) UpdatePaymentFromCallback(ctx context.Context, webhook
 		Status:        status,
 	})
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	if !result.Paid {
+		return nil
+	}
+
+	s.events.Publish(events.PaymentPaid{
+		PaymentID:  result.PaymentID,
+		DonationID: result.DonationID,
+		DonaturID:  result.DonaturID,
+		CampaignID: result.CampaignID,
+		Amount:     result.Amount,
+	})
+
+	for _, milestone := range result.ReachedMilestones {
+		s.events.Publish(events.MilestoneReached{
+			CampaignID:  result.CampaignID,
+			MilestoneID: milestone.ID,
+			Amount:      milestone.Amount,
+			Description: milestone.Description,
+			Stretch:     milestone.Stretch,
+		})
+	}
+
+	return nil
 }
 
 func (s *CampaignService) GetDonatur(ctx context.Context, req GetDonaturListRequest) ([]repository.DonaturList, int, error) {
@@ -137,3 +225,50 @@ func (s *CampaignService) GetDonatur(ctx context.Context, req GetDonaturListRequ
 
 	return donaturs, int(totalCount), nil
 }
+
+func (s *CampaignService) GetDonaturByCursor(ctx context.Context, req GetDonaturListRequest) (*request.CursorPage[repository.DonaturList], error) {
+	page, err := s.donationRepository.GetDonaturByCursor(ctx, req.Slug, request.CursorPaginationRequest{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get donaturs: %w", err)
+	}
+
+	return page, nil
+}
+
+func campaignFilter(req GetCampaignListRequest) repository.CampaignFilter {
+	filter := repository.CampaignFilter{
+		Query:       req.Query,
+		Sort:        req.Sort,
+		Category:    req.Category,
+		ProgressMin: req.ProgressMin,
+		ProgressMax: req.ProgressMax,
+		TargetMin:   optionalDecimal(req.TargetMin),
+		TargetMax:   optionalDecimal(req.TargetMax),
+		Province:    strings.TrimSpace(req.Province),
+		City:        strings.TrimSpace(req.City),
+	}
+
+	if req.Latitude != nil && req.Longitude != nil {
+		filter.Near = &repository.GeoPoint{
+			Latitude:  *req.Latitude,
+			Longitude: *req.Longitude,
+		}
+		filter.RadiusKm = req.RadiusKm
+	}
+
+	return filter
+}
+
+func optionalDecimal(value *float64) *decimal.Decimal {
+	if value == nil {
+		return nil
+	}
+
+	d := decimal.NewFromFloat(*value)
+
+	return &d
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+)
+
+var ErrCampaignUpdateNotFound = errors.New("campaign update not found")
+
+// maxCampaignUpdates caps how many of the latest updates are listed.
+const maxCampaignUpdates = 50
+
+// CampaignUpdateService manages the news campaign members post to keep
+// donors informed.
+type CampaignUpdateService struct {
+	q         *sqlc.Queries
+	campaigns repository.CampaignRepository
+}
+
+func NewCampaignUpdateService(q *sqlc.Queries, campaigns repository.CampaignRepository) *CampaignUpdateService {
+	return &CampaignUpdateService{
+		q:         q,
+		campaigns: campaigns,
+	}
+}
+
+func (s *CampaignUpdateService) Create(ctx context.Context, req CreateCampaignUpdateRequest) (*CampaignUpdate, error) {
+	row, err := s.q.CreateCampaignUpdate(ctx, sqlc.CreateCampaignUpdateParams{
+		CampaignID: req.CampaignID,
+		AuthorID:   sql.NullInt32{Int32: req.AuthorID, Valid: true},
+		Title:      req.Title,
+		Body:       req.Body,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create campaign update: %w", err)
+	}
+
+	return &CampaignUpdate{
+		ID:        row.ID,
+		Title:     row.Title,
+		Body:      row.Body,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// GetUpdates lists the latest updates of the campaign, newest first.
+func (s *CampaignUpdateService) GetUpdates(ctx context.Context, campaignID int32) ([]CampaignUpdate, error) {
+	rows, err := s.q.GetCampaignUpdates(ctx, sqlc.GetCampaignUpdatesParams{
+		CampaignID: campaignID,
+		Limit:      maxCampaignUpdates,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign updates: %w", err)
+	}
+
+	updates := make([]CampaignUpdate, 0, len(rows))
+
+	for _, row := range rows {
+		updates = append(updates, CampaignUpdate{
+			ID:         row.ID,
+			Title:      row.Title,
+			Body:       row.Body,
+			AuthorName: row.AuthorName,
+			CreatedAt:  row.CreatedAt,
+			UpdatedAt:  row.UpdatedAt,
+		})
+	}
+
+	return updates, nil
+}
+
+// GetPublishedUpdates lists the updates of a published campaign along with
+// the campaign itself.
+func (s *CampaignUpdateService) GetPublishedUpdates(ctx context.Context, slug string) (*repository.DetailCampaign, []CampaignUpdate, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, nil, ErrCampaignNotFound
+	}
+
+	updates, err := s.GetUpdates(ctx, campaign.ID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return campaign, updates, nil
+}
+
+func (s *CampaignUpdateService) Delete(ctx context.Context, campaignID, updateID int32) error {
+	deleted, err := s.q.DeleteCampaignUpdate(ctx, sqlc.DeleteCampaignUpdateParams{
+		ID:         updateID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete campaign update: %w", err)
+	}
+
+	if deleted == 0 {
+		return ErrCampaignUpdateNotFound
+	}
+
+	return nil
+}
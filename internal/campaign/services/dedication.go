@@ -0,0 +1,180 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"strings"
+	"time"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/shared/events"
+	"go-campaign.com/pkg/mailer"
+)
+
+const (
+	// ECardRetryInterval is how often the e-cards that failed are sent again
+	ECardRetryInterval = 15 * time.Minute
+	// eCardRetryDelay leaves the PaymentPaid event time to send the e-card
+	// before a retry picks it up
+	eCardRetryDelay = 10 * time.Minute
+	// eCardRetryWindow is how long an e-card that keeps failing is retried
+	eCardRetryWindow = 7 * 24 * time.Hour
+	// eCardRetryBatch bounds the e-cards sent by one run
+	eCardRetryBatch = 100
+)
+
+// DedicationService delivers the e-cards of donations made in memory or in
+// honor of someone.
+type DedicationService struct {
+	db     *sql.DB
+	q      *sqlc.Queries
+	mailer mailer.Mailer
+	appURL string
+}
+
+func NewDedicationService(db *sql.DB, q *sqlc.Queries, mailer mailer.Mailer, appURL string) *DedicationService {
+	return &DedicationService{
+		db:     db,
+		q:      q,
+		mailer: mailer,
+		appURL: strings.TrimRight(appURL, "/"),
+	}
+}
+
+// SendECard emails the e-card of a paid donation to the recipient of its
+// dedication, donations without one or without a recipient are skipped. An
+// e-card that fails is sent again by ResendECards.
+func (s *DedicationService) SendECard(ctx context.Context, event events.Event) {
+	paid := event.(events.PaymentPaid)
+
+	if err := s.sendECard(ctx, paid.DonationID); err != nil {
+		log.Print(err)
+	}
+}
+
+// ResendECards sends the e-cards of the donations paid in the retry window
+// that are still unsent, because the mail failed or the process stopped
+// before the PaymentPaid event was handled. One instance sends at a time, the
+// others skip the run.
+func (s *DedicationService) ResendECards(ctx context.Context) error {
+	return runLocked(ctx, s.db, s.q, "ecards", func(qtx *sqlc.Queries) error {
+		now := time.Now()
+
+		donations, err := qtx.GetUnsentECardDonations(ctx, sqlc.GetUnsentECardDonationsParams{
+			PaidAfter:  now.Add(-eCardRetryWindow),
+			PaidBefore: now.Add(-eCardRetryDelay),
+			Limit:      eCardRetryBatch,
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to get the unsent e-cards: %w", err)
+		}
+
+		for _, donationID := range donations {
+			// marked outside of the lock's transaction, a sent e-card stays
+			// marked whatever happens to the rest of the run
+			if err := s.sendECard(ctx, donationID); err != nil {
+				log.Print(err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *DedicationService) sendECard(ctx context.Context, donationID int32) error {
+	card, err := s.q.GetUnsentECard(ctx, donationID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get the e-card of donation %d: %w", donationID, err)
+	}
+
+	message, err := s.eCardMessage(card)
+
+	if err != nil {
+		return fmt.Errorf("failed to render the e-card of donation %d: %w", donationID, err)
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("failed to send the e-card of donation %d: %w", donationID, err)
+	}
+
+	if err := s.q.MarkECardSent(ctx, card.ID); err != nil {
+		return fmt.Errorf("failed to mark the e-card of donation %d as sent: %w", donationID, err)
+	}
+
+	return nil
+}
+
+type eCardData struct {
+	DonorName     string
+	Dedication    string
+	Message       string
+	CampaignTitle string
+	CampaignURL   string
+}
+
+func (s *DedicationService) eCardMessage(card sqlc.GetUnsentECardRow) (mailer.Message, error) {
+	phrase := entities.DedicationPhrase(card.Type)
+
+	data := eCardData{
+		DonorName:     card.DonorName,
+		Dedication:    fmt.Sprintf("%s %s", phrase, card.HonoreeName),
+		Message:       card.Message,
+		CampaignTitle: card.CampaignTitle,
+		CampaignURL:   fmt.Sprintf("%s/campaigns/%s", s.appURL, card.CampaignSlug),
+	}
+
+	var html bytes.Buffer
+
+	if err := eCardTemplate.Execute(&html, data); err != nil {
+		return mailer.Message{}, err
+	}
+
+	var body strings.Builder
+
+	fmt.Fprintf(&body, "%s made a donation to \"%s\".\n\n", data.DonorName, data.CampaignTitle)
+	fmt.Fprintf(&body, "%s\n\n", data.Dedication)
+
+	if data.Message != "" {
+		fmt.Fprintf(&body, "\"%s\"\n\n", data.Message)
+	}
+
+	fmt.Fprintf(&body, "See the campaign: %s\n", data.CampaignURL)
+
+	return mailer.Message{
+		To:      []string{card.RecipientEmail},
+		Subject: fmt.Sprintf("%s made a donation %s %s", data.DonorName, strings.ToLower(phrase), card.HonoreeName),
+		Body:    body.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+var eCardTemplate = template.Must(template.New("ecard.html").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Dedication}}</title>
+</head>
+<body style="margin:0;padding:24px 16px;font-family:Georgia,serif;background:#f3f4f6;color:#111827">
+<div style="box-sizing:border-box;max-width:520px;margin:0 auto;padding:40px 32px;border-radius:12px;background:#ffffff;border-top:8px solid #16a34a;text-align:center">
+<div style="font-size:14px;letter-spacing:2px;text-transform:uppercase;color:#6b7280">A gift was made</div>
+<h1 style="font-size:28px;font-weight:normal;margin:16px 0">{{.Dedication}}</h1>
+{{if .Message}}<p style="font-size:18px;font-style:italic;line-height:1.6;margin:24px 0">&ldquo;{{.Message}}&rdquo;</p>
+{{end}}<p style="font-size:15px;line-height:1.6;color:#374151">{{.DonorName}} made a donation to <strong>{{.CampaignTitle}}</strong>.</p>
+<a href="{{.CampaignURL}}" style="display:inline-block;margin-top:16px;padding:10px 20px;border-radius:6px;background:#16a34a;color:#ffffff;font-family:Helvetica,Arial,sans-serif;font-size:14px;text-decoration:none">See the campaign</a>
+</div>
+</body>
+</html>
+`))
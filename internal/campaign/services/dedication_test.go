@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/sqlfake"
+)
+
+func TestDedicationServiceResendECards(t *testing.T) {
+	db, fake := sqlfake.New()
+	mail := &recordingMailer{failTo: "down@mail.test"}
+	s := NewDedicationService(db, sqlc.New(db), mail, "https://go-campaign.com/")
+
+	fake.On("TryJobLock", func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{Rows: [][]driver.Value{{true}}}
+	})
+	fake.On("GetUnsentECardDonations", func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{Rows: [][]driver.Value{{int64(3)}, {int64(4)}, {int64(5)}}}
+	})
+
+	recipients := map[int32]string{3: "down@mail.test", 4: "ibu.sari@mail.test"}
+
+	fake.On("GetUnsentECard", func(args []driver.Value) sqlfake.Result {
+		recipient, ok := recipients[args[0].(int32)]
+
+		// sent by the PaymentPaid event in the meantime
+		if !ok {
+			return sqlfake.Result{}
+		}
+
+		return sqlfake.Result{Rows: [][]driver.Value{{
+			int64(args[0].(int32)) + 10, "memory", "Pak Harto", recipient, "", "Budi", "Bantu Korban Banjir", "bantu-korban-banjir",
+		}}}
+	})
+	fake.On("MarkECardSent", func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{RowsAffected: 1}
+	})
+
+	if err := s.ResendECards(context.Background()); err != nil {
+		t.Fatalf("ResendECards() error = %v", err)
+	}
+
+	// the e-cards the PaymentPaid event may still be sending are left alone
+	args := fake.Calls("GetUnsentECardDonations")[0].Args
+
+	if before := args[1].(time.Time); time.Since(before) < eCardRetryDelay-time.Minute {
+		t.Errorf("paid_before = %v, want at least %v ago", before, eCardRetryDelay)
+	}
+
+	if after := args[0].(time.Time); time.Since(after) < eCardRetryWindow-time.Minute || time.Since(after) > eCardRetryWindow+time.Minute {
+		t.Errorf("paid_after = %v, want %v ago", after, eCardRetryWindow)
+	}
+
+	if len(mail.messages) != 1 || mail.messages[0].To[0] != "ibu.sari@mail.test" {
+		t.Fatalf("sent %v, want the e-card of donation 4", mail.messages)
+	}
+
+	if body := mail.messages[0].Body; !strings.Contains(body, "In memory of Pak Harto") || !strings.Contains(body, "https://go-campaign.com/campaigns/bantu-korban-banjir") {
+		t.Errorf("e-card body = %s", body)
+	}
+
+	// the failed e-card stays unsent for the next run
+	if marked := fake.Calls("MarkECardSent"); len(marked) != 1 || marked[0].Args[0] != int32(14) {
+		t.Errorf("MarkECardSent calls = %v, want dedication 14", marked)
+	}
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/pgnotify"
+)
+
+const (
+	// DonationStreamRetryInterval is how soon a failed listener is restarted
+	DonationStreamRetryInterval = 5 * time.Second
+	// donationStreamBuffer is how far a slow client may fall behind before
+	// events are dropped for it
+	donationStreamBuffer = 16
+)
+
+// Names of the events pushed to the campaign page.
+const (
+	StreamEventDonation = "donation"
+	StreamEventProgress = "progress"
+)
+
+type DonationStreamEvent struct {
+	Name string
+	Data any
+}
+
+// DonationStreamService pushes the donations paid on any app instance to the
+// clients watching the campaign on this one, instances learn about them
+// through Postgres LISTEN/NOTIFY.
+type DonationStreamService struct {
+	dsn       string
+	campaigns repository.CampaignRepository
+	// shutdown ends the subscriptions when the server shuts down
+	shutdown <-chan struct{}
+
+	mu          sync.RWMutex
+	subscribers map[int32]map[chan DonationStreamEvent]struct{}
+}
+
+func NewDonationStreamService(dsn string, campaigns repository.CampaignRepository, shutdown <-chan struct{}) *DonationStreamService {
+	return &DonationStreamService{
+		dsn:         dsn,
+		campaigns:   campaigns,
+		shutdown:    shutdown,
+		subscribers: make(map[int32]map[chan DonationStreamEvent]struct{}),
+	}
+}
+
+// DonationSubscription receives the events of a campaign until it is closed.
+type DonationSubscription struct {
+	// Progress is the campaign progress when the subscription started
+	Progress StreamProgress
+	Events   <-chan DonationStreamEvent
+	// Done is closed when the server shuts down, the stream must end then
+	Done  <-chan struct{}
+	close func()
+}
+
+func (s *DonationSubscription) Close() {
+	s.close()
+}
+
+// Subscribe starts receiving the events of a published campaign.
+func (s *DonationStreamService) Subscribe(ctx context.Context, slug string) (*DonationSubscription, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	events := make(chan DonationStreamEvent, donationStreamBuffer)
+
+	s.mu.Lock()
+	if s.subscribers[campaign.ID] == nil {
+		s.subscribers[campaign.ID] = make(map[chan DonationStreamEvent]struct{})
+	}
+	s.subscribers[campaign.ID][events] = struct{}{}
+	s.mu.Unlock()
+
+	var once sync.Once
+
+	return &DonationSubscription{
+		Progress: StreamProgress{
+			CurrentAmount: campaign.CurrentAmount,
+			TargetAmount:  campaign.TargetAmount,
+			Progress:      progressPercent(campaign.CurrentAmount, campaign.TargetAmount),
+		},
+		Events: events,
+		Done:   s.shutdown,
+		close: func() {
+			once.Do(func() {
+				s.unsubscribe(campaign.ID, events)
+			})
+		},
+	}, nil
+}
+
+func (s *DonationStreamService) unsubscribe(campaignID int32, events chan DonationStreamEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subscribers[campaignID], events)
+
+	if len(s.subscribers[campaignID]) == 0 {
+		delete(s.subscribers, campaignID)
+	}
+}
+
+// Listen receives the paid donations of every app instance until ctx is
+// done, it is run by the scheduler which restarts it when it fails.
+func (s *DonationStreamService) Listen(ctx context.Context) error {
+	return pgnotify.Listen(ctx, s.dsn, repository.DonationPaidChannel, s.dispatch)
+}
+
+func (s *DonationStreamService) dispatch(payload string) {
+	var notification repository.DonationPaidNotification
+
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+		log.Printf("invalid donation notification %q: %v", payload, err)
+
+		return
+	}
+
+	// anonymous donors are already named "Anonymous" in the notification
+	donation := DonationStreamEvent{
+		Name: StreamEventDonation,
+		Data: StreamDonation{
+			DonationID: notification.DonationID,
+			DonorName:  notification.DonorName,
+			Anonymous:  notification.Anonymous,
+			Amount:     notification.Amount,
+			PaidAt:     notification.PaidAt,
+		},
+	}
+
+	progress := DonationStreamEvent{
+		Name: StreamEventProgress,
+		Data: StreamProgress{
+			CurrentAmount: notification.CurrentAmount,
+			TargetAmount:  notification.TargetAmount,
+			Progress:      progressPercent(notification.CurrentAmount, notification.TargetAmount),
+			Matched:       notification.Matched,
+		},
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for events := range s.subscribers[notification.CampaignID] {
+		for _, event := range []DonationStreamEvent{donation, progress} {
+			// a client that can't keep up misses events rather than
+			// holding up the others
+			select {
+			case events <- event:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/services/repository"
+)
+
+// streamCampaigns answers GetCampaignBySlug, the stream needs nothing else.
+type streamCampaigns struct {
+	repository.CampaignRepository
+	campaigns map[string]*repository.DetailCampaign
+}
+
+func (r streamCampaigns) GetCampaignBySlug(_ context.Context, slug string) (*repository.DetailCampaign, error) {
+	if campaign, ok := r.campaigns[slug]; ok {
+		return campaign, nil
+	}
+
+	return nil, errors.New("campaign not found")
+}
+
+func newTestDonationStream(shutdown <-chan struct{}) *DonationStreamService {
+	return NewDonationStreamService("", streamCampaigns{campaigns: map[string]*repository.DetailCampaign{
+		"bantu-korban-banjir": {ID: 1, CurrentAmount: decimal.NewFromInt(250000), TargetAmount: decimal.NewFromInt(1000000)},
+		"beasiswa-anak-desa":  {ID: 2, CurrentAmount: decimal.Zero, TargetAmount: decimal.NewFromInt(500000)},
+	}}, shutdown)
+}
+
+func donationPaidPayload(campaignID, donationID int32) string {
+	return fmt.Sprintf(
+		`{"campaign_id":%d,"donation_id":%d,"donor_name":"Anonymous","anonymous":true,"amount":"50000","matched":"25000","current_amount":"325000","target_amount":"1000000","paid_at":"2024-03-01T08:00:00Z"}`,
+		campaignID,
+		donationID,
+	)
+}
+
+func TestDonationStreamDispatch(t *testing.T) {
+	s := newTestDonationStream(nil)
+	ctx := context.Background()
+
+	if _, err := s.Subscribe(ctx, "unknown"); !errors.Is(err, ErrCampaignNotFound) {
+		t.Fatalf("Subscribe(unknown) error = %v, want ErrCampaignNotFound", err)
+	}
+
+	first, err := s.Subscribe(ctx, "bantu-korban-banjir")
+
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	second, _ := s.Subscribe(ctx, "bantu-korban-banjir")
+	other, _ := s.Subscribe(ctx, "beasiswa-anak-desa")
+
+	if !first.Progress.Progress.Equal(decimal.NewFromInt(25)) {
+		t.Errorf("initial progress = %s, want 25", first.Progress.Progress)
+	}
+
+	s.dispatch(donationPaidPayload(1, 7))
+	// invalid payloads are logged and dropped
+	s.dispatch("not json")
+
+	for i, subscription := range []*DonationSubscription{first, second} {
+		if len(subscription.Events) != 2 {
+			t.Fatalf("subscriber %d got %d events, want 2", i, len(subscription.Events))
+		}
+
+		donation := <-subscription.Events
+
+		if data, ok := donation.Data.(StreamDonation); donation.Name != StreamEventDonation || !ok || data.DonationID != 7 || data.DonorName != "Anonymous" {
+			t.Errorf("subscriber %d donation event = %+v", i, donation)
+		}
+
+		progress := <-subscription.Events
+		data, ok := progress.Data.(StreamProgress)
+
+		if progress.Name != StreamEventProgress || !ok || !data.Progress.Equal(decimal.NewFromFloat(32.5)) || !data.Matched.Equal(decimal.NewFromInt(25000)) {
+			t.Errorf("subscriber %d progress event = %+v", i, progress)
+		}
+	}
+
+	if len(other.Events) != 0 {
+		t.Errorf("a subscriber of another campaign got %d events", len(other.Events))
+	}
+
+	// a closed subscription receives nothing more, closing twice is fine
+	first.Close()
+	first.Close()
+	s.dispatch(donationPaidPayload(1, 8))
+
+	if len(first.Events) != 0 || len(second.Events) != 2 {
+		t.Errorf("after Close the subscribers got %d and %d events, want 0 and 2", len(first.Events), len(second.Events))
+	}
+
+	second.Close()
+	other.Close()
+
+	if len(s.subscribers) != 0 {
+		t.Errorf("subscribers left after closing every subscription: %v", s.subscribers)
+	}
+}
+
+func TestDonationStreamDropsForSlowClients(t *testing.T) {
+	s := newTestDonationStream(nil)
+	ctx := context.Background()
+
+	slow, _ := s.Subscribe(ctx, "bantu-korban-banjir")
+	fast, _ := s.Subscribe(ctx, "bantu-korban-banjir")
+	defer slow.Close()
+	defer fast.Close()
+
+	// every donation pushes two events, the slow client never reads
+	for id := int32(1); id <= donationStreamBuffer; id++ {
+		s.dispatch(donationPaidPayload(1, id))
+
+		for range 2 {
+			<-fast.Events
+		}
+	}
+
+	if len(slow.Events) != donationStreamBuffer {
+		t.Fatalf("the slow client has %d events, want a full buffer of %d", len(slow.Events), donationStreamBuffer)
+	}
+
+	// the oldest events are kept, the new ones dropped
+	if event := <-slow.Events; event.Data.(StreamDonation).DonationID != 1 {
+		t.Errorf("first buffered event = %+v, want donation 1", event)
+	}
+
+	if len(fast.Events) != 0 {
+		t.Errorf("the fast client has %d unread events", len(fast.Events))
+	}
+}
+
+func TestDonationStreamShutdown(t *testing.T) {
+	shutdown := make(chan struct{})
+	s := newTestDonationStream(shutdown)
+
+	subscription, _ := s.Subscribe(context.Background(), "bantu-korban-banjir")
+	defer subscription.Close()
+
+	select {
+	case <-subscription.Done:
+		t.Fatal("the subscription is done before the shutdown")
+	default:
+	}
+
+	close(shutdown)
+
+	select {
+	case <-subscription.Done:
+	default:
+		t.Error("the subscription isn't done after the shutdown")
+	}
+}
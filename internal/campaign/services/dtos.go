@@ -4,14 +4,38 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/request"
 )
 
 type PaginatedCampaignRequest struct {
 	UserID int32
 	Limit  int32
 	Offset int32
-	Title  string
+	Query  string
 	Status int32
+	// Cursor is only used by the cursor paginated list
+	Cursor *request.Cursor
+}
+
+type GetCampaignListRequest struct {
+	Query       string
+	Sort        string
+	Category    string
+	ProgressMin *float64
+	ProgressMax *float64
+	TargetMin   *float64
+	TargetMax   *float64
+	Province    string
+	City        string
+	// Latitude and Longitude are given together, RadiusKm needs them
+	Latitude  *float64
+	Longitude *float64
+	RadiusKm  *float64
+	Limit     int32
+	Offset    int32
+	Cursor    *request.Cursor
 }
 
 type CreateCampaignRequest struct {
@@ -19,11 +43,15 @@ type CreateCampaignRequest struct {
 	Title        string
 	Description  string
 	Slug         string
-	TargetAmount float32
+	TargetAmount decimal.Decimal
 	StartDate    string
 	EndDate      string
 	Status       int
 	Images       []string // List of image file names
+	Tags         []string
+	Category     string
+	// Location is optional, nil removes the location of an updated campaign
+	Location *repository.CampaignLocation
 }
 
 type Campaign struct {
@@ -39,18 +67,24 @@ type Campaign struct {
 }
 
 type DonationRequest struct {
-	CampaignID int32
-	UserID     int32
-	Amount     decimal.Decimal
-	Name       string
-	Email      string
-	Note       *string
+	CampaignID   int32
+	UserID       int32
+	Amount       decimal.Decimal
+	Name         string
+	Email        string
+	Note         *string
+	RewardTierID *int32
+	Shipping     *repository.ShippingAddress
+	Anonymous    bool
+	Fundraiser   string
+	Dedication   *repository.Dedication
 }
 
 type GetDonaturListRequest struct {
 	Slug   string
 	Limit  int32
 	Offset int32
+	Cursor *request.Cursor
 }
 
 type DonaturList struct {
@@ -59,3 +93,437 @@ type DonaturList struct {
 	Email  string  `json:"email"`
 	Amount float32 `json:"amount"`
 }
+
+type RewardTierRequest struct {
+	Title            string
+	Description      string
+	MinAmount        decimal.Decimal
+	Quantity         *int32
+	RequiresShipping bool
+}
+
+type RewardTier struct {
+	ID               int32           `json:"id"`
+	Title            string          `json:"title"`
+	Description      string          `json:"description"`
+	MinAmount        decimal.Decimal `json:"min_amount"`
+	Quantity         *int32          `json:"quantity"`
+	Remaining        *int32          `json:"remaining"`
+	Claimed          int32           `json:"claimed"`
+	RequiresShipping bool            `json:"requires_shipping"`
+}
+
+type GetRewardFulfilmentsRequest struct {
+	CampaignID   int32
+	RewardTierID int32
+	// Fulfilled filters on the fulfilment state, nil returns both
+	Fulfilled *bool
+	Limit     int32
+	Offset    int32
+}
+
+type MilestoneRequest struct {
+	Amount      decimal.Decimal
+	Description string
+}
+
+type InviteMemberRequest struct {
+	CampaignID int32
+	InvitedBy  int32
+	Email      string
+	Role       entities.MemberRole
+}
+
+type CampaignMember struct {
+	ID         int32      `json:"id"`
+	UserID     *int32     `json:"user_id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	AcceptedAt *time.Time `json:"accepted_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type Invitation struct {
+	ID            int32     `json:"id"`
+	CampaignID    int32     `json:"campaign_id"`
+	CampaignTitle string    `json:"campaign_title"`
+	Role          string    `json:"role"`
+	Token         string    `json:"token"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ReviewCampaignRequest struct {
+	CampaignID int32
+	ReviewerID int32
+	Approved   bool
+	Reason     string
+}
+
+type CampaignReview struct {
+	ID           int32     `json:"id"`
+	Approved     bool      `json:"approved"`
+	Reason       string    `json:"reason"`
+	ReviewerName string    `json:"reviewer_name"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type ReportCampaignRequest struct {
+	Slug string
+	// ReporterKey identifies the reporter without storing who they are
+	ReporterKey string
+	Category    string
+	Description string
+}
+
+type ResolveReportsRequest struct {
+	CampaignID int32
+	AdminID    int32
+	// Suspend upholds the reports and suspends the campaign, otherwise the
+	// reports are dismissed and the campaign is reinstated
+	Suspend bool
+	Note    string
+}
+
+type CampaignReport struct {
+	ID          int32      `json:"id"`
+	Category    string     `json:"category"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Note        string     `json:"note,omitempty"`
+	ResolvedAt  *time.Time `json:"resolved_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type CampaignAnalyticsRequest struct {
+	CampaignID int32
+	From       time.Time
+	To         time.Time
+	TopDonors  int32
+}
+
+type CampaignAnalytics struct {
+	From      string           `json:"from"`
+	To        string           `json:"to"`
+	Daily     []DailyDonations `json:"daily"`
+	Funnel    DonationFunnel   `json:"funnel"`
+	Summary   DonationSummary  `json:"summary"`
+	Methods   []PaymentMethod  `json:"payment_methods"`
+	TopDonors []AnalyticsDonor `json:"top_donors"`
+}
+
+type DailyDonations struct {
+	Date      string          `json:"date"`
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+}
+
+// DonationFunnel follows the donations created in the period, rates are in
+// percent of the intents.
+type DonationFunnel struct {
+	Intents     int64           `json:"intents"`
+	Invoices    int64           `json:"invoices"`
+	Paid        int64           `json:"paid"`
+	InvoiceRate decimal.Decimal `json:"invoice_rate"`
+	PaidRate    decimal.Decimal `json:"paid_rate"`
+}
+
+type DonationSummary struct {
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+	Average   decimal.Decimal `json:"average"`
+	Median    decimal.Decimal `json:"median"`
+	Smallest  decimal.Decimal `json:"smallest"`
+	Largest   decimal.Decimal `json:"largest"`
+}
+
+type PaymentMethod struct {
+	Method    string          `json:"method"`
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+}
+
+// AnalyticsDonor hides the identity of anonymous donors from the team too,
+// their UserID is 0.
+type AnalyticsDonor struct {
+	UserID      int32           `json:"user_id"`
+	Name        string          `json:"name"`
+	IsAnonymous bool            `json:"anonymous"`
+	Donations   int64           `json:"donations"`
+	Total       decimal.Decimal `json:"total"`
+}
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+type ExportDonationsRequest struct {
+	CampaignID int32
+	Format     ExportFormat
+	From       *time.Time
+	To         *time.Time
+	Status     *int32
+}
+
+type CampaignRevisionSummary struct {
+	Revision   int32     `json:"revision"`
+	Title      string    `json:"title"`
+	Status     string    `json:"status"`
+	AuthorID   *int32    `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// CampaignRevision is the campaign exactly as it was saved by one update.
+type CampaignRevision struct {
+	Revision     int32           `json:"revision"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	Slug         string          `json:"slug"`
+	TargetAmount decimal.Decimal `json:"target_amount"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Status       string          `json:"status"`
+	Images       []string        `json:"images"`
+	Tags         []string        `json:"tags"`
+	AuthorID     *int32          `json:"author_id"`
+	AuthorName   string          `json:"author_name"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+type RevisionDiff struct {
+	From    CampaignRevisionSummary `json:"from"`
+	To      CampaignRevisionSummary `json:"to"`
+	Changes []FieldChange           `json:"changes"`
+}
+
+// FieldChange is a campaign field whose value differs between two revisions.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+type CreatePreviewRequest struct {
+	CampaignID int32
+	UserID     int32
+	ExpiresIn  time.Duration
+}
+
+type CampaignPreview struct {
+	ID            int32      `json:"id"`
+	Token         string     `json:"token"`
+	URL           string     `json:"url"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	Active        bool       `json:"active"`
+	CreatedByName string     `json:"created_by_name,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type WidgetRequest struct {
+	Slug   string
+	Format WidgetFormat
+	Theme  string
+	Size   string
+}
+
+type Widget struct {
+	CampaignID  int32
+	Body        []byte
+	ContentType string
+	ETag        string
+}
+
+type ShareLinks struct {
+	PageURL   string `json:"page_url"`
+	ShortURL  string `json:"short_url"`
+	QRCodeURL string `json:"qr_code_url"`
+	Clicks    int64  `json:"clicks"`
+}
+
+type LandingPage struct {
+	Campaign *repository.DetailCampaign
+	Links    ShareLinks
+	// Description is the campaign description cut to fit link previews
+	Description string
+	ImageURL    string
+	Raised      string
+	Target      string
+	Percent     int
+	BarPercent  int
+}
+
+type CreateCampaignUpdateRequest struct {
+	CampaignID int32
+	AuthorID   int32
+	Title      string
+	Body       string
+}
+
+type CampaignUpdate struct {
+	ID         int32     `json:"id"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	AuthorName string    `json:"author_name,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type LeaderboardDonor struct {
+	Rank      int             `json:"rank"`
+	Name      string          `json:"name"`
+	Anonymous bool            `json:"anonymous"`
+	Donations int64           `json:"donations"`
+	Total     decimal.Decimal `json:"total"`
+}
+
+type CreateFundraiserRequest struct {
+	UserID       int32
+	CampaignSlug string
+	Slug         string
+	Title        string
+	Story        string
+	TargetAmount decimal.Decimal
+}
+
+type UpdateFundraiserRequest struct {
+	ID           int32
+	UserID       int32
+	Title        string
+	Story        string
+	TargetAmount decimal.Decimal
+}
+
+type Fundraiser struct {
+	ID            int32           `json:"id"`
+	Slug          string          `json:"slug"`
+	Title         string          `json:"title"`
+	Story         string          `json:"story,omitempty"`
+	OwnerName     string          `json:"owner_name,omitempty"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	// Progress is expressed in percent of the target amount
+	Progress  decimal.Decimal     `json:"progress"`
+	Donations int64               `json:"donations,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	Campaign  *FundraiserCampaign `json:"campaign,omitempty"`
+}
+
+// FundraiserCampaign is the parent campaign a fundraiser raises money for.
+type FundraiserCampaign struct {
+	ID    int32  `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+type RankedFundraiser struct {
+	Rank int `json:"rank"`
+	Fundraiser
+}
+
+type CreateMatchingPoolRequest struct {
+	CampaignID   int32
+	UserID       int32
+	SponsorName  string
+	SponsorEmail string
+	Ratio        decimal.Decimal
+	Cap          decimal.Decimal
+	StartsAt     string
+	EndsAt       string
+}
+
+// SponsorMatchingPool is a matching pool as the campaign team sees it,
+// ConfirmedAt is nil until the sponsor confirmed the pledge.
+type SponsorMatchingPool struct {
+	repository.MatchingPool
+	SponsorEmail    string     `json:"sponsor_email"`
+	Active          bool       `json:"active"`
+	ConfirmedAt     *time.Time `json:"confirmed_at"`
+	CancelledAt     *time.Time `json:"cancelled_at"`
+	StatementSentAt *time.Time `json:"statement_sent_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// MatchingPledge is what the sponsor is asked to confirm.
+type MatchingPledge struct {
+	CampaignTitle string
+	SponsorName   string
+	Ratio         decimal.Decimal
+	Cap           decimal.Decimal
+	StartsAt      time.Time
+	EndsAt        time.Time
+}
+
+// MatchingPoolStatement lists what a sponsor matched, donors who gave
+// anonymously stay anonymous.
+type MatchingPoolStatement struct {
+	Pool      SponsorMatchingPool `json:"pool"`
+	Donations int                 `json:"donations"`
+	Donated   decimal.Decimal     `json:"donated"`
+	Matched   decimal.Decimal     `json:"matched"`
+	Matches   []DonationMatch     `json:"matches"`
+}
+
+type DonationMatch struct {
+	DonationID     int32           `json:"donation_id"`
+	DonorName      string          `json:"donor_name"`
+	DonationAmount decimal.Decimal `json:"donation_amount"`
+	Amount         decimal.Decimal `json:"amount"`
+	MatchedAt      time.Time       `json:"matched_at"`
+}
+
+// PlatformStats are the platform-wide totals shown on the homepage, as of
+// ComputedAt.
+type PlatformStats struct {
+	TotalRaised     decimal.Decimal `json:"total_raised"`
+	Donors          int64           `json:"donors"`
+	Donations       int64           `json:"donations"`
+	ActiveCampaigns int64           `json:"active_campaigns"`
+	FundedCampaigns int64           `json:"funded_campaigns"`
+	Categories      []CategoryStats `json:"categories"`
+	ComputedAt      time.Time       `json:"computed_at"`
+}
+
+type CategoryStats struct {
+	Category        string          `json:"category"`
+	TotalRaised     decimal.Decimal `json:"total_raised"`
+	Donors          int64           `json:"donors"`
+	Donations       int64           `json:"donations"`
+	ActiveCampaigns int64           `json:"active_campaigns"`
+	FundedCampaigns int64           `json:"funded_campaigns"`
+}
+
+type SimilarCampaign struct {
+	ID            int32           `json:"id"`
+	Title         string          `json:"title"`
+	Slug          string          `json:"slug"`
+	Category      string          `json:"category"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	Progress      decimal.Decimal `json:"progress"`
+	EndDate       time.Time       `json:"end_date"`
+	Score         decimal.Decimal `json:"score"`
+}
+
+// StreamDonation is pushed to the campaign page when a donation is paid.
+type StreamDonation struct {
+	DonationID int32           `json:"donation_id"`
+	DonorName  string          `json:"donor_name"`
+	Anonymous  bool            `json:"anonymous"`
+	Amount     decimal.Decimal `json:"amount"`
+	PaidAt     time.Time       `json:"paid_at"`
+}
+
+// StreamProgress is pushed to the campaign page when its amount changes,
+// Matched is what sponsors added on top of the latest donation.
+type StreamProgress struct {
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	Progress      decimal.Decimal `json:"progress"`
+	Matched       decimal.Decimal `json:"matched"`
+}
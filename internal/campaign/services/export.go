@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/xlsx"
+)
+
+// exportFlushEvery is how many rows are buffered before they are pushed to
+// the client.
+const exportFlushEvery = 1000
+
+var exportHeader = []string{
+	"donation_id",
+	"transaction_id",
+	"donor_name",
+	"donor_email",
+	"amount",
+	"status",
+	"method",
+	"vendor",
+	"paid_at",
+	"note",
+	"created_at",
+}
+
+type ExportService struct {
+	donations repository.DonationRepository
+}
+
+func NewExportService(donations repository.DonationRepository) *ExportService {
+	return &ExportService{
+		donations: donations,
+	}
+}
+
+// ExportDonations writes the donations of a campaign to w as csv or xlsx.
+// Rows are written while they are read from the database, so the export
+// never holds the whole campaign in memory.
+func (s *ExportService) ExportDonations(ctx context.Context, w io.Writer, req ExportDonationsRequest) error {
+	var rw exportRowWriter
+
+	switch req.Format {
+	case ExportFormatXLSX:
+		xw, err := xlsx.NewWriter(w, "Donations")
+
+		if err != nil {
+			return fmt.Errorf("failed to start the workbook: %w", err)
+		}
+
+		rw = &xlsxRowWriter{w: xw}
+	default:
+		rw = &csvRowWriter{w: csv.NewWriter(w)}
+	}
+
+	if err := rw.header(exportHeader); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	count := 0
+
+	err := s.donations.ExportDonations(ctx, repository.DonationExportFilter{
+		CampaignID: req.CampaignID,
+		From:       req.From,
+		To:         req.To,
+		Status:     req.Status,
+	}, func(row repository.DonationExportRow) error {
+		if err := rw.row(row); err != nil {
+			return fmt.Errorf("failed to write row: %w", err)
+		}
+
+		count++
+
+		if count%exportFlushEvery == 0 {
+			return rw.flush()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return rw.close()
+}
+
+type exportRowWriter interface {
+	header(columns []string) error
+	row(row repository.DonationExportRow) error
+	flush() error
+	close() error
+}
+
+type csvRowWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvRowWriter) header(columns []string) error {
+	return c.w.Write(columns)
+}
+
+func (c *csvRowWriter) row(row repository.DonationExportRow) error {
+	return c.w.Write([]string{
+		strconv.Itoa(int(row.DonationID)),
+		row.TransactionID.String(),
+		csvSafe(row.DonorName),
+		csvSafe(row.DonorEmail),
+		row.Amount.StringFixed(2),
+		sqlc.DonationPaymentStatus(row.Status).String(),
+		csvSafe(row.Method),
+		csvSafe(row.Vendor),
+		exportTime(row.PaidAt),
+		csvSafe(row.Note),
+		row.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+func (c *csvRowWriter) flush() error {
+	c.w.Flush()
+
+	return c.w.Error()
+}
+
+func (c *csvRowWriter) close() error {
+	return c.flush()
+}
+
+type xlsxRowWriter struct {
+	w *xlsx.Writer
+}
+
+func (x *xlsxRowWriter) header(columns []string) error {
+	cells := make([]xlsx.Cell, 0, len(columns))
+
+	for _, column := range columns {
+		cells = append(cells, xlsx.String(column))
+	}
+
+	return x.w.Write(cells)
+}
+
+func (x *xlsxRowWriter) row(row repository.DonationExportRow) error {
+	return x.w.Write([]xlsx.Cell{
+		xlsx.Number(strconv.Itoa(int(row.DonationID))),
+		xlsx.String(row.TransactionID.String()),
+		xlsx.String(row.DonorName),
+		xlsx.String(row.DonorEmail),
+		xlsx.Number(row.Amount.StringFixed(2)),
+		xlsx.String(sqlc.DonationPaymentStatus(row.Status).String()),
+		xlsx.String(row.Method),
+		xlsx.String(row.Vendor),
+		xlsx.String(exportTime(row.PaidAt)),
+		xlsx.String(row.Note),
+		xlsx.String(row.CreatedAt.Format(time.RFC3339)),
+	})
+}
+
+func (x *xlsxRowWriter) flush() error {
+	return x.w.Flush()
+}
+
+func (x *xlsxRowWriter) close() error {
+	return x.w.Close()
+}
+
+// csvSafe keeps spreadsheet apps from evaluating donor supplied text as a
+// formula when the file is opened.
+func csvSafe(value string) string {
+	if value == "" {
+		return value
+	}
+
+	switch value[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + value
+	}
+
+	return value
+}
+
+func exportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
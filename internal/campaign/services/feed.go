@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/feed"
+)
+
+const (
+	// feedSize is how many of the newest entries a feed carries
+	feedSize = 50
+	// feedSummaryLength keeps the campaign summaries short, readers link to
+	// the campaign page for the whole story
+	feedSummaryLength = 300
+)
+
+type FeedService struct {
+	campaigns *CampaignService
+	updates   *CampaignUpdateService
+	appURL    string
+}
+
+func NewFeedService(campaigns *CampaignService, updates *CampaignUpdateService, appURL string) *FeedService {
+	return &FeedService{
+		campaigns: campaigns,
+		updates:   updates,
+		appURL:    appURL,
+	}
+}
+
+// CampaignsFeed lists the newest active campaigns, optionally of a single
+// category.
+func (s *FeedService) CampaignsFeed(ctx context.Context, category string) (*feed.Feed, error) {
+	campaigns, _, err := s.campaigns.GetCampaigns(ctx, GetCampaignListRequest{
+		Sort:     repository.SortNewest,
+		Category: category,
+		Limit:    feedSize,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	title := "New campaigns"
+
+	if category != "" {
+		query.Set("category", category)
+		title = fmt.Sprintf("New %s campaigns", category)
+	}
+
+	f := &feed.Feed{
+		Title:       title,
+		Description: "The newest campaigns open for donations",
+		Link:        s.appURL,
+		URL:         s.feedURL("/feeds/campaigns", query),
+		Items:       make([]feed.Item, 0, len(campaigns)),
+	}
+
+	for _, campaign := range campaigns {
+		item := feed.Item{
+			ID:        fmt.Sprintf("urn:go-campaign:campaign:%d", campaign.ID),
+			Title:     campaign.Title,
+			Link:      fmt.Sprintf("%s/campaigns/%s", s.appURL, campaign.Slug),
+			Summary:   campaignSummary(campaign),
+			Published: campaign.StartDate,
+			Updated:   campaign.UpdatedAt,
+		}
+
+		if campaign.Category != nil {
+			item.Categories = []string{*campaign.Category}
+		}
+
+		f.Items = append(f.Items, item)
+	}
+
+	f.Updated = lastUpdated(f.Items, time.Unix(0, 0))
+
+	return f, nil
+}
+
+// UpdatesFeed lists the latest updates posted on a published campaign.
+func (s *FeedService) UpdatesFeed(ctx context.Context, slug string) (*feed.Feed, error) {
+	campaign, updates, err := s.updates.GetPublishedUpdates(ctx, slug)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pageURL := fmt.Sprintf("%s/campaigns/%s", s.appURL, campaign.Slug)
+
+	f := &feed.Feed{
+		Title:       fmt.Sprintf("Updates of %s", campaign.Title),
+		Description: fmt.Sprintf("News from the campaign %s", campaign.Title),
+		Link:        pageURL,
+		URL:         s.feedURL(fmt.Sprintf("/feeds/campaigns/%s/updates", campaign.Slug), nil),
+		Items:       make([]feed.Item, 0, len(updates)),
+	}
+
+	for _, update := range updates {
+		f.Items = append(f.Items, feed.Item{
+			ID:        fmt.Sprintf("urn:go-campaign:campaign-update:%d", update.ID),
+			Title:     update.Title,
+			Link:      fmt.Sprintf("%s#update-%d", pageURL, update.ID),
+			Summary:   update.Body,
+			Author:    update.AuthorName,
+			Published: update.CreatedAt,
+			Updated:   update.UpdatedAt,
+		})
+	}
+
+	f.Updated = lastUpdated(f.Items, campaign.StartDate)
+
+	return f, nil
+}
+
+func (s *FeedService) feedURL(path string, query url.Values) string {
+	if len(query) == 0 {
+		return s.appURL + path
+	}
+
+	return s.appURL + path + "?" + query.Encode()
+}
+
+// campaignSummary leaves the raised amount out, donations don't touch
+// updated_at and the feed validators would keep an outdated amount cached.
+func campaignSummary(campaign repository.CampaignList) string {
+	target := fmt.Sprintf(
+		"Target %s, ends %s.",
+		FormatRupiah(campaign.TargetAmount),
+		campaign.EndDate.Format("2 Jan 2006"),
+	)
+
+	description := strings.Join(strings.Fields(campaign.Description), " ")
+
+	if description == "" {
+		return target
+	}
+
+	return truncateTitle(description, feedSummaryLength) + "\n\n" + target
+}
+
+// lastUpdated is the time the newest entry changed, an empty feed falls back
+// to a fixed time so it keeps the same validators.
+func lastUpdated(items []feed.Item, fallback time.Time) time.Time {
+	var updated time.Time
+
+	for _, item := range items {
+		if item.Updated.After(updated) {
+			updated = item.Updated
+		}
+	}
+
+	if updated.IsZero() {
+		return fallback
+	}
+
+	return updated
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/slug"
+)
+
+var (
+	ErrFundraiserNotFound = repository.ErrFundraiserNotFound
+	// ErrCampaignNotActive is returned when a fundraiser is started for a
+	// campaign that can't take donations
+	ErrCampaignNotActive = errors.New("campaign is not accepting donations")
+)
+
+// FundraiserService manages the pages supporters run for a campaign, every
+// donation made through a page is credited to both the page and its campaign.
+type FundraiserService struct {
+	q         *sqlc.Queries
+	campaigns repository.CampaignRepository
+}
+
+func NewFundraiserService(q *sqlc.Queries, campaigns repository.CampaignRepository) *FundraiserService {
+	return &FundraiserService{
+		q:         q,
+		campaigns: campaigns,
+	}
+}
+
+func (s *FundraiserService) Create(ctx context.Context, req CreateFundraiserRequest) (*Fundraiser, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, req.CampaignSlug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	if campaign.Status != int32(entities.StatusActive) || campaign.Suspended {
+		return nil, ErrCampaignNotActive
+	}
+
+	if req.Slug == "" {
+		req.Slug, err = s.uniqueSlug(ctx, req.Title)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := s.q.CreateFundraiser(ctx, sqlc.CreateFundraiserParams{
+		CampaignID:   campaign.ID,
+		UserID:       req.UserID,
+		Slug:         req.Slug,
+		Title:        req.Title,
+		Story:        req.Story,
+		TargetAmount: req.TargetAmount,
+	})
+
+	if isFundraiserSlugTaken(err) {
+		return nil, FieldErrors{"slug": slugTakenMessage}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fundraiser: %w", err)
+	}
+
+	fundraiser := fundraiserFromModel(row)
+	fundraiser.Campaign = &FundraiserCampaign{
+		ID:    campaign.ID,
+		Slug:  campaign.Slug,
+		Title: campaign.Title,
+	}
+
+	return fundraiser, nil
+}
+
+// GetFundraiser returns a live fundraiser page along with its campaign.
+func (s *FundraiserService) GetFundraiser(ctx context.Context, slug string) (*Fundraiser, error) {
+	row, err := s.q.GetFundraiserBySlug(ctx, slug)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFundraiserNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fundraiser: %w", err)
+	}
+
+	return &Fundraiser{
+		ID:            row.ID,
+		Slug:          row.Slug,
+		Title:         row.Title,
+		Story:         row.Story,
+		OwnerName:     row.OwnerName,
+		TargetAmount:  row.TargetAmount,
+		CurrentAmount: row.CurrentAmount,
+		Progress:      progressPercent(row.CurrentAmount, row.TargetAmount),
+		Donations:     row.Donations,
+		CreatedAt:     row.CreatedAt,
+		Campaign: &FundraiserCampaign{
+			ID:    row.CampaignID,
+			Slug:  row.CampaignSlug,
+			Title: row.CampaignTitle,
+		},
+	}, nil
+}
+
+// Leaderboard ranks the fundraisers of a published campaign by the amount
+// they raised.
+func (s *FundraiserService) Leaderboard(ctx context.Context, campaignSlug string, limit int32) ([]RankedFundraiser, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, campaignSlug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	rows, err := s.q.GetCampaignFundraisers(ctx, sqlc.GetCampaignFundraisersParams{
+		CampaignID: campaign.ID,
+		Limit:      limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign fundraisers: %w", err)
+	}
+
+	fundraisers := make([]RankedFundraiser, 0, len(rows))
+
+	for i, row := range rows {
+		fundraisers = append(fundraisers, RankedFundraiser{
+			Rank: i + 1,
+			Fundraiser: Fundraiser{
+				ID:            row.ID,
+				Slug:          row.Slug,
+				Title:         row.Title,
+				OwnerName:     row.OwnerName,
+				TargetAmount:  row.TargetAmount,
+				CurrentAmount: row.CurrentAmount,
+				Progress:      progressPercent(row.CurrentAmount, row.TargetAmount),
+				CreatedAt:     row.CreatedAt,
+			},
+		})
+	}
+
+	return fundraisers, nil
+}
+
+// GetUserFundraisers lists the live fundraisers the user started.
+func (s *FundraiserService) GetUserFundraisers(ctx context.Context, userID int32) ([]Fundraiser, error) {
+	rows, err := s.q.GetUserFundraisers(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user fundraisers: %w", err)
+	}
+
+	fundraisers := make([]Fundraiser, 0, len(rows))
+
+	for _, row := range rows {
+		fundraisers = append(fundraisers, Fundraiser{
+			ID:            row.ID,
+			Slug:          row.Slug,
+			Title:         row.Title,
+			Story:         row.Story,
+			TargetAmount:  row.TargetAmount,
+			CurrentAmount: row.CurrentAmount,
+			Progress:      progressPercent(row.CurrentAmount, row.TargetAmount),
+			CreatedAt:     row.CreatedAt,
+			Campaign: &FundraiserCampaign{
+				ID:    row.CampaignID,
+				Slug:  row.CampaignSlug,
+				Title: row.CampaignTitle,
+			},
+		})
+	}
+
+	return fundraisers, nil
+}
+
+// Update changes the story and target of a fundraiser, its slug stays so the
+// links already shared keep working.
+func (s *FundraiserService) Update(ctx context.Context, req UpdateFundraiserRequest) (*Fundraiser, error) {
+	row, err := s.q.UpdateFundraiser(ctx, sqlc.UpdateFundraiserParams{
+		ID:           req.ID,
+		UserID:       req.UserID,
+		Title:        req.Title,
+		Story:        req.Story,
+		TargetAmount: req.TargetAmount,
+	})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFundraiserNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update fundraiser: %w", err)
+	}
+
+	return fundraiserFromModel(row), nil
+}
+
+// Delete takes the page down, the donations made through it stay attributed.
+func (s *FundraiserService) Delete(ctx context.Context, id, userID int32) error {
+	deleted, err := s.q.DeleteFundraiser(ctx, sqlc.DeleteFundraiserParams{
+		ID:     id,
+		UserID: userID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete fundraiser: %w", err)
+	}
+
+	if deleted == 0 {
+		return ErrFundraiserNotFound
+	}
+
+	return nil
+}
+
+func (s *FundraiserService) uniqueSlug(ctx context.Context, title string) (string, error) {
+	base := slug.Make(title)
+
+	if base == "" {
+		base = "fundraiser"
+	}
+
+	rows, err := s.q.GetTakenFundraiserSlugs(ctx, base)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get taken fundraiser slugs: %w", err)
+	}
+
+	return freeSlug(base, rows), nil
+}
+
+func fundraiserFromModel(row sqlc.CampaignFundraiser) *Fundraiser {
+	return &Fundraiser{
+		ID:            row.ID,
+		Slug:          row.Slug,
+		Title:         row.Title,
+		Story:         row.Story,
+		TargetAmount:  row.TargetAmount,
+		CurrentAmount: row.CurrentAmount,
+		Progress:      progressPercent(row.CurrentAmount, row.TargetAmount),
+		CreatedAt:     row.CreatedAt.Time,
+	}
+}
+
+func progressPercent(current, target decimal.Decimal) decimal.Decimal {
+	if target.IsZero() {
+		return decimal.Zero
+	}
+
+	return current.Div(target).Mul(decimal.NewFromInt(100)).Round(2)
+}
+
+// isFundraiserSlugTaken reports whether err is a live fundraiser already
+// using the slug.
+func isFundraiserSlugTaken(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "idx_campaign_fundraisers_slug"
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+// runLocked runs fn in a transaction holding the advisory lock of name. Every
+// instance schedules the same jobs, the ones that don't get the lock skip the
+// run instead of computing the same rows concurrently.
+func runLocked(ctx context.Context, db *sql.DB, q *sqlc.Queries, name string, fn func(qtx *sqlc.Queries) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := q.WithTx(tx)
+
+	locked, err := qtx.TryJobLock(ctx, name)
+
+	if err != nil {
+		return fmt.Errorf("failed to take the %s lock: %w", name, err)
+	}
+
+	if !locked {
+		return nil
+	}
+
+	if err := fn(qtx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/sqlfake"
+)
+
+func TestRunLocked(t *testing.T) {
+	for _, locked := range []bool{true, false} {
+		db, fake := sqlfake.New()
+
+		fake.On("TryJobLock", func(args []driver.Value) sqlfake.Result {
+			return sqlfake.Result{Rows: [][]driver.Value{{locked}}}
+		})
+
+		ran := false
+
+		err := runLocked(context.Background(), db, sqlc.New(db), "trending", func(*sqlc.Queries) error {
+			ran = true
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("runLocked() error = %v", err)
+		}
+
+		if calls := fake.Calls("TryJobLock"); len(calls) != 1 || calls[0].Args[0] != "trending" {
+			t.Errorf("TryJobLock calls = %v", calls)
+		}
+
+		// another instance holding the lock skips the run
+		if ran != locked || (fake.Commits() == 1) != locked {
+			t.Errorf("locked = %v: ran = %v, commits = %d", locked, ran, fake.Commits())
+		}
+	}
+}
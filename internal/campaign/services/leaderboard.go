@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/request"
+)
+
+const (
+	TrendingRefreshInterval = 5 * time.Minute
+	// trendingWindow is how far back donations count towards the score
+	trendingWindow = 7 * 24 * time.Hour
+	// trendingHalfLifeHours halves the weight of a donation every day, so the
+	// score follows the donation velocity rather than the total raised
+	trendingHalfLifeHours = 24
+)
+
+// LeaderboardService ranks the donors of a campaign and the campaigns of the
+// platform.
+type LeaderboardService struct {
+	db        *sql.DB
+	q         *sqlc.Queries
+	campaigns repository.CampaignRepository
+}
+
+func NewLeaderboardService(db *sql.DB, q *sqlc.Queries, campaigns repository.CampaignRepository) *LeaderboardService {
+	return &LeaderboardService{
+		db:        db,
+		q:         q,
+		campaigns: campaigns,
+	}
+}
+
+// TopDonors ranks the donors of a published campaign by the total they paid,
+// anonymous donations are ranked without the donor name.
+func (s *LeaderboardService) TopDonors(ctx context.Context, slug string, limit int32) ([]LeaderboardDonor, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	rows, err := s.q.GetCampaignLeaderboard(ctx, sqlc.GetCampaignLeaderboardParams{
+		CampaignID: campaign.ID,
+		Limit:      limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign leaderboard: %w", err)
+	}
+
+	donors := make([]LeaderboardDonor, 0, len(rows))
+
+	for i, row := range rows {
+		donors = append(donors, LeaderboardDonor{
+			Rank:      i + 1,
+			Name:      row.Name,
+			Anonymous: row.IsAnonymous,
+			Donations: row.Donations,
+			Total:     row.Total,
+		})
+	}
+
+	return donors, nil
+}
+
+// Trending lists the active campaigns with the highest trending score, it
+// only reads the scores stored by RefreshTrending.
+func (s *LeaderboardService) Trending(ctx context.Context, category string, limit int32) ([]repository.CampaignList, error) {
+	campaigns, err := s.campaigns.GetPaginatedCampaigns(ctx, repository.CampaignFilter{
+		Sort:         repository.SortTrending,
+		Category:     category,
+		TrendingOnly: true,
+	}, request.PaginationRequest{
+		Limit: limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending campaigns: %w", err)
+	}
+
+	return campaigns, nil
+}
+
+// RefreshTrending recomputes the trending score of every campaign that
+// received a donation within the trending window.
+func (s *LeaderboardService) RefreshTrending(ctx context.Context) error {
+	return runLocked(ctx, s.db, s.q, "trending", func(qtx *sqlc.Queries) error {
+		err := qtx.RefreshTrendingScores(ctx, sqlc.RefreshTrendingScoresParams{
+			HalfLifeHours: trendingHalfLifeHours,
+			Since:         time.Now().Add(-trendingWindow),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to refresh trending scores: %w", err)
+		}
+
+		return nil
+	})
+}
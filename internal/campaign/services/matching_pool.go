@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/mailer"
+)
+
+var (
+	ErrMatchingPoolNotFound = errors.New("matching pool not found")
+	ErrConfirmationNotFound = errors.New("matching pool confirmation not found")
+)
+
+// MatchingStatementInterval is how often closed pools are looked up to email
+// their sponsor a statement.
+const MatchingStatementInterval = time.Hour
+
+// MatchingPoolService manages the pools sponsors fund to match donations,
+// the matching itself happens when a donation is paid.
+type MatchingPoolService struct {
+	db     *sql.DB
+	q      *sqlc.Queries
+	mailer mailer.Mailer
+	appURL string
+}
+
+func NewMatchingPoolService(db *sql.DB, q *sqlc.Queries, mailer mailer.Mailer, appURL string) *MatchingPoolService {
+	return &MatchingPoolService{
+		db:     db,
+		q:      q,
+		mailer: mailer,
+		appURL: strings.TrimRight(appURL, "/"),
+	}
+}
+
+// Create records the pledge and emails the sponsor a confirmation link, the
+// pool doesn't match anything until the sponsor confirmed it.
+func (s *MatchingPoolService) Create(ctx context.Context, campaignTitle string, req CreateMatchingPoolRequest) (*SponsorMatchingPool, error) {
+	startsAt, err := time.Parse(time.DateTime, req.StartsAt)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse starts_at: %w", err)
+	}
+
+	endsAt, err := time.Parse(time.DateTime, req.EndsAt)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ends_at: %w", err)
+	}
+
+	token, err := newToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.q.CreateMatchingPool(ctx, sqlc.CreateMatchingPoolParams{
+		CampaignID:        req.CampaignID,
+		SponsorName:       req.SponsorName,
+		SponsorEmail:      req.SponsorEmail,
+		Ratio:             req.Ratio,
+		Cap:               req.Cap,
+		StartsAt:          startsAt,
+		EndsAt:            endsAt,
+		CreatedBy:         sql.NullInt32{Int32: req.UserID, Valid: true},
+		ConfirmationToken: sql.NullString{String: token, Valid: true},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create matching pool: %w", err)
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      []string{row.SponsorEmail},
+		Subject: fmt.Sprintf("Confirm your matching pledge for %s", campaignTitle),
+		Body: fmt.Sprintf(
+			"Dear %s,\n\nThe team of \"%s\" recorded your pledge to match donations at %s for every 1 donated, up to %s, from %s to %s.\n\nDonations are only matched once you confirm the pledge here:\n%s/matching-pools/%s\n\nIf you didn't make this pledge, ignore this email.\n",
+			row.SponsorName,
+			campaignTitle,
+			row.Ratio.String(),
+			FormatRupiah(row.Cap),
+			row.StartsAt.Format("2 Jan 2006 15:04"),
+			row.EndsAt.Format("2 Jan 2006 15:04"),
+			s.appURL,
+			token,
+		),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to send matching pool confirmation email: %w", err)
+	}
+
+	pool := sponsorMatchingPool(row, time.Now())
+
+	return &pool, nil
+}
+
+// PendingPledge returns the unconfirmed pledge of the emailed token, it is
+// shown to the sponsor before confirming.
+func (s *MatchingPoolService) PendingPledge(ctx context.Context, token string) (*MatchingPledge, error) {
+	row, err := s.q.GetPendingMatchingPool(ctx, sql.NullString{String: token, Valid: true})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrConfirmationNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching pool: %w", err)
+	}
+
+	return &MatchingPledge{
+		CampaignTitle: row.CampaignTitle,
+		SponsorName:   row.SponsorName,
+		Ratio:         row.Ratio,
+		Cap:           row.Cap,
+		StartsAt:      row.StartsAt,
+		EndsAt:        row.EndsAt,
+	}, nil
+}
+
+// Confirm is the sponsor accepting the pledge from the emailed link, the pool
+// matches the donations paid from then on.
+func (s *MatchingPoolService) Confirm(ctx context.Context, token string) error {
+	_, err := s.q.ConfirmMatchingPool(ctx, sql.NullString{String: token, Valid: true})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrConfirmationNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to confirm matching pool: %w", err)
+	}
+
+	return nil
+}
+
+// GetPools lists the matching pools of the campaign, closed ones included.
+func (s *MatchingPoolService) GetPools(ctx context.Context, campaignID int32) ([]SponsorMatchingPool, error) {
+	rows, err := s.q.GetCampaignMatchingPools(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching pools: %w", err)
+	}
+
+	now := time.Now()
+	pools := make([]SponsorMatchingPool, 0, len(rows))
+
+	for _, row := range rows {
+		pools = append(pools, sponsorMatchingPool(row, now))
+	}
+
+	return pools, nil
+}
+
+// Cancel stops the pool from matching further donations, the matches made so
+// far stay credited to the campaign.
+func (s *MatchingPoolService) Cancel(ctx context.Context, campaignID, poolID int32) error {
+	affected, err := s.q.CancelMatchingPool(ctx, sqlc.CancelMatchingPoolParams{
+		ID:         poolID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to cancel matching pool: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrMatchingPoolNotFound
+	}
+
+	return nil
+}
+
+func (s *MatchingPoolService) Statement(ctx context.Context, campaignID, poolID int32) (*MatchingPoolStatement, error) {
+	row, err := s.q.GetMatchingPool(ctx, sqlc.GetMatchingPoolParams{
+		ID:         poolID,
+		CampaignID: campaignID,
+	})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrMatchingPoolNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching pool: %w", err)
+	}
+
+	matches, err := s.q.GetMatchingPoolStatement(ctx, poolID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching pool statement: %w", err)
+	}
+
+	statement := &MatchingPoolStatement{
+		Pool:      sponsorMatchingPool(row, time.Now()),
+		Donations: len(matches),
+		Matches:   make([]DonationMatch, 0, len(matches)),
+	}
+
+	for _, match := range matches {
+		statement.Donated = statement.Donated.Add(match.DonationAmount)
+		statement.Matched = statement.Matched.Add(match.Amount)
+		statement.Matches = append(statement.Matches, DonationMatch{
+			DonationID:     match.DonationID,
+			DonorName:      match.DonorName,
+			DonationAmount: match.DonationAmount,
+			Amount:         match.Amount,
+			MatchedAt:      match.CreatedAt,
+		})
+	}
+
+	return statement, nil
+}
+
+// SendStatements emails their statement to the sponsors of the pools that
+// ended or ran out since the previous run, each pool is only sent once. One
+// instance sends at a time, the others skip the run.
+func (s *MatchingPoolService) SendStatements(ctx context.Context) error {
+	return runLocked(ctx, s.db, s.q, "matching-statements", func(qtx *sqlc.Queries) error {
+		pools, err := qtx.GetPoolsDueForStatement(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to get matching pools due for a statement: %w", err)
+		}
+
+		for _, pool := range pools {
+			statement, err := s.Statement(ctx, pool.CampaignID, pool.ID)
+
+			// one broken pool doesn't hold back the statements of the others
+			if err != nil {
+				log.Printf("failed to build the statement of matching pool %d: %v", pool.ID, err)
+				continue
+			}
+
+			err = s.mailer.Send(ctx, mailer.Message{
+				To:      []string{statement.Pool.SponsorEmail},
+				Subject: fmt.Sprintf("Your matching statement for %s", pool.CampaignTitle),
+				Body:    statementBody(pool.CampaignTitle, statement),
+			})
+
+			// the pool is picked up again on the next run
+			if err != nil {
+				log.Printf("failed to send the statement of matching pool %d: %v", pool.ID, err)
+				continue
+			}
+
+			// marked outside of the lock's transaction, a statement that was
+			// sent stays marked even if a later pool fails the run
+			if err := s.q.MarkMatchingPoolStatementSent(ctx, pool.ID); err != nil {
+				return fmt.Errorf("failed to mark the matching pool statement as sent: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func statementBody(campaignTitle string, statement *MatchingPoolStatement) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Dear %s,\n\n", statement.Pool.SponsorName)
+	fmt.Fprintf(
+		&b,
+		"Thank you for matching donations to \"%s\" from %s to %s.\n\n",
+		campaignTitle,
+		statement.Pool.StartsAt.Format("2 Jan 2006 15:04"),
+		statement.Pool.EndsAt.Format("2 Jan 2006 15:04"),
+	)
+	if statement.Pool.CancelledAt != nil {
+		fmt.Fprintf(&b, "The campaign team cancelled the pool on %s.\n\n", statement.Pool.CancelledAt.Format("2 Jan 2006 15:04"))
+	}
+
+	fmt.Fprintf(&b, "Donations matched: %d\n", statement.Donations)
+	fmt.Fprintf(&b, "Donated by donors: %s\n", FormatRupiah(statement.Donated))
+	fmt.Fprintf(&b, "Matched by you: %s of %s\n\n", FormatRupiah(statement.Matched), FormatRupiah(statement.Pool.Cap))
+
+	for _, match := range statement.Matches {
+		fmt.Fprintf(
+			&b,
+			"%s  %s donated %s, matched %s\n",
+			match.MatchedAt.Format("2006-01-02 15:04"),
+			match.DonorName,
+			FormatRupiah(match.DonationAmount),
+			FormatRupiah(match.Amount),
+		)
+	}
+
+	return b.String()
+}
+
+func sponsorMatchingPool(row sqlc.CampaignMatchingPool, now time.Time) SponsorMatchingPool {
+	open := !now.Before(row.StartsAt) && now.Before(row.EndsAt) && row.MatchedAmount.LessThan(row.Cap)
+
+	pool := SponsorMatchingPool{
+		MatchingPool: repository.MatchingPool{
+			ID:          row.ID,
+			SponsorName: row.SponsorName,
+			Ratio:       row.Ratio,
+			Cap:         row.Cap,
+			Matched:     row.MatchedAmount,
+			Remaining:   row.Cap.Sub(row.MatchedAmount),
+			StartsAt:    row.StartsAt,
+			EndsAt:      row.EndsAt,
+		},
+		SponsorEmail: row.SponsorEmail,
+		Active:       open && row.ConfirmedAt.Valid && !row.DeletedAt.Valid,
+		CreatedAt:    row.CreatedAt.Time,
+	}
+
+	if row.ConfirmedAt.Valid {
+		pool.ConfirmedAt = &row.ConfirmedAt.Time
+	}
+
+	if row.DeletedAt.Valid {
+		pool.CancelledAt = &row.DeletedAt.Time
+	}
+
+	if row.StatementSentAt.Valid {
+		pool.StatementSentAt = &row.StatementSentAt.Time
+	}
+
+	return pool
+}
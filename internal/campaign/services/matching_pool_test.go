@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/mailer"
+	"go-campaign.com/pkg/sqlfake"
+)
+
+// recordingMailer keeps the messages it is asked to send, the ones sent to
+// failTo fail.
+type recordingMailer struct {
+	mu       sync.Mutex
+	messages []mailer.Message
+	failTo   string
+}
+
+func (m *recordingMailer) Send(_ context.Context, message mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if message.To[0] == m.failTo {
+		return errors.New("mailbox unavailable")
+	}
+
+	m.messages = append(m.messages, message)
+
+	return nil
+}
+
+func matchingPoolRow(id int64, email string) []driver.Value {
+	start := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	return []driver.Value{
+		id, int64(1), "PT Sponsor", email, "1", "1000000", "50000", start, start.Add(24 * time.Hour),
+		nil, nil, start, start, nil, nil, start,
+	}
+}
+
+func TestMatchingPoolServiceSendStatements(t *testing.T) {
+	for _, locked := range []bool{true, false} {
+		db, fake := sqlfake.New()
+		mail := &recordingMailer{failTo: "down@sponsor.test"}
+		s := NewMatchingPoolService(db, sqlc.New(db), mail, "https://go-campaign.com")
+
+		fake.On("TryJobLock", func([]driver.Value) sqlfake.Result {
+			return sqlfake.Result{Rows: [][]driver.Value{{locked}}}
+		})
+		fake.On("GetPoolsDueForStatement", func([]driver.Value) sqlfake.Result {
+			return sqlfake.Result{Rows: [][]driver.Value{
+				{int64(1), int64(1), "Bantu Korban Banjir"},
+				{int64(2), int64(1), "Bantu Korban Banjir"},
+			}}
+		})
+		fake.On("GetMatchingPool", func(args []driver.Value) sqlfake.Result {
+			if args[0] == int32(1) {
+				return sqlfake.Result{Rows: [][]driver.Value{matchingPoolRow(1, "down@sponsor.test")}}
+			}
+
+			return sqlfake.Result{Rows: [][]driver.Value{matchingPoolRow(2, "csr@sponsor.test")}}
+		})
+		fake.On("GetMatchingPoolStatement", func([]driver.Value) sqlfake.Result {
+			return sqlfake.Result{Rows: [][]driver.Value{
+				{int64(7), "Anonymous", "50000", "50000", time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)},
+			}}
+		})
+		fake.On("MarkMatchingPoolStatementSent", func([]driver.Value) sqlfake.Result {
+			return sqlfake.Result{RowsAffected: 1}
+		})
+
+		if err := s.SendStatements(context.Background()); err != nil {
+			t.Fatalf("locked = %v: SendStatements() error = %v", locked, err)
+		}
+
+		// another instance holding the lock sends the statements
+		if !locked {
+			if len(fake.Calls("GetPoolsDueForStatement")) > 0 || len(mail.messages) > 0 {
+				t.Errorf("statements were sent without the lock")
+			}
+
+			continue
+		}
+
+		if len(mail.messages) != 1 || mail.messages[0].To[0] != "csr@sponsor.test" {
+			t.Fatalf("sent %v, want the statement of pool 2 only", mail.messages)
+		}
+
+		if body := mail.messages[0].Body; !strings.Contains(body, "Donations matched: 1") || !strings.Contains(body, "Anonymous donated") {
+			t.Errorf("statement body = %s", body)
+		}
+
+		// the pool whose mail failed is sent again on the next run
+		if marked := fake.Calls("MarkMatchingPoolStatementSent"); len(marked) != 1 || marked[0].Args[0] != int32(2) {
+			t.Errorf("MarkMatchingPoolStatementSent calls = %v, want pool 2", marked)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/mailer"
+)
+
+var (
+	ErrMemberNotFound          = errors.New("member not found")
+	ErrAlreadyMember           = errors.New("this email already belongs to a campaign member")
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to another email address")
+)
+
+type MemberService struct {
+	q      *sqlc.Queries
+	mailer mailer.Mailer
+	appURL string
+}
+
+func NewMemberService(q *sqlc.Queries, mailer mailer.Mailer, appURL string) *MemberService {
+	return &MemberService{
+		q:      q,
+		mailer: mailer,
+		appURL: strings.TrimRight(appURL, "/"),
+	}
+}
+
+func (s *MemberService) GetMembers(ctx context.Context, campaignID int32) ([]CampaignMember, error) {
+	rows, err := s.q.GetCampaignMembers(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign members: %w", err)
+	}
+
+	members := make([]CampaignMember, 0, len(rows))
+
+	for _, row := range rows {
+		member := CampaignMember{
+			ID:        row.ID,
+			Name:      row.Name,
+			Email:     row.Email,
+			Role:      entities.MemberRole(row.Role).String(),
+			Status:    entities.MemberStatus(row.Status).String(),
+			CreatedAt: row.CreatedAt,
+		}
+
+		if row.UserID.Valid {
+			member.UserID = &row.UserID.Int32
+		}
+
+		if row.AcceptedAt.Valid {
+			member.AcceptedAt = &row.AcceptedAt.Time
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// Invite stores the invitation and emails how to answer it. Answering takes a
+// signed in user, so the email points to the API routes of the invitation
+// rather than to a page. Inviting an email again replaces the pending or
+// declined invitation with a fresh token.
+func (s *MemberService) Invite(ctx context.Context, campaignTitle string, req InviteMemberRequest) error {
+	token, err := newToken()
+
+	if err != nil {
+		return err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	_, err = s.q.InviteCampaignMember(ctx, sqlc.InviteCampaignMemberParams{
+		CampaignID: req.CampaignID,
+		Email:      email,
+		Role:       int32(req.Role),
+		Token:      sql.NullString{String: token, Valid: true},
+		InvitedBy:  sql.NullInt32{Int32: req.InvitedBy, Valid: true},
+	})
+
+	// the upsert skips accepted members, so no row comes back
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAlreadyMember
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      []string{email},
+		Subject: fmt.Sprintf("You are invited to join %s", campaignTitle),
+		Body: fmt.Sprintf(
+			"You have been invited to help manage the campaign \"%s\" as %s.\n\nSign in with this email address to accept or decline it, the invitation is listed with your pending invitations at:\n%s\n\nAccept: POST %s/accept\nDecline: POST %s/decline\n",
+			campaignTitle,
+			req.Role,
+			s.appURL+"/api/v1/user/invitations",
+			s.invitationURL(token),
+			s.invitationURL(token),
+		),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MemberService) invitationURL(token string) string {
+	return fmt.Sprintf("%s/api/v1/user/invitations/%s", s.appURL, token)
+}
+
+func (s *MemberService) UpdateRole(ctx context.Context, campaignID, memberID int32, role entities.MemberRole) error {
+	affected, err := s.q.UpdateCampaignMemberRole(ctx, sqlc.UpdateCampaignMemberRoleParams{
+		ID:         memberID,
+		CampaignID: campaignID,
+		Role:       int32(role),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to update member role: %w", err)
+	}
+
+	// the owner row is never updated
+	if affected == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func (s *MemberService) RemoveMember(ctx context.Context, campaignID, memberID int32) error {
+	affected, err := s.q.DeleteCampaignMember(ctx, sqlc.DeleteCampaignMemberParams{
+		ID:         memberID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// GetInvitations lists the pending invitations sent to the user's email.
+func (s *MemberService) GetInvitations(ctx context.Context, userID int32) ([]Invitation, error) {
+	email, err := s.q.GetUserEmail(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	rows, err := s.q.GetPendingInvitations(ctx, strings.ToLower(email))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+
+	invitations := make([]Invitation, 0, len(rows))
+
+	for _, row := range rows {
+		invitations = append(invitations, Invitation{
+			ID:            row.ID,
+			CampaignID:    row.CampaignID,
+			CampaignTitle: row.CampaignTitle,
+			Role:          entities.MemberRole(row.Role).String(),
+			Token:         row.Token.String,
+			CreatedAt:     row.CreatedAt,
+		})
+	}
+
+	return invitations, nil
+}
+
+func (s *MemberService) AcceptInvitation(ctx context.Context, userID int32, token string) error {
+	invitation, err := s.findInvitation(ctx, userID, token)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := s.q.AcceptInvitation(ctx, sqlc.AcceptInvitationParams{
+		ID:     invitation.ID,
+		UserID: sql.NullInt32{Int32: userID, Valid: true},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+func (s *MemberService) DeclineInvitation(ctx context.Context, userID int32, token string) error {
+	invitation, err := s.findInvitation(ctx, userID, token)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := s.q.DeclineInvitation(ctx, invitation.ID)
+
+	if err != nil {
+		return fmt.Errorf("failed to decline invitation: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+// findInvitation returns the pending invitation of the token, it must have
+// been sent to the email of the user answering it.
+func (s *MemberService) findInvitation(ctx context.Context, userID int32, token string) (*sqlc.CampaignMember, error) {
+	invitation, err := s.q.FindInvitationByToken(ctx, sql.NullString{String: token, Valid: true})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvitationNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	email, err := s.q.GetUserEmail(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !strings.EqualFold(email, invitation.Email) {
+		return nil, ErrInvitationEmailMismatch
+	}
+
+	return &invitation, nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 32)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+var ErrPreviewNotFound = errors.New("preview link not found")
+
+type PreviewService struct {
+	q      *sqlc.Queries
+	appURL string
+}
+
+func NewPreviewService(q *sqlc.Queries, appURL string) *PreviewService {
+	return &PreviewService{
+		q:      q,
+		appURL: strings.TrimRight(appURL, "/"),
+	}
+}
+
+// Create generates a preview link that shows the campaign as it would look
+// published, until it expires or is revoked.
+func (s *PreviewService) Create(ctx context.Context, req CreatePreviewRequest) (*CampaignPreview, error) {
+	token, err := newToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.q.CreateCampaignPreview(ctx, sqlc.CreateCampaignPreviewParams{
+		CampaignID: req.CampaignID,
+		Token:      token,
+		CreatedBy:  sql.NullInt32{Int32: req.UserID, Valid: true},
+		ExpiresAt:  time.Now().Add(req.ExpiresIn),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create preview link: %w", err)
+	}
+
+	return &CampaignPreview{
+		ID:        row.ID,
+		Token:     row.Token,
+		URL:       s.previewURL(row.Token),
+		ExpiresAt: row.ExpiresAt,
+		Active:    true,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+// GetPreviews lists every preview link of the campaign, expired and revoked
+// ones included.
+func (s *PreviewService) GetPreviews(ctx context.Context, campaignID int32) ([]CampaignPreview, error) {
+	rows, err := s.q.GetCampaignPreviews(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preview links: %w", err)
+	}
+
+	now := time.Now()
+	previews := make([]CampaignPreview, 0, len(rows))
+
+	for _, row := range rows {
+		preview := CampaignPreview{
+			ID:            row.ID,
+			Token:         row.Token,
+			URL:           s.previewURL(row.Token),
+			ExpiresAt:     row.ExpiresAt,
+			Active:        !row.RevokedAt.Valid && row.ExpiresAt.After(now),
+			CreatedByName: row.CreatedByName,
+			CreatedAt:     row.CreatedAt,
+		}
+
+		if row.RevokedAt.Valid {
+			preview.RevokedAt = &row.RevokedAt.Time
+		}
+
+		previews = append(previews, preview)
+	}
+
+	return previews, nil
+}
+
+func (s *PreviewService) Revoke(ctx context.Context, campaignID, previewID int32) error {
+	revoked, err := s.q.RevokeCampaignPreview(ctx, sqlc.RevokeCampaignPreviewParams{
+		ID:         previewID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to revoke preview link: %w", err)
+	}
+
+	if revoked == 0 {
+		return ErrPreviewNotFound
+	}
+
+	return nil
+}
+
+// previewURL is the public route showing the preview.
+func (s *PreviewService) previewURL(token string) string {
+	return fmt.Sprintf("%s/api/v1/campaigns/previews/%s", s.appURL, token)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+)
+
+const (
+	SimilarityRefreshInterval = time.Hour
+	// similarPerCampaign is how many similar campaigns are kept per campaign,
+	// more than a page ever shows
+	similarPerCampaign = 20
+)
+
+// similarMinScore keeps the campaigns at least as similar as a shared
+// category alone makes them.
+var similarMinScore = decimal.NewFromInt(1)
+
+// RecommendationService suggests related campaigns, similarities are
+// computed offline by RefreshSimilarities and only read on requests.
+type RecommendationService struct {
+	db        *sql.DB
+	q         *sqlc.Queries
+	campaigns repository.CampaignRepository
+}
+
+func NewRecommendationService(db *sql.DB, q *sqlc.Queries, campaigns repository.CampaignRepository) *RecommendationService {
+	return &RecommendationService{
+		db:        db,
+		q:         q,
+		campaigns: campaigns,
+	}
+}
+
+// Similar lists the active campaigns most similar to a published campaign.
+func (s *RecommendationService) Similar(ctx context.Context, slug string, limit int32) ([]SimilarCampaign, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	rows, err := s.q.GetSimilarCampaigns(ctx, sqlc.GetSimilarCampaignsParams{
+		CampaignID: campaign.ID,
+		Limit:      limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get similar campaigns: %w", err)
+	}
+
+	campaigns := make([]SimilarCampaign, 0, len(rows))
+
+	for _, row := range rows {
+		campaigns = append(campaigns, SimilarCampaign{
+			ID:            row.ID,
+			Title:         row.Title,
+			Slug:          row.Slug,
+			Category:      stringValue(row.Category),
+			CurrentAmount: row.CurrentAmount,
+			TargetAmount:  row.TargetAmount,
+			Progress:      row.Progress,
+			EndDate:       row.EndDate,
+			Score:         row.Score,
+		})
+	}
+
+	return campaigns, nil
+}
+
+// RefreshSimilarities recomputes the similar campaigns of every listed
+// campaign, only active campaigns are suggested. One instance refreshes at a
+// time, the others skip the run.
+func (s *RecommendationService) RefreshSimilarities(ctx context.Context) error {
+	return runLocked(ctx, s.db, s.q, "similarities", func(qtx *sqlc.Queries) error {
+		err := qtx.RefreshCampaignSimilarities(ctx, sqlc.RefreshCampaignSimilaritiesParams{
+			MinScore:    similarMinScore,
+			PerCampaign: similarPerCampaign,
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to refresh campaign similarities: %w", err)
+		}
+
+		return nil
+	})
+}
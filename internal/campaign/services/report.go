@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+type ReportService struct {
+	db *sql.DB
+	q  *sqlc.Queries
+	// threshold is the number of open reports that suspends a campaign
+	threshold int
+}
+
+func NewReportService(db *sql.DB, q *sqlc.Queries, threshold int) *ReportService {
+	return &ReportService{
+		db:        db,
+		q:         q,
+		threshold: threshold,
+	}
+}
+
+// Report files an abuse report against a public campaign. A reporter can only
+// report a campaign once, the repeat is ignored and false is returned. The
+// campaign is suspended once its open reports reach the threshold.
+func (s *ReportService) Report(ctx context.Context, req ReportCampaignRequest) (bool, error) {
+	campaign, err := s.q.GetCampaignBySlug(ctx, req.Slug)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, ErrCampaignNotFound
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get campaign: %w", err)
+	}
+
+	created, err := s.q.CreateCampaignReport(ctx, sqlc.CreateCampaignReportParams{
+		CampaignID:  campaign.ID,
+		ReporterKey: req.ReporterKey,
+		Category:    req.Category,
+		Description: sql.NullString{
+			String: req.Description,
+			Valid:  req.Description != "",
+		},
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	if created == 0 {
+		return false, nil
+	}
+
+	if campaign.SuspendedAt.Valid {
+		return true, nil
+	}
+
+	open, err := s.q.CountOpenCampaignReports(ctx, campaign.ID)
+
+	if err != nil {
+		return true, fmt.Errorf("failed to count reports: %w", err)
+	}
+
+	if open < int64(s.threshold) {
+		return true, nil
+	}
+
+	suspended, err := s.q.SuspendCampaign(ctx, sqlc.SuspendCampaignParams{
+		ID: campaign.ID,
+		SuspensionReason: sql.NullString{
+			String: fmt.Sprintf("Suspended automatically after %d open reports", open),
+			Valid:  true,
+		},
+	})
+
+	if err != nil {
+		return true, fmt.Errorf("failed to suspend campaign: %w", err)
+	}
+
+	if suspended > 0 {
+		log.Printf("campaign %d suspended automatically after %d open reports", campaign.ID, open)
+	}
+
+	return true, nil
+}
+
+// GetReportedCampaigns lists the campaigns with open reports, the most
+// reported first.
+func (s *ReportService) GetReportedCampaigns(ctx context.Context, limit, offset int32) ([]sqlc.GetReportedCampaignsRow, int64, error) {
+	campaigns, err := s.q.GetReportedCampaigns(ctx, sqlc.GetReportedCampaignsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get reported campaigns: %w", err)
+	}
+
+	total, err := s.q.GetTotalReportedCampaigns(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get total reported campaigns: %w", err)
+	}
+
+	return campaigns, total, nil
+}
+
+func (s *ReportService) GetCampaignReports(ctx context.Context, campaignID int32) ([]CampaignReport, error) {
+	rows, err := s.q.GetCampaignReports(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign reports: %w", err)
+	}
+
+	reports := make([]CampaignReport, 0, len(rows))
+
+	for _, row := range rows {
+		report := CampaignReport{
+			ID:          row.ID,
+			Category:    row.Category,
+			Description: row.Description.String,
+			Status:      entities.ReportStatus(row.Status).String(),
+			Note:        row.ResolutionNote.String,
+			CreatedAt:   row.CreatedAt,
+		}
+
+		if row.ResolvedAt.Valid {
+			report.ResolvedAt = &row.ResolvedAt.Time
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// Resolve closes the open reports of a campaign with the admin's note, either
+// upholding them and suspending the campaign or dismissing them. Dismissing
+// only lifts a suspension the reports caused, not one an admin upheld.
+func (s *ReportService) Resolve(ctx context.Context, req ResolveReportsRequest) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := s.q.WithTx(tx)
+
+	campaign, err := qtx.FindCampaignForReview(ctx, req.CampaignID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrCampaignNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get campaign: %w", err)
+	}
+
+	status := entities.ReportDismissed
+
+	if req.Suspend {
+		status = entities.ReportUpheld
+		// an automatic suspension takes the admin's reason
+		err = qtx.UpholdCampaignSuspension(ctx, sqlc.UpholdCampaignSuspensionParams{
+			ID:               campaign.ID,
+			SuspensionReason: sql.NullString{String: req.Note, Valid: true},
+		})
+	} else {
+		_, err = qtx.LiftReportSuspension(ctx, campaign.ID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update campaign suspension: %w", err)
+	}
+
+	_, err = qtx.ResolveCampaignReports(ctx, sqlc.ResolveCampaignReportsParams{
+		CampaignID: campaign.ID,
+		Status:     int32(status),
+		ResolvedBy: sql.NullInt32{Int32: req.AdminID, Valid: true},
+		ResolutionNote: sql.NullString{
+			String: req.Note,
+			Valid:  req.Note != "",
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to resolve reports: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
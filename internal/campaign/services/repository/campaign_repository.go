@@ -9,35 +9,157 @@ import (
 )
 
 type CampaignRepository interface {
-	GetPaginatedCampaigns(ctx context.Context, req request.PaginationRequest) ([]CampaignList, error)
-	GetTotalCampaign(ctx context.Context) (int64, error)
+	GetPaginatedCampaigns(ctx context.Context, filter CampaignFilter, req request.PaginationRequest) ([]CampaignList, error)
+	GetCampaignsByCursor(ctx context.Context, filter CampaignFilter, req request.CursorPaginationRequest) (*request.CursorPage[CampaignList], error)
+	GetTotalCampaign(ctx context.Context, filter CampaignFilter) (int64, error)
 	GetCampaignBySlug(ctx context.Context, slug string) (*DetailCampaign, error)
+	GetCampaignByPreviewToken(ctx context.Context, token string) (*DetailCampaign, error)
+	// FindRedirectedSlug returns the current slug of a campaign renamed from
+	// slug, or an empty string when no campaign was
+	FindRedirectedSlug(ctx context.Context, slug string) (string, error)
+	GetCampaignMilestones(ctx context.Context, campaignID int32) ([]Milestone, error)
+	// GetActiveMatchingPools returns the sponsor pools currently matching
+	// donations to the campaign
+	GetActiveMatchingPools(ctx context.Context, campaignID int32) ([]MatchingPool, error)
+}
+
+// Sort options accepted by the public campaign list.
+const (
+	SortRelevance     = "relevance"
+	SortNewest        = "newest"
+	SortEndingSoon    = "ending_soon"
+	SortMostFunded    = "most_funded"
+	SortClosestToGoal = "closest_to_goal"
+	SortMostDonors    = "most_donors"
+	SortTrending      = "trending"
+	// SortDistance needs CampaignFilter.Near
+	SortDistance = "distance"
+)
+
+var CampaignSorts = []string{
+	SortRelevance,
+	SortNewest,
+	SortEndingSoon,
+	SortMostFunded,
+	SortClosestToGoal,
+	SortMostDonors,
+	SortTrending,
+	SortDistance,
+}
+
+// CampaignFilter narrows and orders the public campaign list.
+type CampaignFilter struct {
+	// Query is a full-text search over title, tags and description.
+	Query string
+	// Sort is one of CampaignSorts, an empty value falls back to relevance
+	// when Query is set and newest otherwise.
+	Sort string
+	// Category is one of entities.CampaignCategories
+	Category string
+	// progress is expressed in percent of the target amount
+	ProgressMin *float64
+	ProgressMax *float64
+	TargetMin   *decimal.Decimal
+	TargetMax   *decimal.Decimal
+	// TrendingOnly keeps the campaigns that received donations recently
+	TrendingOnly bool
+	// Province and City filter on the campaign region, case-insensitively
+	Province string
+	City     string
+	// Near computes the distance of every campaign to the point, only
+	// campaigns with coordinates are listed then
+	Near *GeoPoint
+	// RadiusKm keeps the campaigns within that distance of Near
+	RadiusKm *float64
+}
+
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
 }
 
 type CampaignList struct {
 	ID            int32           `json:"id"`
 	Title         string          `json:"title"`
+	Description   string          `json:"description"`
 	Slug          string          `json:"slug"`
+	Category      *string         `json:"category"`
 	CurrentAmount decimal.Decimal `json:"current_amount"`
 	TargetAmount  decimal.Decimal `json:"target_amount"`
 	Progress      decimal.Decimal `json:"progress"`
 	StartDate     time.Time       `json:"start_date"`
 	EndDate       time.Time       `json:"end_date"`
 	Status        string          `json:"status"`
+	DonorCount    int64           `json:"donor_count"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	Province      *string         `json:"province"`
+	City          *string         `json:"city"`
+	// DistanceKm is only filled when the list is filtered by a point
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+	// search fields, only filled when the list is filtered by a query
+	Rank                 float32 `json:"rank,omitempty"`
+	TitleHighlight       string  `json:"title_highlight,omitempty"`
+	DescriptionHighlight string  `json:"description_highlight,omitempty"`
 }
 
 type DetailCampaign struct {
-	ID            int32           `json:"id"`
-	UserID        int32           `json:"user_id"`
-	Title         string          `json:"title"`
-	Description   *string         `json:"description"`
-	Slug          string          `json:"slug"`
-	TargetAmount  decimal.Decimal `json:"target_amount"`
-	CurrentAmount decimal.Decimal `json:"current_amount"`
-	StartDate     time.Time       `json:"start_date"`
-	EndDate       time.Time       `json:"end_date"`
-	UserName      string          `json:"user_name"`
-	UserEmail     string          `json:"user_email"`
-	Progress      decimal.Decimal `json:"progress"`
-	Status        int32           `json:"status"`
+	ID            int32             `json:"id"`
+	UserID        int32             `json:"user_id"`
+	Title         string            `json:"title"`
+	Description   *string           `json:"description"`
+	Slug          string            `json:"slug"`
+	TargetAmount  decimal.Decimal   `json:"target_amount"`
+	CurrentAmount decimal.Decimal   `json:"current_amount"`
+	StartDate     time.Time         `json:"start_date"`
+	EndDate       time.Time         `json:"end_date"`
+	UserName      string            `json:"user_name"`
+	UserEmail     string            `json:"user_email"`
+	Progress      decimal.Decimal   `json:"progress"`
+	Status        int32             `json:"status"`
+	Tags          []string          `json:"tags"`
+	Category      *string           `json:"category"`
+	Images        []string          `json:"images"`
+	Milestones    []Milestone       `json:"milestones"`
+	MatchingPools []MatchingPool    `json:"matching_pools"`
+	Location      *CampaignLocation `json:"location"`
+	// Suspended campaigns stay visible but can't take donations
+	Suspended        bool    `json:"suspended"`
+	SuspensionNotice *string `json:"suspension_notice,omitempty"`
+	// LastEditedAt is set once the campaign changed after it was created
+	LastEditedAt *time.Time `json:"last_edited_at"`
+	// Preview is set when the campaign is shown through a preview link, it is
+	// read-only and can't take donations
+	Preview bool `json:"preview,omitempty"`
+}
+
+// CampaignLocation is where a campaign takes place, every field is optional.
+type CampaignLocation struct {
+	Province  *string  `json:"province"`
+	City      *string  `json:"city"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+// Milestone is a funding goal along the way to, or past, the target amount.
+type Milestone struct {
+	ID          int32           `json:"id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	// Stretch is set on milestones above the campaign's target amount
+	Stretch   bool       `json:"stretch"`
+	Reached   bool       `json:"reached"`
+	ReachedAt *time.Time `json:"reached_at"`
+}
+
+// MatchingPool is a sponsor's pledge to match donations at a ratio until the
+// cap is spent or the window ends.
+type MatchingPool struct {
+	ID          int32           `json:"id"`
+	SponsorName string          `json:"sponsor_name"`
+	Ratio       decimal.Decimal `json:"ratio"`
+	Cap         decimal.Decimal `json:"cap"`
+	Matched     decimal.Decimal `json:"matched"`
+	Remaining   decimal.Decimal `json:"remaining"`
+	StartsAt    time.Time       `json:"starts_at"`
+	EndsAt      time.Time       `json:"ends_at"`
 }
@@ -2,31 +2,77 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/shared/http/request"
 )
 
 type DonationRepository interface {
 	CreateDonationIntent(ctx context.Context, req CreateDonationIntentParams) (*DonationIntent, error)
 	MarkInvoiceCreated(ctx context.Context, paymentID int32, link, vendor string) error
-	MarkInvoiceFailed(ctx context.Context, paymentID int32) error
-	UpdateDonationPaymentFromWebhook(ctx context.Context, req UpdatePayment) error
+	MarkInvoiceFailed(ctx context.Context, paymentID, donationID int32) error
+	UpdateDonationPaymentFromWebhook(ctx context.Context, req UpdatePayment) (*PaymentUpdateResult, error)
 	GetPaginatedDonatur(ctx context.Context, req GetPaginatedDonaturParams) ([]DonaturList, error)
+	GetDonaturByCursor(ctx context.Context, slug string, req request.CursorPaginationRequest) (*request.CursorPage[DonaturList], error)
 	GetTotalPaidDonatur(ctx context.Context, slug string) (int64, error)
+	// ExportDonations calls fn for every matching donation while the rows are
+	// read, an error from fn stops the export.
+	ExportDonations(ctx context.Context, filter DonationExportFilter, fn func(DonationExportRow) error) error
 }
 
+// Errors returned when a reward tier can't be reserved for a donation.
+var (
+	ErrRewardTierNotFound      = errors.New("reward tier not found")
+	ErrRewardTierSoldOut       = errors.New("reward tier is sold out")
+	ErrRewardAmountTooLow      = errors.New("donation amount is below the reward tier minimum")
+	ErrShippingAddressRequired = errors.New("reward tier requires a shipping address")
+)
+
+// ErrFundraiserNotFound is returned when a donation names a fundraiser page
+// that doesn't belong to the campaign.
+var ErrFundraiserNotFound = errors.New("fundraiser not found")
+
 type CreateDonationIntentParams struct {
-	CampaignID int32
-	UserID     int32
-	Amount     decimal.Decimal
-	Name       string
-	Email      string
-	Note       *string
+	CampaignID   int32
+	UserID       int32
+	Amount       decimal.Decimal
+	Name         string
+	Email        string
+	Note         *string
+	RewardTierID *int32
+	Shipping     *ShippingAddress
+	// Anonymous hides the donor name from the public lists
+	Anonymous bool
+	// Fundraiser is the slug of the fundraiser page the donation was made
+	// through, empty for donations made on the campaign itself
+	Fundraiser string
+	Dedication *Dedication
+}
+
+// Dedication makes a donation in memory or in honor of someone, the recipient
+// is sent an e-card once the donation is paid.
+type Dedication struct {
+	// Type is one of entities.DedicationTypes
+	Type           string
+	HonoreeName    string
+	RecipientEmail string
+	Message        string
+	// Public shows the dedication on the donor wall
+	Public bool
+}
+
+type ShippingAddress struct {
+	Name    string
+	Phone   string
+	Address string
 }
 
 type DonationIntent struct {
 	PaymentID     int32
+	DonationID    int32
 	TransactionID uuid.UUID
 	CampaignID    int32
 	Amount        decimal.Decimal
@@ -44,6 +90,42 @@ type UpdatePayment struct {
 	Amount        decimal.Decimal
 }
 
+// PaymentUpdateResult describes what a webhook callback changed.
+type PaymentUpdateResult struct {
+	PaymentID  int32
+	DonationID int32
+	DonaturID  int32
+	CampaignID int32
+	Amount     decimal.Decimal
+	// Paid is only set when this callback moved the payment to PAID
+	Paid bool
+	// Matched is what sponsor matching pools added to the campaign on top of
+	// the donation
+	Matched decimal.Decimal
+	// ReachedMilestones are the milestones the payment pushed the campaign past
+	ReachedMilestones []Milestone
+}
+
+// DonationPaidChannel is the Postgres channel paid donations are announced on,
+// the payload is a DonationPaidNotification. The notification is sent with the
+// transaction that marks the payment paid, so it is only delivered once the
+// payment is committed.
+const DonationPaidChannel = "campaign_donations"
+
+// DonationPaidNotification is the payload of DonationPaidChannel, donors who
+// gave anonymously are named "Anonymous".
+type DonationPaidNotification struct {
+	CampaignID    int32           `json:"campaign_id"`
+	DonationID    int32           `json:"donation_id"`
+	DonorName     string          `json:"donor_name"`
+	Anonymous     bool            `json:"anonymous"`
+	Amount        decimal.Decimal `json:"amount"`
+	Matched       decimal.Decimal `json:"matched"`
+	CurrentAmount decimal.Decimal `json:"current_amount"`
+	TargetAmount  decimal.Decimal `json:"target_amount"`
+	PaidAt        time.Time       `json:"paid_at"`
+}
+
 type GetPaginatedDonaturParams struct {
 	Limit  int32
 	Offset int32
@@ -55,4 +137,29 @@ type DonaturList struct {
 	Name         string          `json:"name"`
 	Email        string          `json:"email"`
 	TotalDonated decimal.Decimal `json:"total_donated"`
+	// Dedication is the latest public dedication of the donor, e.g. "In
+	// memory of Siti"
+	Dedication string `json:"dedication,omitempty"`
+}
+
+type DonationExportFilter struct {
+	CampaignID int32
+	// From and To bound the donation date, both days are included
+	From   *time.Time
+	To     *time.Time
+	Status *int32
+}
+
+type DonationExportRow struct {
+	DonationID    int32
+	TransactionID uuid.UUID
+	DonorName     string
+	DonorEmail    string
+	Amount        decimal.Decimal
+	Status        int32
+	Method        string
+	Vendor        string
+	PaidAt        *time.Time
+	Note          string
+	CreatedAt     time.Time
 }
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/shared/events"
+)
+
+var (
+	ErrCampaignNotFound         = errors.New("campaign not found")
+	ErrCampaignNotPendingReview = errors.New("campaign is not waiting for review")
+)
+
+type ReviewService struct {
+	db       *sql.DB
+	q        *sqlc.Queries
+	eventBus *events.Bus
+}
+
+func NewReviewService(db *sql.DB, q *sqlc.Queries, eventBus *events.Bus) *ReviewService {
+	return &ReviewService{
+		db:       db,
+		q:        q,
+		eventBus: eventBus,
+	}
+}
+
+func (s *ReviewService) IsAdmin(ctx context.Context, userID int) (bool, error) {
+	admin, err := s.q.IsUserAdmin(ctx, int32(userID))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return admin, nil
+}
+
+// GetReviewQueue lists the campaigns waiting for review, the ones submitted
+// first come first.
+func (s *ReviewService) GetReviewQueue(ctx context.Context, limit, offset int32) ([]sqlc.GetReviewQueueRow, int64, error) {
+	campaigns, err := s.q.GetReviewQueue(ctx, sqlc.GetReviewQueueParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get review queue: %w", err)
+	}
+
+	total, err := s.q.GetTotalReviewQueue(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get total review queue: %w", err)
+	}
+
+	return campaigns, total, nil
+}
+
+// Review approves or rejects a campaign waiting for review and notifies the
+// owner. The first approval also locks some of the campaign fields.
+func (s *ReviewService) Review(ctx context.Context, req ReviewCampaignRequest) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := s.q.WithTx(tx)
+
+	campaign, err := qtx.FindCampaignForReview(ctx, req.CampaignID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrCampaignNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get campaign: %w", err)
+	}
+
+	if entities.Status(campaign.Status) != entities.StatusPendingReview {
+		return ErrCampaignNotPendingReview
+	}
+
+	decision := entities.ReviewRejected
+
+	if req.Approved {
+		decision = entities.ReviewApproved
+		err = qtx.ApproveCampaign(ctx, campaign.ID)
+	} else {
+		err = qtx.RejectCampaign(ctx, campaign.ID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update campaign status: %w", err)
+	}
+
+	err = qtx.CreateCampaignReview(ctx, sqlc.CreateCampaignReviewParams{
+		CampaignID: campaign.ID,
+		ReviewerID: sql.NullInt32{Int32: req.ReviewerID, Valid: true},
+		Decision:   int32(decision),
+		Reason:     req.Reason,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to create campaign review: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.eventBus.Publish(events.CampaignReviewed{
+		CampaignID: campaign.ID,
+		Title:      campaign.Title,
+		OwnerEmail: campaign.OwnerEmail,
+		Approved:   req.Approved,
+		Reason:     req.Reason,
+	})
+
+	return nil
+}
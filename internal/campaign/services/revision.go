@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// GetRevisions lists the saved revisions of a campaign, the latest first.
+func (s *UserCampaignService) GetRevisions(ctx context.Context, campaignID int32) ([]CampaignRevisionSummary, error) {
+	rows, err := s.q.GetCampaignRevisions(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign revisions: %w", err)
+	}
+
+	revisions := make([]CampaignRevisionSummary, 0, len(rows))
+
+	for _, row := range rows {
+		revisions = append(revisions, CampaignRevisionSummary{
+			Revision:   row.Revision,
+			Title:      row.Title,
+			Status:     entities.Status(row.Status).String(),
+			AuthorID:   int32Pointer(row.AuthorID),
+			AuthorName: row.AuthorName,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+
+	return revisions, nil
+}
+
+func (s *UserCampaignService) GetRevision(ctx context.Context, campaignID, revision int32) (*CampaignRevision, error) {
+	row, err := s.q.GetCampaignRevision(ctx, sqlc.GetCampaignRevisionParams{
+		CampaignID: campaignID,
+		Revision:   revision,
+	})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRevisionNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign revision: %w", err)
+	}
+
+	return &CampaignRevision{
+		Revision:     row.Revision,
+		Title:        row.Title,
+		Description:  row.Description.String,
+		Slug:         row.Slug,
+		TargetAmount: row.TargetAmount,
+		StartDate:    row.StartDate,
+		EndDate:      row.EndDate,
+		Status:       entities.Status(row.Status).String(),
+		Images:       row.Images,
+		Tags:         row.Tags,
+		AuthorID:     int32Pointer(row.AuthorID),
+		AuthorName:   row.AuthorName,
+		CreatedAt:    row.CreatedAt,
+	}, nil
+}
+
+// DiffRevisions returns the fields that changed going from one revision to
+// the other.
+func (s *UserCampaignService) DiffRevisions(ctx context.Context, campaignID, from, to int32) (*RevisionDiff, error) {
+	a, err := s.GetRevision(ctx, campaignID, from)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.GetRevision(ctx, campaignID, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &RevisionDiff{
+		From:    a.summary(),
+		To:      b.summary(),
+		Changes: []FieldChange{},
+	}
+
+	add := func(field string, changed bool, from, to any) {
+		if changed {
+			diff.Changes = append(diff.Changes, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	add("title", a.Title != b.Title, a.Title, b.Title)
+	add("description", a.Description != b.Description, a.Description, b.Description)
+	add("slug", a.Slug != b.Slug, a.Slug, b.Slug)
+	add("target_amount", !a.TargetAmount.Equal(b.TargetAmount), a.TargetAmount, b.TargetAmount)
+	add("start_date", !a.StartDate.Equal(b.StartDate), a.StartDate, b.StartDate)
+	add("end_date", !a.EndDate.Equal(b.EndDate), a.EndDate, b.EndDate)
+	add("status", a.Status != b.Status, a.Status, b.Status)
+	add("images", !slices.Equal(a.Images, b.Images), a.Images, b.Images)
+	add("tags", !slices.Equal(a.Tags, b.Tags), a.Tags, b.Tags)
+
+	return diff, nil
+}
+
+// RevertCampaign saves the content of an older revision as a new update. The
+// current status and slug are kept, so reverting never moves the campaign
+// through the review or breaks its links, and fields locked by the approval
+// are checked like any other update.
+func (s *UserCampaignService) RevertCampaign(ctx context.Context, current *sqlc.GetUserCampaignByIdRow, revision, userID int32) (*sqlc.UpdateCampaignRow, error) {
+	rev, err := s.GetRevision(ctx, current.ID, revision)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.UpdateCampaign(ctx, current, CreateCampaignRequest{
+		UserID:       userID,
+		Title:        rev.Title,
+		Description:  rev.Description,
+		Slug:         current.Slug,
+		TargetAmount: rev.TargetAmount,
+		StartDate:    rev.StartDate.Format(time.DateTime),
+		EndDate:      rev.EndDate.Format(time.DateTime),
+		Status:       int(current.Status),
+		Images:       rev.Images,
+		Tags:         rev.Tags,
+		// revisions don't track the category, the current one is kept
+		Category: stringValue(current.Category),
+	})
+}
+
+func (r *CampaignRevision) summary() CampaignRevisionSummary {
+	return CampaignRevisionSummary{
+		Revision:   r.Revision,
+		Title:      r.Title,
+		Status:     r.Status,
+		AuthorID:   r.AuthorID,
+		AuthorName: r.AuthorName,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func int32Pointer(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Int32
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+var ErrRewardQuantityTooLow = errors.New("quantity can't be lower than the rewards already taken")
+
+type RewardTierService struct {
+	q *sqlc.Queries
+}
+
+func NewRewardTierService(q *sqlc.Queries) *RewardTierService {
+	return &RewardTierService{
+		q: q,
+	}
+}
+
+func (s *RewardTierService) GetRewardTiers(ctx context.Context, campaignID int32) ([]RewardTier, error) {
+	tiers, err := s.q.GetCampaignRewardTiers(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reward tiers: %w", err)
+	}
+
+	rewardTiers := make([]RewardTier, 0, len(tiers))
+
+	for _, tier := range tiers {
+		rewardTiers = append(rewardTiers, toRewardTier(tier))
+	}
+
+	return rewardTiers, nil
+}
+
+func (s *RewardTierService) GetRewardTiersBySlug(ctx context.Context, slug string) ([]RewardTier, error) {
+	campaign, err := s.q.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, fmt.Errorf("campaign not found: %w", err)
+	}
+
+	return s.GetRewardTiers(ctx, campaign.ID)
+}
+
+func (s *RewardTierService) CreateRewardTier(ctx context.Context, campaignID int32, req RewardTierRequest) (*RewardTier, error) {
+	tier, err := s.q.CreateRewardTier(ctx, sqlc.CreateRewardTierParams{
+		CampaignID: campaignID,
+		Title:      req.Title,
+		Description: sql.NullString{
+			String: req.Description,
+			Valid:  req.Description != "",
+		},
+		MinAmount:        req.MinAmount,
+		Quantity:         nullInt32(req.Quantity),
+		RequiresShipping: req.RequiresShipping,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reward tier: %w", err)
+	}
+
+	rewardTier := toRewardTier(tier)
+
+	return &rewardTier, nil
+}
+
+func (s *RewardTierService) UpdateRewardTier(ctx context.Context, campaignID, tierID int32, req RewardTierRequest) (*RewardTier, error) {
+	current, err := s.q.GetRewardTierById(ctx, sqlc.GetRewardTierByIdParams{
+		ID:         tierID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reward tier not found: %w", err)
+	}
+
+	if req.Quantity != nil && *req.Quantity < current.Reserved+current.Claimed {
+		return nil, ErrRewardQuantityTooLow
+	}
+
+	tier, err := s.q.UpdateRewardTier(ctx, sqlc.UpdateRewardTierParams{
+		ID:         tierID,
+		CampaignID: campaignID,
+		Title:      req.Title,
+		Description: sql.NullString{
+			String: req.Description,
+			Valid:  req.Description != "",
+		},
+		MinAmount:        req.MinAmount,
+		Quantity:         nullInt32(req.Quantity),
+		RequiresShipping: req.RequiresShipping,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update reward tier: %w", err)
+	}
+
+	rewardTier := toRewardTier(tier)
+
+	return &rewardTier, nil
+}
+
+func (s *RewardTierService) DeleteRewardTier(ctx context.Context, campaignID, tierID int32) error {
+	affected, err := s.q.SoftDeleteRewardTier(ctx, sqlc.SoftDeleteRewardTierParams{
+		ID:         tierID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete reward tier: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("reward tier not found")
+	}
+
+	return nil
+}
+
+func (s *RewardTierService) GetFulfilments(ctx context.Context, req GetRewardFulfilmentsRequest) ([]sqlc.GetRewardFulfilmentsRow, int64, error) {
+	tierID := sql.NullInt32{
+		Int32: req.RewardTierID,
+		Valid: req.RewardTierID != 0,
+	}
+
+	var fulfilled sql.NullBool
+
+	if req.Fulfilled != nil {
+		fulfilled = sql.NullBool{Bool: *req.Fulfilled, Valid: true}
+	}
+
+	fulfilments, err := s.q.GetRewardFulfilments(ctx, sqlc.GetRewardFulfilmentsParams{
+		CampaignID:   req.CampaignID,
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+		RewardTierID: tierID,
+		Fulfilled:    fulfilled,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get reward fulfilments: %w", err)
+	}
+
+	total, err := s.q.GetTotalRewardFulfilments(ctx, sqlc.GetTotalRewardFulfilmentsParams{
+		CampaignID:   req.CampaignID,
+		RewardTierID: tierID,
+		Fulfilled:    fulfilled,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get total reward fulfilments: %w", err)
+	}
+
+	return fulfilments, total, nil
+}
+
+func (s *RewardTierService) MarkFulfilled(ctx context.Context, campaignID, rewardID int32) error {
+	affected, err := s.q.MarkDonationRewardFulfilled(ctx, sqlc.MarkDonationRewardFulfilledParams{
+		ID:         rewardID,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to mark reward as fulfilled: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("reward not found or already fulfilled")
+	}
+
+	return nil
+}
+
+func toRewardTier(tier sqlc.RewardTier) RewardTier {
+	rewardTier := RewardTier{
+		ID:               tier.ID,
+		Title:            tier.Title,
+		Description:      tier.Description.String,
+		MinAmount:        tier.MinAmount,
+		Claimed:          tier.Claimed,
+		RequiresShipping: tier.RequiresShipping,
+	}
+
+	if tier.Quantity.Valid {
+		quantity := tier.Quantity.Int32
+		remaining := quantity - tier.Reserved - tier.Claimed
+
+		rewardTier.Quantity = &quantity
+		rewardTier.Remaining = &remaining
+	}
+
+	return rewardTier
+}
+
+func nullInt32(value *int32) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: *value, Valid: true}
+}
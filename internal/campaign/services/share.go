@@ -0,0 +1,235 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/qrcode"
+)
+
+var ErrShortLinkNotFound = errors.New("short link not found")
+
+const (
+	shortCodeLength   = 7
+	shortCodeAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// ogDescriptionLength keeps link previews within what most apps show
+	ogDescriptionLength = 200
+)
+
+type ShareService struct {
+	q         *sqlc.Queries
+	campaigns repository.CampaignRepository
+	appURL    string
+}
+
+func NewShareService(q *sqlc.Queries, campaigns repository.CampaignRepository, appURL string) *ShareService {
+	return &ShareService{
+		q:         q,
+		campaigns: campaigns,
+		appURL:    appURL,
+	}
+}
+
+// GetLinks returns the links used to share the campaign, its short link is
+// created the first time it is asked for.
+func (s *ShareService) GetLinks(ctx context.Context, campaignID int32, slug string) (*ShareLinks, error) {
+	link, err := s.shortLink(ctx, campaignID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShareLinks{
+		PageURL:   s.pageURL(slug),
+		ShortURL:  s.shortURL(link.Code),
+		QRCodeURL: s.qrCodeURL(slug),
+		Clicks:    link.Clicks,
+	}, nil
+}
+
+// LandingPage gathers what the server-rendered page of a published campaign
+// shows, including its OpenGraph metadata.
+func (s *ShareService) LandingPage(ctx context.Context, slug string) (*LandingPage, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	campaign.MatchingPools, err = s.campaigns.GetActiveMatchingPools(ctx, campaign.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.GetLinks(ctx, campaign.ID, campaign.Slug)
+
+	if err != nil {
+		return nil, err
+	}
+
+	page := &LandingPage{
+		Campaign:    campaign,
+		Links:       *links,
+		Description: truncateTitle(strings.Join(strings.Fields(stringValue(campaign.Description)), " "), ogDescriptionLength),
+		Raised:      FormatRupiah(campaign.CurrentAmount),
+		Target:      FormatRupiah(campaign.TargetAmount),
+	}
+
+	if len(campaign.Images) > 0 {
+		page.ImageURL = campaign.Images[0]
+	}
+
+	if campaign.TargetAmount.IsPositive() {
+		page.Percent = int(campaign.CurrentAmount.Mul(decimal.NewFromInt(100)).Div(campaign.TargetAmount).IntPart())
+	}
+
+	page.BarPercent = min(page.Percent, 100)
+
+	return page, nil
+}
+
+// Visit counts a click on the short link and returns the slug of its
+// campaign. Links of campaigns that aren't published don't resolve.
+func (s *ShareService) Visit(ctx context.Context, code string) (string, error) {
+	slug, err := s.q.VisitShortLink(ctx, code)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrShortLinkNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to visit short link: %w", err)
+	}
+
+	return slug, nil
+}
+
+// QRCode renders a PNG QR code pointing to the short link of a published
+// campaign, scale is the size in pixels of a single module.
+func (s *ShareService) QRCode(ctx context.Context, slug string, scale int) ([]byte, error) {
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	link, err := s.shortLink(ctx, campaign.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := qrcode.Encode(s.shortURL(link.Code))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode qr code: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := code.PNG(&buf, scale); err != nil {
+		return nil, fmt.Errorf("failed to render qr code: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *ShareService) shortLink(ctx context.Context, campaignID int32) (sqlc.GetShortLinkByCampaignRow, error) {
+	link, err := s.q.GetShortLinkByCampaign(ctx, campaignID)
+
+	if err == nil {
+		return link, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return link, fmt.Errorf("failed to get short link: %w", err)
+	}
+
+	// codes are random, a few attempts are enough to get past a collision
+	for range 3 {
+		code, err := newShortCode()
+
+		if err != nil {
+			return link, err
+		}
+
+		err = s.q.CreateShortLink(ctx, sqlc.CreateShortLinkParams{
+			CampaignID: campaignID,
+			Code:       code,
+		})
+
+		if isShortCodeTaken(err) {
+			continue
+		}
+
+		if err != nil {
+			return link, fmt.Errorf("failed to create short link: %w", err)
+		}
+
+		// a concurrent request may have created the link first, read back
+		// whichever one was kept
+		link, err = s.q.GetShortLinkByCampaign(ctx, campaignID)
+
+		if err != nil {
+			return link, fmt.Errorf("failed to get short link: %w", err)
+		}
+
+		return link, nil
+	}
+
+	return link, errors.New("failed to create short link: no free code")
+}
+
+func (s *ShareService) pageURL(slug string) string {
+	return fmt.Sprintf("%s/campaigns/%s", s.appURL, slug)
+}
+
+func (s *ShareService) shortURL(code string) string {
+	return fmt.Sprintf("%s/c/%s", s.appURL, code)
+}
+
+func (s *ShareService) qrCodeURL(slug string) string {
+	return fmt.Sprintf("%s/api/v1/campaigns/%s/qr.png", s.appURL, slug)
+}
+
+func newShortCode() (string, error) {
+	max := big.NewInt(int64(len(shortCodeAlphabet)))
+	code := make([]byte, shortCodeLength)
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+
+		if err != nil {
+			return "", fmt.Errorf("failed to generate short code: %w", err)
+		}
+
+		code[i] = shortCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+func isShortCodeTaken(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "campaign_short_links_code_key"
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/sitemap"
+)
+
+var ErrSitemapNotFound = errors.New("sitemap not found")
+
+const (
+	SitemapRefreshInterval = 10 * time.Minute
+	// sitemapOverlap re-reads the latest changes on every refresh, a
+	// transaction that commits late carries the time it started at
+	sitemapOverlap = time.Minute
+)
+
+type sitemapEntry struct {
+	slug      string
+	category  string
+	updatedAt time.Time
+}
+
+// SitemapService keeps the listed campaigns in memory and only reads the
+// campaigns changed since the previous refresh, so serving a sitemap never
+// queries the campaigns table.
+type SitemapService struct {
+	q      *sqlc.Queries
+	appURL string
+
+	// refreshing serializes refreshes, mu guards the fields below it
+	refreshing sync.Mutex
+	mu         sync.RWMutex
+	loaded     bool
+	since      time.Time
+	entries    map[int32]sitemapEntry
+	// files holds the rendered sitemaps until the entries change
+	files map[string][]byte
+}
+
+func NewSitemapService(q *sqlc.Queries, appURL string) *SitemapService {
+	return &SitemapService{
+		q:       q,
+		appURL:  appURL,
+		entries: make(map[int32]sitemapEntry),
+		files:   make(map[string][]byte),
+	}
+}
+
+// Refresh applies the campaigns changed since the previous refresh, the
+// first one loads every campaign. It only reads the database, so every
+// instance refreshes its own copy without a job lock.
+func (s *SitemapService) Refresh(ctx context.Context) error {
+	s.refreshing.Lock()
+	defer s.refreshing.Unlock()
+
+	s.mu.RLock()
+	since := s.since
+	s.mu.RUnlock()
+
+	if !since.IsZero() {
+		since = since.Add(-sitemapOverlap)
+	}
+
+	rows, err := s.q.GetSitemapChanges(ctx, since)
+
+	if err != nil {
+		return fmt.Errorf("failed to get sitemap changes: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+
+	for _, row := range rows {
+		current, exists := s.entries[row.ID]
+
+		if row.ChangedAt.After(s.since) {
+			s.since = row.ChangedAt
+		}
+
+		if !row.Listed {
+			if exists {
+				delete(s.entries, row.ID)
+				changed = true
+			}
+
+			continue
+		}
+
+		entry := sitemapEntry{
+			slug:      row.Slug,
+			category:  stringValue(row.Category),
+			updatedAt: row.UpdatedAt,
+		}
+
+		if !exists || current != entry {
+			s.entries[row.ID] = entry
+			changed = true
+		}
+	}
+
+	if changed || !s.loaded {
+		clear(s.files)
+	}
+
+	s.loaded = true
+
+	return nil
+}
+
+// Index renders the sitemap index, it lists the category sitemap and as many
+// campaign sitemaps as needed to stay under sitemap.MaxURLs each.
+func (s *SitemapService) Index(ctx context.Context) ([]byte, error) {
+	return s.file(ctx, "index")
+}
+
+// Sitemap renders one of the sitemaps listed in the index, "categories" or
+// "campaigns-N" where N starts at 1.
+func (s *SitemapService) Sitemap(ctx context.Context, name string) ([]byte, error) {
+	if name == "categories" {
+		return s.file(ctx, name)
+	}
+
+	page, err := strconv.Atoi(strings.TrimPrefix(name, "campaigns-"))
+
+	if err != nil || page < 1 || !strings.HasPrefix(name, "campaigns-") {
+		return nil, ErrSitemapNotFound
+	}
+
+	return s.file(ctx, fmt.Sprintf("campaigns-%d", page))
+}
+
+func (s *SitemapService) file(ctx context.Context, name string) ([]byte, error) {
+	if err := s.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	body, ok := s.files[name]
+	s.mu.RUnlock()
+
+	if ok {
+		return body, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if body, ok := s.files[name]; ok {
+		return body, nil
+	}
+
+	body, err := s.render(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.files[name] = body
+
+	return body, nil
+}
+
+// ensureLoaded covers requests that come in before the first scheduled
+// refresh finished.
+func (s *SitemapService) ensureLoaded(ctx context.Context) error {
+	s.mu.RLock()
+	loaded := s.loaded
+	s.mu.RUnlock()
+
+	if loaded {
+		return nil
+	}
+
+	return s.Refresh(ctx)
+}
+
+// render builds a sitemap from the entries, the caller holds the lock.
+func (s *SitemapService) render(name string) ([]byte, error) {
+	ids := make([]int32, 0, len(s.entries))
+
+	for id := range s.entries {
+		ids = append(ids, id)
+	}
+
+	// campaigns keep their sitemap as long as older ones aren't removed
+	slices.Sort(ids)
+
+	var buf bytes.Buffer
+	var err error
+
+	switch name {
+	case "index":
+		err = sitemap.WriteIndex(&buf, s.indexURLs(ids))
+	case "categories":
+		err = sitemap.WriteURLSet(&buf, s.categoryURLs())
+	default:
+		page, _ := strconv.Atoi(strings.TrimPrefix(name, "campaigns-"))
+		start := (page - 1) * sitemap.MaxURLs
+
+		// the first page always exists, even with no campaign listed yet
+		if start > 0 && start >= len(ids) {
+			return nil, ErrSitemapNotFound
+		}
+
+		err = sitemap.WriteURLSet(&buf, s.campaignURLs(ids[start:min(start+sitemap.MaxURLs, len(ids))]))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to render sitemap: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *SitemapService) indexURLs(ids []int32) []sitemap.URL {
+	var lastMod time.Time
+
+	for _, entry := range s.entries {
+		lastMod = latest(lastMod, entry.updatedAt)
+	}
+
+	urls := []sitemap.URL{{
+		Loc:     fmt.Sprintf("%s/sitemaps/categories.xml", s.appURL),
+		LastMod: lastMod,
+	}}
+
+	for page := 0; page == 0 || page*sitemap.MaxURLs < len(ids); page++ {
+		var pageLastMod time.Time
+
+		for _, id := range ids[page*sitemap.MaxURLs : min((page+1)*sitemap.MaxURLs, len(ids))] {
+			pageLastMod = latest(pageLastMod, s.entries[id].updatedAt)
+		}
+
+		urls = append(urls, sitemap.URL{
+			Loc:     fmt.Sprintf("%s/sitemaps/campaigns-%d.xml", s.appURL, page+1),
+			LastMod: pageLastMod,
+		})
+	}
+
+	return urls
+}
+
+func (s *SitemapService) categoryURLs() []sitemap.URL {
+	lastMods := make(map[string]time.Time, len(entities.CampaignCategories))
+
+	for _, entry := range s.entries {
+		lastMods[entry.category] = latest(lastMods[entry.category], entry.updatedAt)
+	}
+
+	urls := make([]sitemap.URL, 0, len(entities.CampaignCategories))
+
+	for _, category := range entities.CampaignCategories {
+		urls = append(urls, sitemap.URL{
+			Loc:     fmt.Sprintf("%s/categories/%s", s.appURL, category),
+			LastMod: lastMods[category],
+		})
+	}
+
+	return urls
+}
+
+func (s *SitemapService) campaignURLs(ids []int32) []sitemap.URL {
+	urls := make([]sitemap.URL, 0, len(ids))
+
+	for _, id := range ids {
+		entry := s.entries[id]
+
+		urls = append(urls, sitemap.URL{
+			Loc:     fmt.Sprintf("%s/campaigns/%s", s.appURL, entry.slug),
+			LastMod: entry.updatedAt,
+		})
+	}
+
+	return urls
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/sitemap"
+	"go-campaign.com/pkg/sqlfake"
+)
+
+func sitemapRow(id int64, slug, category string, listed bool, changedAt time.Time) []driver.Value {
+	return []driver.Value{id, slug, category, changedAt, listed, changedAt}
+}
+
+func TestSitemapServiceRefresh(t *testing.T) {
+	ctx := context.Background()
+	db, fake := sqlfake.New()
+	s := NewSitemapService(sqlc.New(db), "https://go-campaign.com")
+
+	start := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	changes := [][]driver.Value{
+		sitemapRow(1, "bantu-korban-banjir", "disaster", true, start),
+		sitemapRow(2, "beasiswa-anak-desa", "education", true, start.Add(time.Hour)),
+		sitemapRow(3, "draft-campaign", "health", false, start.Add(2*time.Hour)),
+	}
+
+	fake.On("GetSitemapChanges", func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{Rows: changes}
+	})
+
+	// the first request loads every campaign
+	index, err := s.Index(ctx)
+
+	if err != nil {
+		t.Fatalf("Index() error = %v", err)
+	}
+
+	if calls := fake.Calls("GetSitemapChanges"); len(calls) != 1 || !calls[0].Args[0].(time.Time).IsZero() {
+		t.Fatalf("GetSitemapChanges calls = %v, want one from the zero time", calls)
+	}
+
+	for _, want := range []string{
+		"<loc>https://go-campaign.com/sitemaps/categories.xml</loc>",
+		"<loc>https://go-campaign.com/sitemaps/campaigns-1.xml</loc>",
+	} {
+		if !strings.Contains(string(index), want) {
+			t.Errorf("the index is missing %s\n%s", want, index)
+		}
+	}
+
+	campaigns, err := s.Sitemap(ctx, "campaigns-1")
+
+	if err != nil {
+		t.Fatalf("Sitemap() error = %v", err)
+	}
+
+	if !strings.Contains(string(campaigns), "/campaigns/bantu-korban-banjir</loc>") || strings.Contains(string(campaigns), "draft-campaign") {
+		t.Errorf("campaigns-1 = %s", campaigns)
+	}
+
+	// the next refresh reads from the newest change, minus the overlap
+	changes = [][]driver.Value{
+		sitemapRow(1, "bantu-korban-banjir", "disaster", true, start),
+		sitemapRow(2, "beasiswa-anak-desa", "education", false, start.Add(3*time.Hour)),
+		sitemapRow(4, "operasi-jantung", "health", true, start.Add(4*time.Hour)),
+	}
+
+	if err := s.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	calls := fake.Calls("GetSitemapChanges")
+
+	if since := calls[1].Args[0].(time.Time); !since.Equal(start.Add(2*time.Hour - sitemapOverlap)) {
+		t.Errorf("second refresh since = %v", since)
+	}
+
+	campaigns, err = s.Sitemap(ctx, "campaigns-1")
+
+	if err != nil {
+		t.Fatalf("Sitemap() error = %v", err)
+	}
+
+	if strings.Contains(string(campaigns), "beasiswa-anak-desa") || !strings.Contains(string(campaigns), "operasi-jantung") {
+		t.Errorf("campaigns-1 after the refresh = %s", campaigns)
+	}
+
+	// nothing changed, the rendered sitemap is kept
+	changes = nil
+
+	if err := s.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if _, ok := s.files["campaigns-1"]; !ok {
+		t.Error("a refresh without changes dropped the rendered sitemaps")
+	}
+
+	categories, err := s.Sitemap(ctx, "categories")
+
+	if err != nil {
+		t.Fatalf("Sitemap() error = %v", err)
+	}
+
+	if !strings.Contains(string(categories), "<loc>https://go-campaign.com/categories/health</loc>") {
+		t.Errorf("categories = %s", categories)
+	}
+}
+
+func TestSitemapServiceRefreshError(t *testing.T) {
+	db, fake := sqlfake.New()
+	s := NewSitemapService(sqlc.New(db), "https://go-campaign.com")
+
+	fake.On("GetSitemapChanges", func([]driver.Value) sqlfake.Result {
+		return sqlfake.Result{Err: errors.New("connection refused")}
+	})
+
+	if _, err := s.Index(context.Background()); err == nil {
+		t.Fatal("Index() didn't fail")
+	}
+
+	// the next request tries again
+	if _, err := s.Index(context.Background()); err == nil || len(fake.Calls("GetSitemapChanges")) != 2 {
+		t.Errorf("the failed load was not retried")
+	}
+}
+
+func TestSitemapServicePaging(t *testing.T) {
+	s := NewSitemapService(nil, "https://go-campaign.com")
+	s.loaded = true
+
+	updated := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	for id := int32(1); id <= sitemap.MaxURLs+1; id++ {
+		s.entries[id] = sitemapEntry{slug: fmt.Sprintf("campaign-%d", id), category: "health", updatedAt: updated}
+	}
+
+	// the last campaign is the only one of the second page
+	s.entries[sitemap.MaxURLs+1] = sitemapEntry{slug: "newest", category: "health", updatedAt: updated.Add(time.Hour)}
+
+	ctx := context.Background()
+	index, err := s.Index(ctx)
+
+	if err != nil {
+		t.Fatalf("Index() error = %v", err)
+	}
+
+	if !strings.Contains(string(index), "campaigns-2.xml</loc>") || strings.Contains(string(index), "campaigns-3.xml") {
+		t.Errorf("the index doesn't list two campaign sitemaps\n%s", index)
+	}
+
+	second, err := s.Sitemap(ctx, "campaigns-2")
+
+	if err != nil {
+		t.Fatalf("Sitemap(campaigns-2) error = %v", err)
+	}
+
+	if strings.Count(string(second), "<url>") != 1 || !strings.Contains(string(second), "/campaigns/newest</loc>") {
+		t.Errorf("campaigns-2 = %s", second)
+	}
+
+	for _, name := range []string{"campaigns-3", "campaigns-0", "campaigns-x", "campaigns", "tags"} {
+		if _, err := s.Sitemap(ctx, name); !errors.Is(err, ErrSitemapNotFound) {
+			t.Errorf("Sitemap(%q) error = %v, want ErrSitemapNotFound", name, err)
+		}
+	}
+}
+
+func TestSitemapServiceEmpty(t *testing.T) {
+	s := NewSitemapService(nil, "https://go-campaign.com")
+	s.loaded = true
+
+	// the first campaign sitemap exists before any campaign is listed
+	body, err := s.Sitemap(context.Background(), "campaigns-1")
+
+	if err != nil {
+		t.Fatalf("Sitemap() error = %v", err)
+	}
+
+	if strings.Contains(string(body), "<url>") {
+		t.Errorf("campaigns-1 = %s", body)
+	}
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/pkg/slug"
+)
+
+const (
+	slugTakenMessage    = "Slug already taken"
+	slugReservedMessage = "Slug is reserved"
+)
+
+// reservedSlugs are the fixed paths next to /campaigns/:slug, a campaign
+// using one of them could never be reached.
+var reservedSlugs = map[string]bool{
+	"trending":       true,
+	"previews":       true,
+	"matching-pools": true,
+	"feeds":          true,
+	"xendit":         true,
+}
+
+// uniqueSlug generates the slug of a title, numbering it when live campaigns
+// or slug redirects already use it.
+func uniqueSlug(ctx context.Context, q *sqlc.Queries, title string) (string, error) {
+	base := slug.Make(title)
+
+	if base == "" {
+		base = "campaign"
+	}
+
+	rows, err := q.GetTakenSlugs(ctx, base)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get taken slugs: %w", err)
+	}
+
+	return freeSlug(base, rows), nil
+}
+
+// freeSlug returns base, or the first numbered variant of it, not in taken
+// nor reserved.
+func freeSlug(base string, taken []string) string {
+	used := make(map[string]bool, len(taken)+len(reservedSlugs))
+
+	for s := range reservedSlugs {
+		used[s] = true
+	}
+
+	for _, s := range taken {
+		used[s] = true
+	}
+
+	candidate := base
+
+	for n := 2; used[candidate]; n++ {
+		candidate = slug.WithSuffix(base, n)
+	}
+
+	return candidate
+}
+
+// checkSlugRedirect rejects a reserved slug and a slug another campaign was
+// renamed from, links shared with the old slug have to keep reaching that
+// campaign.
+func checkSlugRedirect(ctx context.Context, q *sqlc.Queries, slug string, campaignID int32) error {
+	if reservedSlugs[slug] {
+		return FieldErrors{"slug": slugReservedMessage}
+	}
+
+	taken, err := q.IsSlugRedirected(ctx, sqlc.IsSlugRedirectedParams{
+		Slug:       slug,
+		CampaignID: campaignID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to check slug redirects: %w", err)
+	}
+
+	if taken {
+		return FieldErrors{"slug": slugTakenMessage}
+	}
+
+	return nil
+}
+
+// isSlugTaken reports whether err is a live campaign already using the slug,
+// the validator can miss it when two requests race.
+func isSlugTaken(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "idx_campaigns_slug_deleted_at"
+}
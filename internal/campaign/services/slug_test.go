@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFreeSlug(t *testing.T) {
+	tests := []struct {
+		base  string
+		taken []string
+		want  string
+	}{
+		{"bantu-korban-banjir", nil, "bantu-korban-banjir"},
+		{"bantu-korban-banjir", []string{"bantu-korban-banjir"}, "bantu-korban-banjir-2"},
+		{"bantu-korban-banjir", []string{"bantu-korban-banjir", "bantu-korban-banjir-2"}, "bantu-korban-banjir-3"},
+		// the fixed paths of /campaigns get a suffix
+		{"trending", nil, "trending-2"},
+		{"previews", nil, "previews-2"},
+		{"matching-pools", []string{"matching-pools-2"}, "matching-pools-3"},
+		{"feeds", nil, "feeds-2"},
+		{"trending-now", nil, "trending-now"},
+	}
+
+	for _, tt := range tests {
+		if got := freeSlug(tt.base, tt.taken); got != tt.want {
+			t.Errorf("freeSlug(%q, %q) = %q, want %q", tt.base, tt.taken, got, tt.want)
+		}
+	}
+}
+
+func TestCheckSlugRedirectReserved(t *testing.T) {
+	for slug := range reservedSlugs {
+		// rejected before the redirects are queried
+		err := checkSlugRedirect(context.Background(), nil, slug, 0)
+
+		var fieldErrors FieldErrors
+
+		if !errors.As(err, &fieldErrors) || fieldErrors["slug"] != slugReservedMessage {
+			t.Errorf("checkSlugRedirect(%q) error = %v, want %q", slug, err, slugReservedMessage)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+)
+
+const StatsRefreshInterval = 5 * time.Minute
+
+// StatsService keeps the platform statistics in memory, they are recomputed
+// by the scheduler so serving them never scans the payments table.
+type StatsService struct {
+	q *sqlc.Queries
+
+	// refreshing serializes refreshes, mu guards stats
+	refreshing sync.Mutex
+	mu         sync.RWMutex
+	stats      *PlatformStats
+}
+
+func NewStatsService(q *sqlc.Queries) *StatsService {
+	return &StatsService{
+		q: q,
+	}
+}
+
+// Refresh recomputes the statistics of every category, a donor who gave to
+// several categories is counted once in the platform total. It only reads the
+// database, so every instance refreshes its own copy without a job lock.
+func (s *StatsService) Refresh(ctx context.Context) error {
+	s.refreshing.Lock()
+	defer s.refreshing.Unlock()
+
+	campaignRows, err := s.q.GetCampaignCategoryStats(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get campaign statistics: %w", err)
+	}
+
+	donationRows, err := s.q.GetDonationCategoryStats(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get donation statistics: %w", err)
+	}
+
+	donors, err := s.q.GetPlatformDonorCount(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get donor count: %w", err)
+	}
+
+	categories := make(map[string]*CategoryStats, len(entities.CampaignCategories))
+
+	for _, category := range entities.CampaignCategories {
+		categories[category] = &CategoryStats{Category: category}
+	}
+
+	// campaigns created before a category was retired are counted as other
+	category := func(name string) *CategoryStats {
+		if stats, ok := categories[name]; ok {
+			return stats
+		}
+
+		return categories["other"]
+	}
+
+	for _, row := range campaignRows {
+		stats := category(row.Category)
+		stats.ActiveCampaigns += row.ActiveCampaigns
+		stats.FundedCampaigns += row.FundedCampaigns
+		stats.TotalRaised = stats.TotalRaised.Add(row.TotalRaised)
+	}
+
+	for _, row := range donationRows {
+		stats := category(row.Category)
+		stats.Donations += row.Donations
+		stats.Donors += row.Donors
+	}
+
+	platform := &PlatformStats{
+		Donors:     donors,
+		Categories: make([]CategoryStats, 0, len(categories)),
+		ComputedAt: time.Now(),
+	}
+
+	for _, name := range entities.CampaignCategories {
+		stats := categories[name]
+
+		platform.TotalRaised = platform.TotalRaised.Add(stats.TotalRaised)
+		platform.Donations += stats.Donations
+		platform.ActiveCampaigns += stats.ActiveCampaigns
+		platform.FundedCampaigns += stats.FundedCampaigns
+		platform.Categories = append(platform.Categories, *stats)
+	}
+
+	s.mu.Lock()
+	s.stats = platform
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Stats returns the statistics of the latest refresh.
+func (s *StatsService) Stats(ctx context.Context) (*PlatformStats, error) {
+	if err := s.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := *s.stats
+	stats.Categories = slices.Clone(s.stats.Categories)
+
+	return &stats, nil
+}
+
+// ensureLoaded covers requests that come in before the first scheduled
+// refresh finished.
+func (s *StatsService) ensureLoaded(ctx context.Context) error {
+	s.mu.RLock()
+	loaded := s.stats != nil
+	s.mu.RUnlock()
+
+	if loaded {
+		return nil
+	}
+
+	return s.Refresh(ctx)
+}
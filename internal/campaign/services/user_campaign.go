@@ -3,27 +3,54 @@ package services
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
+	"go-campaign.com/internal/campaign/entities"
 	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/request"
 )
 
+// FieldErrors are request fields the service rejected, handlers answer them
+// like validation errors.
+type FieldErrors map[string]string
+
+func (e FieldErrors) Error() string {
+	fields := make([]string, 0, len(e))
+
+	for field, message := range e {
+		fields = append(fields, field+": "+message)
+	}
+
+	sort.Strings(fields)
+
+	return strings.Join(fields, "; ")
+}
+
+const lockedFieldMessage = "can't be changed once the campaign is approved"
+
 type UserCampaignService struct {
-	q *sqlc.Queries
+	db *sql.DB
+	q  *sqlc.Queries
 }
 
-func NewUserCampaignService(q *sqlc.Queries) *UserCampaignService {
+func NewUserCampaignService(db *sql.DB, q *sqlc.Queries) *UserCampaignService {
 	return &UserCampaignService{
-		q: q,
+		db: db,
+		q:  q,
 	}
 }
 
 func (s *UserCampaignService) GetPaginatedUserCampaigns(ctx context.Context, request PaginatedCampaignRequest) ([]sqlc.GetPaginatedUserCampaignRow, int64, error) {
-	title := sql.NullString{
-		String: request.Title,
-		Valid:  request.Title != "",
+	query := strings.TrimSpace(request.Query)
+	q := sql.NullString{
+		String: query,
+		Valid:  query != "",
 	}
 	status := sql.NullInt32{
 		Int32: request.Status,
@@ -36,7 +63,7 @@ func (s *UserCampaignService) GetPaginatedUserCampaigns(ctx context.Context, req
 			UserID: request.UserID,
 			Limit:  request.Limit,
 			Offset: request.Offset,
-			Title:  title,
+			Query:  q,
 			Status: status,
 		},
 	)
@@ -47,7 +74,7 @@ func (s *UserCampaignService) GetPaginatedUserCampaigns(ctx context.Context, req
 
 	totalCount, err := s.q.GetTotalUserCampaigns(ctx, sqlc.GetTotalUserCampaignsParams{
 		UserID: request.UserID,
-		Title:  title,
+		Query:  q,
 		Status: status,
 	})
 
@@ -58,6 +85,95 @@ func (s *UserCampaignService) GetPaginatedUserCampaigns(ctx context.Context, req
 	return campaigns, totalCount, nil
 }
 
+// GetUserCampaignsByCursor pages the user's campaigns by start date, the
+// search rank is still returned but does not drive the ordering.
+func (s *UserCampaignService) GetUserCampaignsByCursor(ctx context.Context, req PaginatedCampaignRequest) (*request.CursorPage[sqlc.GetPaginatedUserCampaignRow], error) {
+	query := strings.TrimSpace(req.Query)
+	q := sql.NullString{
+		String: query,
+		Valid:  query != "",
+	}
+	status := sql.NullInt32{
+		Int32: req.Status,
+		Valid: req.Status != 0,
+	}
+
+	var (
+		campaigns []sqlc.GetPaginatedUserCampaignRow
+		startDate time.Time
+	)
+
+	if req.Cursor != nil {
+		parsed, err := time.Parse(time.RFC3339Nano, req.Cursor.Key)
+
+		if err != nil {
+			return nil, request.ErrInvalidCursor
+		}
+
+		startDate = parsed
+	}
+
+	if req.Cursor != nil && req.Cursor.Backward {
+		rows, err := s.q.GetUserCampaignsBeforeCursor(ctx, sqlc.GetUserCampaignsBeforeCursorParams{
+			UserID:          req.UserID,
+			Limit:           req.Limit + 1,
+			Query:           q,
+			Status:          status,
+			CursorStartDate: startDate,
+			CursorID:        req.Cursor.ID,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user campaigns: %w", err)
+		}
+
+		for _, row := range rows {
+			campaigns = append(campaigns, sqlc.GetPaginatedUserCampaignRow(row))
+		}
+	} else {
+		params := sqlc.GetUserCampaignsAfterCursorParams{
+			UserID: req.UserID,
+			Limit:  req.Limit + 1,
+			Query:  q,
+			Status: status,
+		}
+
+		if req.Cursor != nil {
+			params.CursorStartDate = sql.NullTime{Time: startDate, Valid: true}
+			params.CursorID = sql.NullInt32{Int32: req.Cursor.ID, Valid: true}
+		}
+
+		rows, err := s.q.GetUserCampaignsAfterCursor(ctx, params)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user campaigns: %w", err)
+		}
+
+		for _, row := range rows {
+			campaigns = append(campaigns, sqlc.GetPaginatedUserCampaignRow(row))
+		}
+	}
+
+	rows := make([]request.Keyed[sqlc.GetPaginatedUserCampaignRow], 0, len(campaigns))
+
+	for _, c := range campaigns {
+		rows = append(rows, request.Keyed[sqlc.GetPaginatedUserCampaignRow]{
+			Item: c,
+			Cursor: request.Cursor{
+				Key: c.StartDate.Format(time.RFC3339Nano),
+				ID:  c.ID,
+			},
+		})
+	}
+
+	page := request.PageByCursor(rows, request.CursorPaginationRequest{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+
+	return &page, nil
+}
+
 func (s *UserCampaignService) CreateCampaign(ctx context.Context, request CreateCampaignRequest) (*sqlc.Campaign, error) {
 	startDate, err := time.Parse(time.DateTime, request.StartDate)
 
@@ -73,22 +189,77 @@ func (s *UserCampaignService) CreateCampaign(ctx context.Context, request Create
 
 	log.Print(request.Description)
 
-	campaign, err := s.q.CreateCampaign(ctx, sqlc.CreateCampaignParams{
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := s.q.WithTx(tx)
+
+	if request.Slug == "" {
+		request.Slug, err = uniqueSlug(ctx, qtx, request.Title)
+
+		if err != nil {
+			return nil, err
+		}
+	} else if err := checkSlugRedirect(ctx, qtx, request.Slug, 0); err != nil {
+		return nil, err
+	}
+
+	campaign, err := qtx.CreateCampaign(ctx, sqlc.CreateCampaignParams{
 		UserID:       request.UserID,
 		Title:        request.Title,
 		Description:  &request.Description,
 		Slug:         request.Slug,
-		TargetAmount: &request.TargetAmount,
+		TargetAmount: request.TargetAmount,
 		StartDate:    startDate,
 		EndDate:      endDate,
 		Status:       int32(request.Status),
 		Images:       request.Images,
+		Tags:         normalizeTags(request.Tags),
+		Category:     categoryValue(request.Category),
 	})
 
+	if isSlugTaken(err) {
+		return nil, FieldErrors{"slug": slugTakenMessage}
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to create campaign: %w", err)
 	}
 
+	if err := saveCampaignLocation(ctx, qtx, campaign.ID, request.Location); err != nil {
+		return nil, err
+	}
+
+	// the creator manages the campaign through its owner membership
+	err = qtx.CreateCampaignOwner(ctx, sqlc.CreateCampaignOwnerParams{
+		CampaignID: campaign.ID,
+		UserID:     request.UserID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create campaign owner: %w", err)
+	}
+
+	_, err = qtx.CreateCampaignRevision(ctx, sqlc.CreateCampaignRevisionParams{
+		AuthorID:   sql.NullInt32{Int32: request.UserID, Valid: true},
+		CampaignID: campaign.ID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create campaign revision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return &campaign, nil
 }
 
@@ -105,7 +276,7 @@ func (s *UserCampaignService) FindUserCampaign(ctx context.Context, userID, camp
 	return &c, nil
 }
 
-func (s *UserCampaignService) UpdateCampaign(ctx context.Context, campaignID int32, request CreateCampaignRequest) (*sqlc.UpdateCampaignRow, error) {
+func (s *UserCampaignService) UpdateCampaign(ctx context.Context, current *sqlc.GetUserCampaignByIdRow, request CreateCampaignRequest) (*sqlc.UpdateCampaignRow, error) {
 	startDate, err := time.Parse(time.DateTime, request.StartDate)
 	if err != nil {
 		return nil, fmt.Errorf("invalid start date format: %w", err)
@@ -116,22 +287,334 @@ func (s *UserCampaignService) UpdateCampaign(ctx context.Context, campaignID int
 		return nil, fmt.Errorf("invalid end date format: %w", err)
 	}
 
-	campaign, err := s.q.UpdateCampaign(ctx, sqlc.UpdateCampaignParams{
-		ID:           campaignID,
+	if errs := checkCampaignUpdate(current, request, startDate); len(errs) > 0 {
+		return nil, errs
+	}
+
+	// the slug stays the same unless a new one is given
+	if request.Slug == "" {
+		request.Slug = current.Slug
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := s.q.WithTx(tx)
+
+	campaign, err := qtx.UpdateCampaign(ctx, sqlc.UpdateCampaignParams{
+		ID:           current.ID,
 		UserID:       request.UserID,
 		Title:        request.Title,
 		Description:  &request.Description,
 		Slug:         request.Slug,
-		TargetAmount: &request.TargetAmount,
+		TargetAmount: request.TargetAmount,
 		StartDate:    startDate,
 		EndDate:      endDate,
 		Status:       int32(request.Status),
 		Images:       request.Images,
+		Tags:         normalizeTags(request.Tags),
+		Category:     categoryValue(request.Category),
 	})
 
+	if isSlugTaken(err) {
+		return nil, FieldErrors{"slug": slugTakenMessage}
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to update campaign: %w", err)
 	}
 
+	if campaign.Slug != current.Slug {
+		if err := s.renameSlug(ctx, qtx, current, campaign.Slug); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := saveCampaignLocation(ctx, qtx, campaign.ID, request.Location); err != nil {
+		return nil, err
+	}
+
+	// every update is kept as a revision so what the campaign promised
+	// can be traced back later
+	_, err = qtx.CreateCampaignRevision(ctx, sqlc.CreateCampaignRevisionParams{
+		AuthorID:   sql.NullInt32{Int32: request.UserID, Valid: true},
+		CampaignID: campaign.ID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create campaign revision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return &campaign, nil
 }
+
+// checkCampaignUpdate keeps unapproved campaigns out of the live statuses and
+// stops approved campaigns from changing what the review agreed on.
+func checkCampaignUpdate(current *sqlc.GetUserCampaignByIdRow, request CreateCampaignRequest, startDate time.Time) FieldErrors {
+	errs := FieldErrors{}
+	status := entities.Status(request.Status)
+
+	if !current.ApprovedAt.Valid {
+		if status != entities.StatusDraft && status != entities.StatusPendingReview {
+			errs["status"] = "must be draft or pending review until the campaign is approved"
+		}
+
+		return errs
+	}
+
+	if status != entities.StatusActive && status != entities.StatusCompleted && status != entities.StatusCancelled {
+		errs["status"] = "can't go back to draft or review once the campaign is approved"
+	}
+
+	if request.Title != current.Title {
+		errs["title"] = lockedFieldMessage
+	}
+
+	// the stored target is read back as float32, compared at that precision
+	if current.TargetAmount == nil || *current.TargetAmount != float32(request.TargetAmount.InexactFloat64()) {
+		errs["target_amount"] = lockedFieldMessage
+	}
+
+	if !startDate.Equal(current.StartDate) {
+		errs["start_date"] = lockedFieldMessage
+	}
+
+	return errs
+}
+
+// renameSlug keeps the old slug of a published campaign pointing to the new
+// one, links already shared keep working.
+func (s *UserCampaignService) renameSlug(ctx context.Context, qtx *sqlc.Queries, current *sqlc.GetUserCampaignByIdRow, slug string) error {
+	if err := checkSlugRedirect(ctx, qtx, slug, current.ID); err != nil {
+		return err
+	}
+
+	// moving back to an old slug of its own drops that redirect
+	err := qtx.DeleteSlugRedirect(ctx, sqlc.DeleteSlugRedirectParams{
+		Slug:       slug,
+		CampaignID: current.ID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to release slug redirect: %w", err)
+	}
+
+	// drafts were never public, nobody links to their old slug
+	if !current.ApprovedAt.Valid {
+		return nil
+	}
+
+	err = qtx.CreateSlugRedirect(ctx, sqlc.CreateSlugRedirectParams{
+		Slug:       current.Slug,
+		CampaignID: current.ID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to create slug redirect: %w", err)
+	}
+
+	return nil
+}
+
+// GetLocation returns nil when the campaign has no location.
+func (s *UserCampaignService) GetLocation(ctx context.Context, campaignID int32) (*repository.CampaignLocation, error) {
+	row, err := s.q.GetCampaignLocation(ctx, campaignID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign location: %w", err)
+	}
+
+	return campaignLocation(row), nil
+}
+
+// saveCampaignLocation replaces the location of the campaign, an empty
+// location removes it.
+func saveCampaignLocation(ctx context.Context, qtx *sqlc.Queries, campaignID int32, location *repository.CampaignLocation) error {
+	if location == nil || *location == (repository.CampaignLocation{}) {
+		if err := qtx.DeleteCampaignLocation(ctx, campaignID); err != nil {
+			return fmt.Errorf("failed to delete campaign location: %w", err)
+		}
+
+		return nil
+	}
+
+	params := sqlc.UpsertCampaignLocationParams{
+		CampaignID: campaignID,
+	}
+
+	if location.Province != nil {
+		params.Province = sql.NullString{String: *location.Province, Valid: true}
+	}
+
+	if location.City != nil {
+		params.City = sql.NullString{String: *location.City, Valid: true}
+	}
+
+	if location.Latitude != nil && location.Longitude != nil {
+		params.Latitude = sql.NullFloat64{Float64: *location.Latitude, Valid: true}
+		params.Longitude = sql.NullFloat64{Float64: *location.Longitude, Valid: true}
+	}
+
+	if err := qtx.UpsertCampaignLocation(ctx, params); err != nil {
+		return fmt.Errorf("failed to save campaign location: %w", err)
+	}
+
+	return nil
+}
+
+func campaignLocation(row sqlc.CampaignLocation) *repository.CampaignLocation {
+	location := &repository.CampaignLocation{}
+
+	if row.Province.Valid {
+		location.Province = &row.Province.String
+	}
+
+	if row.City.Valid {
+		location.City = &row.City.String
+	}
+
+	if row.Latitude.Valid && row.Longitude.Valid {
+		location.Latitude = &row.Latitude.Float64
+		location.Longitude = &row.Longitude.Float64
+	}
+
+	return location
+}
+
+// categoryValue stores an empty category as NULL.
+func categoryValue(category string) *string {
+	if category == "" {
+		return nil
+	}
+
+	return &category
+}
+
+// normalizeTags lowercases and de-duplicates the campaign tags so they index
+// consistently in the search vector.
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+func (s *UserCampaignService) GetMilestones(ctx context.Context, campaignID int32) ([]repository.Milestone, error) {
+	rows, err := s.q.GetCampaignMilestones(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign milestones: %w", err)
+	}
+
+	milestones := make([]repository.Milestone, 0, len(rows))
+
+	for _, row := range rows {
+		milestone := repository.Milestone{
+			ID:          row.ID,
+			Amount:      row.Amount,
+			Description: row.Description,
+			Stretch:     row.Stretch,
+			Reached:     row.ReachedAt.Valid,
+		}
+
+		if row.ReachedAt.Valid {
+			milestone.ReachedAt = &row.ReachedAt.Time
+		}
+
+		milestones = append(milestones, milestone)
+	}
+
+	return milestones, nil
+}
+
+// ReplaceMilestones swaps the whole milestone list of a campaign. Milestones
+// the campaign already passed are marked as reached right away, without
+// publishing an event.
+func (s *UserCampaignService) ReplaceMilestones(ctx context.Context, campaignID int32, milestones []MilestoneRequest) ([]repository.Milestone, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the database transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	qtx := s.q.WithTx(tx)
+
+	if err := qtx.DeleteCampaignMilestones(ctx, campaignID); err != nil {
+		return nil, fmt.Errorf("failed to delete campaign milestones: %w", err)
+	}
+
+	for _, milestone := range milestones {
+		err := qtx.CreateCampaignMilestone(ctx, sqlc.CreateCampaignMilestoneParams{
+			CampaignID:  campaignID,
+			Amount:      milestone.Amount,
+			Description: milestone.Description,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create campaign milestone: %w", err)
+		}
+	}
+
+	if _, err := qtx.MarkReachedMilestones(ctx, campaignID); err != nil {
+		return nil, fmt.Errorf("failed to update campaign milestones: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return s.GetMilestones(ctx, campaignID)
+}
+
+// GetReviews lists the admin decisions on the campaign, the latest first.
+func (s *UserCampaignService) GetReviews(ctx context.Context, campaignID int32) ([]CampaignReview, error) {
+	rows, err := s.q.GetCampaignReviews(ctx, campaignID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign reviews: %w", err)
+	}
+
+	reviews := make([]CampaignReview, 0, len(rows))
+
+	for _, row := range rows {
+		reviews = append(reviews, CampaignReview{
+			ID:           row.ID,
+			Approved:     entities.ReviewDecision(row.Decision) == entities.ReviewApproved,
+			Reason:       row.Reason,
+			ReviewerName: row.ReviewerName,
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+
+	return reviews, nil
+}
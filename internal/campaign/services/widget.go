@@ -0,0 +1,252 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/events"
+	"go-campaign.com/pkg/cache"
+)
+
+// WidgetTTL is how long a rendered widget is served from memory, paid
+// donations drop it earlier.
+const WidgetTTL = time.Minute
+
+type WidgetFormat string
+
+const (
+	WidgetSVG  WidgetFormat = "svg"
+	WidgetHTML WidgetFormat = "html"
+)
+
+type widgetTheme struct {
+	Background string
+	Text       string
+	Muted      string
+	Track      string
+	Bar        string
+	Button     string
+	ButtonText string
+}
+
+var widgetThemes = map[string]widgetTheme{
+	"light": {
+		Background: "#ffffff",
+		Text:       "#111827",
+		Muted:      "#6b7280",
+		Track:      "#e5e7eb",
+		Bar:        "#16a34a",
+		Button:     "#16a34a",
+		ButtonText: "#ffffff",
+	},
+	"dark": {
+		Background: "#111827",
+		Text:       "#f9fafb",
+		Muted:      "#9ca3af",
+		Track:      "#374151",
+		Bar:        "#22c55e",
+		Button:     "#22c55e",
+		ButtonText: "#052e16",
+	},
+}
+
+// widgetWidths are the widths in pixels of the widget sizes.
+var widgetWidths = map[string]int{
+	"small":  240,
+	"medium": 320,
+	"large":  400,
+}
+
+var (
+	WidgetThemes = []string{"light", "dark"}
+	WidgetSizes  = []string{"small", "medium", "large"}
+)
+
+type WidgetService struct {
+	campaigns repository.CampaignRepository
+	appURL    string
+	cache     *cache.Cache[*Widget]
+}
+
+func NewWidgetService(campaigns repository.CampaignRepository, appURL string) *WidgetService {
+	return &WidgetService{
+		campaigns: campaigns,
+		appURL:    appURL,
+		cache:     cache.New[*Widget](WidgetTTL),
+	}
+}
+
+// Render returns the progress widget of a published campaign, rendered
+// widgets are cached until the TTL passes or the campaign gets a donation.
+func (s *WidgetService) Render(ctx context.Context, req WidgetRequest) (*Widget, error) {
+	key := fmt.Sprintf("%s:%s:%s:%s", req.Slug, req.Format, req.Theme, req.Size)
+
+	if widget, ok := s.cache.Get(key); ok {
+		return widget, nil
+	}
+
+	campaign, err := s.campaigns.GetCampaignBySlug(ctx, req.Slug)
+
+	if err != nil {
+		return nil, ErrCampaignNotFound
+	}
+
+	data := s.widgetData(campaign, req)
+
+	var body bytes.Buffer
+
+	tmpl := widgetSVGTemplate
+	contentType := "image/svg+xml; charset=utf-8"
+
+	if req.Format == WidgetHTML {
+		tmpl = widgetHTMLTemplate
+		contentType = "text/html; charset=utf-8"
+	}
+
+	if err := tmpl.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf("failed to render widget: %w", err)
+	}
+
+	sum := sha256.Sum256(body.Bytes())
+
+	widget := &Widget{
+		CampaignID:  campaign.ID,
+		Body:        body.Bytes(),
+		ContentType: contentType,
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}
+
+	s.cache.Set(key, widget)
+
+	return widget, nil
+}
+
+// Invalidate drops the cached widgets of a campaign once one of its
+// donations is paid, so the next request shows the new amount.
+func (s *WidgetService) Invalidate(_ context.Context, event events.Event) {
+	paid := event.(events.PaymentPaid)
+
+	s.cache.DeleteFunc(func(_ string, widget *Widget) bool {
+		return widget.CampaignID == paid.CampaignID
+	})
+}
+
+type widgetData struct {
+	Title     string
+	Raised    string
+	Target    string
+	Percent   int
+	Width     int
+	Height    int
+	BarWidth  int
+	DonateURL string
+	Suspended bool
+	Theme     widgetTheme
+}
+
+func (s *WidgetService) widgetData(campaign *repository.DetailCampaign, req WidgetRequest) widgetData {
+	width := widgetWidths[req.Size]
+	percent := 0
+
+	if campaign.TargetAmount.IsPositive() {
+		percent = int(campaign.CurrentAmount.Mul(decimal.NewFromInt(100)).Div(campaign.TargetAmount).IntPart())
+	}
+
+	barWidth := width - 32
+
+	return widgetData{
+		// an svg text doesn't wrap, so long titles are cut to fit the width
+		Title:     truncateTitle(campaign.Title, (width-32)/8),
+		Raised:    FormatRupiah(campaign.CurrentAmount),
+		Target:    FormatRupiah(campaign.TargetAmount),
+		Percent:   percent,
+		Width:     width,
+		Height:    140,
+		BarWidth:  barWidth * min(percent, 100) / 100,
+		DonateURL: fmt.Sprintf("%s/campaigns/%s", s.appURL, campaign.Slug),
+		Suspended: campaign.Suspended,
+		Theme:     widgetThemes[req.Theme],
+	}
+}
+
+func truncateTitle(title string, max int) string {
+	runes := []rune(title)
+
+	if len(runes) <= max {
+		return title
+	}
+
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
+
+// FormatRupiah writes an amount the way Indonesian donors read it, e.g.
+// Rp 1.250.000.
+func FormatRupiah(amount decimal.Decimal) string {
+	digits := amount.Round(0).String()
+	sign := ""
+
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	var b strings.Builder
+
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+
+		b.WriteRune(d)
+	}
+
+	return sign + "Rp " + b.String()
+}
+
+var widgetSVGTemplate = template.Must(template.New("widget.svg").Funcs(widgetFuncs).Parse(`<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="{{.Height}}" viewBox="0 0 {{.Width}} {{.Height}}" role="img" aria-label="{{.Title}}: {{.Percent}}% funded">
+<rect width="{{.Width}}" height="{{.Height}}" rx="8" fill="{{.Theme.Background}}"/>
+<text x="16" y="28" font-family="Helvetica, Arial, sans-serif" font-size="14" font-weight="bold" fill="{{.Theme.Text}}">{{.Title}}</text>
+<rect x="16" y="44" width="{{.Width | track}}" height="8" rx="4" fill="{{.Theme.Track}}"/>
+<rect x="16" y="44" width="{{.BarWidth}}" height="8" rx="4" fill="{{.Theme.Bar}}"/>
+<text x="16" y="74" font-family="Helvetica, Arial, sans-serif" font-size="12" fill="{{.Theme.Text}}">{{.Raised}} raised</text>
+<text x="16" y="92" font-family="Helvetica, Arial, sans-serif" font-size="11" fill="{{.Theme.Muted}}">{{.Percent}}% of {{.Target}}</text>
+<a href="{{.DonateURL}}" target="_blank">
+<rect x="16" y="104" width="{{.Width | track}}" height="26" rx="4" fill="{{.Theme.Button}}"/>
+<text x="{{.Width | center}}" y="121" text-anchor="middle" font-family="Helvetica, Arial, sans-serif" font-size="12" font-weight="bold" fill="{{.Theme.ButtonText}}">{{if .Suspended}}Donations paused{{else}}Donate now{{end}}</text>
+</a>
+</svg>
+`))
+
+var widgetHTMLTemplate = template.Must(template.New("widget.html").Funcs(widgetFuncs).Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+</head>
+<body style="margin:0;font-family:Helvetica,Arial,sans-serif;background:{{.Theme.Background}};color:{{.Theme.Text}}">
+<div style="box-sizing:border-box;width:{{.Width}}px;padding:16px">
+<div style="font-size:14px;font-weight:bold;margin-bottom:12px">{{.Title}}</div>
+<div style="height:8px;border-radius:4px;background:{{.Theme.Track}}">
+<div style="height:8px;border-radius:4px;width:{{.BarWidth}}px;background:{{.Theme.Bar}}"></div>
+</div>
+<div style="font-size:12px;margin-top:12px">{{.Raised}} raised</div>
+<div style="font-size:11px;margin-top:4px;color:{{.Theme.Muted}}">{{.Percent}}% of {{.Target}}</div>
+{{if .Suspended}}<div style="margin-top:12px;padding:6px 0;text-align:center;font-size:12px;color:{{.Theme.Muted}}">Donations paused</div>
+{{else}}<a href="{{.DonateURL}}" target="_blank" rel="noopener" style="display:block;margin-top:12px;padding:6px 0;border-radius:4px;text-align:center;font-size:12px;font-weight:bold;text-decoration:none;background:{{.Theme.Button}};color:{{.Theme.ButtonText}}">Donate now</a>
+{{end}}</div>
+</body>
+</html>
+`))
+
+var widgetFuncs = template.FuncMap{
+	"track":  func(width int) int { return width - 32 },
+	"center": func(width int) int { return width / 2 },
+}
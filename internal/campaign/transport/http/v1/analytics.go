@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type analyticsHandler struct {
+	s         *services.AnalyticsService
+	campaigns *services.UserCampaignService
+}
+
+func NewAnalyticsHandler(s *services.AnalyticsService, campaigns *services.UserCampaignService) *analyticsHandler {
+	return &analyticsHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+// Show returns the donation analytics of a campaign, every member can see
+// them. The period defaults to the last 30 days.
+func (h *analyticsHandler) Show(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	req := analyticsRequest{Top: 10}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	from, to := req.period(time.Now())
+
+	analytics, err := h.s.GetCampaignAnalytics(c.Context(), services.CampaignAnalyticsRequest{
+		CampaignID: campaign.ID,
+		From:       from,
+		To:         to,
+		TopDonors:  req.Top,
+	})
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Campaign analytics retrieved successfully", analytics),
+	)
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type campaignUpdateHandler struct {
+	s         *services.CampaignUpdateService
+	campaigns *services.UserCampaignService
+}
+
+func NewCampaignUpdateHandler(s *services.CampaignUpdateService, campaigns *services.UserCampaignService) *campaignUpdateHandler {
+	return &campaignUpdateHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+func (h *campaignUpdateHandler) Index(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	updates, err := h.s.GetUpdates(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Campaign updates retrieved successfully", updates),
+	)
+}
+
+// Create posts a new update, it shows on the campaign and in its feed.
+func (h *campaignUpdateHandler) Create(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	var req campaignUpdateRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	update, err := h.s.Create(c.Context(), services.CreateCampaignUpdateRequest{
+		CampaignID: campaign.ID,
+		AuthorID:   int32(userID),
+		Title:      req.Title,
+		Body:       req.Body,
+	})
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to create campaign update", err.Error()),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Campaign update created successfully", update),
+	)
+}
+
+func (h *campaignUpdateHandler) Delete(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	updateID, err := strconv.Atoi(c.Params("updateId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid update ID", "Update ID must be a valid integer"),
+		)
+	}
+
+	err = h.s.Delete(c.Context(), campaign.ID, int32(updateID))
+
+	if errors.Is(err, services.ErrCampaignUpdateNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign update not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to delete campaign update", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Campaign update deleted successfully", nil),
+	)
+}
+
+// PublicIndex lists the updates of a published campaign.
+func (h *campaignUpdateHandler) PublicIndex(c *fiber.Ctx) error {
+	_, updates, err := h.s.GetPublishedUpdates(c.Context(), c.Params("slug"))
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Campaign updates retrieved successfully", updates),
+	)
+}
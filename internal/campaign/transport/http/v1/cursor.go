@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/shared/http/request"
+)
+
+// maxPerPage bounds the page size of the lists that can be paged by cursor,
+// in both pagination modes.
+const maxPerPage = 100
+
+// parsePage reads page and per_page, out of range values fall back to the
+// first page and the default size, sizes above maxPerPage are capped.
+func parsePage(c *fiber.Ctx) (page, perPage int) {
+	page = c.QueryInt("page", 1)
+	if page <= 0 {
+		page = 1
+	}
+
+	perPage = c.QueryInt("per_page", 10)
+	if perPage <= 0 {
+		perPage = 10
+	}
+
+	return page, min(perPage, maxPerPage)
+}
+
+// parseCursor reports whether the list is requested in cursor mode, which is
+// the case as soon as the cursor query parameter is present. An empty value
+// asks for the first page.
+func parseCursor(c *fiber.Ctx) (*request.Cursor, bool, error) {
+	if !c.Request().URI().QueryArgs().Has("cursor") {
+		return nil, false, nil
+	}
+
+	value := c.Query("cursor")
+
+	if value == "" {
+		return nil, true, nil
+	}
+
+	cursor, err := request.DecodeCursor(value)
+
+	return cursor, true, err
+}
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+)
+
+const (
+	// streamHeartbeatInterval keeps proxies from closing an idle stream, a
+	// failed heartbeat also tells the client went away
+	streamHeartbeatInterval = 20 * time.Second
+	// streamRetry is how long the browser waits before reconnecting
+	streamRetry = 5 * time.Second
+)
+
+type donationStreamHandler struct {
+	s *services.DonationStreamService
+}
+
+func NewDonationStreamHandler(s *services.DonationStreamService) *donationStreamHandler {
+	return &donationStreamHandler{
+		s: s,
+	}
+}
+
+// Stream pushes the donations paid to a campaign and its progress as
+// Server-Sent Events, starting with the current progress.
+func (h *donationStreamHandler) Stream(c *fiber.Ctx) error {
+	subscription, err := h.s.Subscribe(c.Context(), c.Params("slug"))
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// stops nginx from buffering the stream
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer subscription.Close()
+
+		heartbeat := time.NewTicker(streamHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds())
+
+		if err := writeStreamEvent(w, services.StreamEventProgress, subscription.Progress); err != nil {
+			return
+		}
+
+		for {
+			select {
+			// the browser reconnects to another instance after streamRetry
+			case <-subscription.Done:
+				return
+			case event := <-subscription.Events:
+				if err := writeStreamEvent(w, event.Name, event.Data); err != nil {
+					return
+				}
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+
+				if err := w.Flush(); err != nil {
+					return
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+func writeStreamEvent(w *bufio.Writer, name string, data any) error {
+	body, err := json.Marshal(data)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, body)
+
+	return w.Flush()
+}
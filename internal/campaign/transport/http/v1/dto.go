@@ -1,8 +1,14 @@
 package v1
 
 import (
+	"errors"
+	"regexp"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/campaign/services/repository"
 )
 
 type ListCampaign struct {
@@ -18,10 +24,16 @@ type ListCampaign struct {
 }
 
 type DonationRequest struct {
-	Name   string  `json:"name" validate:"required,min=3,max=100"`
-	Email  string  `json:"email" validate:"required,email"`
-	Amount float32 `json:"amount" validate:"required,min=1"`
-	Note   string  `json:"note" validate:"omitempty,max=500"`
+	Name         string                  `json:"name" validate:"required,min=3,max=100"`
+	Email        string                  `json:"email" validate:"required,email"`
+	Amount       float32                 `json:"amount" validate:"required,min=1"`
+	Note         string                  `json:"note" validate:"omitempty,max=500"`
+	RewardTierID *int32                  `json:"reward_tier_id"`
+	Shipping     *shippingAddressRequest `json:"shipping"`
+	Anonymous    bool                    `json:"anonymous"`
+	// Fundraiser is the slug of the fundraiser page the donation is made through
+	Fundraiser string             `json:"fundraiser"`
+	Dedication *dedicationRequest `json:"dedication"`
 }
 
 func (r *DonationRequest) Validate() error {
@@ -30,5 +42,213 @@ func (r *DonationRequest) Validate() error {
 		validation.Field(&r.Email, validation.Required, validation.Length(5, 100), is.Email),
 		validation.Field(&r.Amount, validation.Required, validation.Min(1.0)),
 		validation.Field(&r.Note, validation.Length(0, 500)),
+		validation.Field(&r.RewardTierID, validation.NilOrNotEmpty, validation.Min(int32(1))),
+		validation.Field(&r.Shipping),
+		validation.Field(&r.Fundraiser, validation.Length(0, 60)),
+		validation.Field(&r.Dedication),
+	)
+}
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9]+$`)
+
+type shippingAddressRequest struct {
+	Name    string `json:"name"`
+	Phone   string `json:"phone"`
+	Address string `json:"address"`
+}
+
+func (r shippingAddressRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Name, validation.Required, validation.Length(3, 100)),
+		validation.Field(&r.Phone, validation.Required, validation.Length(8, 20), validation.Match(phonePattern).Error("must be a valid phone number")),
+		validation.Field(&r.Address, validation.Required, validation.Length(10, 500)),
+	)
+}
+
+// toShippingAddress returns nil when no address was sent.
+func (r *shippingAddressRequest) toShippingAddress() *repository.ShippingAddress {
+	if r == nil {
+		return nil
+	}
+
+	return &repository.ShippingAddress{
+		Name:    r.Name,
+		Phone:   r.Phone,
+		Address: r.Address,
+	}
+}
+
+type dedicationRequest struct {
+	Type           string `json:"type"`
+	HonoreeName    string `json:"honoree_name"`
+	RecipientEmail string `json:"recipient_email"`
+	Message        string `json:"message"`
+	// Public shows the dedication on the donor wall
+	Public bool `json:"public"`
+}
+
+func (r dedicationRequest) Validate() error {
+	types := make([]any, 0, len(entities.DedicationTypes))
+	for _, dedicationType := range entities.DedicationTypes {
+		types = append(types, dedicationType)
+	}
+
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Type, validation.Required, validation.In(types...)),
+		validation.Field(&r.HonoreeName, validation.Required, validation.Length(2, 100)),
+		// the e-card is only sent when a recipient is given
+		validation.Field(&r.RecipientEmail, validation.Length(5, 100), is.Email),
+		validation.Field(&r.Message, validation.Length(0, 1000)),
+	)
+}
+
+// toDedication returns nil when the donation isn't dedicated.
+func (r *dedicationRequest) toDedication() *repository.Dedication {
+	if r == nil {
+		return nil
+	}
+
+	return &repository.Dedication{
+		Type:           r.Type,
+		HonoreeName:    r.HonoreeName,
+		RecipientEmail: r.RecipientEmail,
+		Message:        r.Message,
+		Public:         r.Public,
+	}
+}
+
+type campaignListRequest struct {
+	Query       string   `query:"q"`
+	Sort        string   `query:"sort"`
+	Category    string   `query:"category"`
+	ProgressMin *float64 `query:"progress_min"`
+	ProgressMax *float64 `query:"progress_max"`
+	TargetMin   *float64 `query:"target_min"`
+	TargetMax   *float64 `query:"target_max"`
+	Province    string   `query:"province"`
+	City        string   `query:"city"`
+	Lat         *float64 `query:"lat"`
+	Lng         *float64 `query:"lng"`
+	RadiusKm    *float64 `query:"radius_km"`
+}
+
+// maxRadiusKm keeps the radius search local, wider areas are better served
+// by the region filter.
+const maxRadiusKm = 500.0
+
+func (r *campaignListRequest) Validate() error {
+	sorts := make([]any, 0, len(repository.CampaignSorts))
+	for _, sort := range repository.CampaignSorts {
+		sorts = append(sorts, sort)
+	}
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Query, validation.Length(0, 100)),
+		validation.Field(&r.Sort, validation.In(sorts...)),
+		validation.Field(&r.Category, validation.In(campaignCategories()...)),
+		validation.Field(&r.ProgressMin, validation.Min(0.0)),
+		validation.Field(&r.ProgressMax, validation.Min(0.0), validation.By(notLessThan(r.ProgressMin))),
+		validation.Field(&r.TargetMin, validation.Min(0.0)),
+		validation.Field(&r.TargetMax, validation.Min(0.0), validation.By(notLessThan(r.TargetMin))),
+		validation.Field(&r.Province, validation.Length(0, 60)),
+		validation.Field(&r.City, validation.Length(0, 60)),
+		validation.Field(&r.Lat, validation.When(r.Lng != nil || r.RadiusKm != nil || r.Sort == repository.SortDistance, validation.NotNil), validation.Min(-90.0), validation.Max(90.0)),
+		validation.Field(&r.Lng, validation.When(r.Lat != nil || r.RadiusKm != nil || r.Sort == repository.SortDistance, validation.NotNil), validation.Min(-180.0), validation.Max(180.0)),
+		validation.Field(&r.RadiusKm, validation.Min(0.1), validation.Max(maxRadiusKm)),
+	)
+}
+
+// notLessThan checks that the upper bound of a range is not below its lower bound.
+func notLessThan(lower *float64) validation.RuleFunc {
+	return func(value interface{}) error {
+		upper, _ := value.(*float64)
+
+		if lower == nil || upper == nil || *upper >= *lower {
+			return nil
+		}
+
+		return errors.New("must be greater than or equal to the minimum value")
+	}
+}
+
+type reportCampaignRequest struct {
+	Category    string `json:"category"`
+	Description string `json:"description"`
+}
+
+func (r *reportCampaignRequest) Validate() error {
+	categories := make([]any, 0, len(entities.ReportCategories))
+	for _, category := range entities.ReportCategories {
+		categories = append(categories, category)
+	}
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Category, validation.Required, validation.In(categories...)),
+		validation.Field(&r.Description, validation.When(r.Category == "other", validation.Required), validation.Length(0, 1000)),
+	)
+}
+
+type widgetRequest struct {
+	Theme string `query:"theme"`
+	Size  string `query:"size"`
+}
+
+func (r *widgetRequest) Validate() error {
+	themes := make([]any, 0, len(services.WidgetThemes))
+	for _, theme := range services.WidgetThemes {
+		themes = append(themes, theme)
+	}
+
+	sizes := make([]any, 0, len(services.WidgetSizes))
+	for _, size := range services.WidgetSizes {
+		sizes = append(sizes, size)
+	}
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Theme, validation.In(themes...)),
+		validation.Field(&r.Size, validation.In(sizes...)),
+	)
+}
+
+type qrCodeRequest struct {
+	// Scale is the size in pixels of a single module of the code
+	Scale int `query:"scale"`
+}
+
+func (r *qrCodeRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Scale, validation.Min(1), validation.Max(20)),
+	)
+}
+
+type leaderboardRequest struct {
+	Limit int32 `query:"limit"`
+}
+
+func (r *leaderboardRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Limit, validation.Min(int32(1)), validation.Max(int32(50))),
+	)
+}
+
+type trendingRequest struct {
+	Limit    int32  `query:"limit"`
+	Category string `query:"category"`
+}
+
+func (r *trendingRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Limit, validation.Min(int32(1)), validation.Max(int32(50))),
+		validation.Field(&r.Category, validation.In(campaignCategories()...)),
+	)
+}
+
+type similarCampaignsRequest struct {
+	Limit int32 `query:"limit"`
+}
+
+func (r *similarCampaignsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Limit, validation.Min(int32(1)), validation.Max(int32(20))),
 	)
 }
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+// exportTimeout bounds the database read of an export.
+const exportTimeout = 10 * time.Minute
+
+type exportHandler struct {
+	s         *services.ExportService
+	campaigns *services.UserCampaignService
+}
+
+func NewExportHandler(s *services.ExportService, campaigns *services.UserCampaignService) *exportHandler {
+	return &exportHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+// Donations streams the donations of a campaign as csv or xlsx, only owners
+// and editors can download them since the file holds donor emails.
+func (h *exportHandler) Donations(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	var req exportDonationsRequest
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	filter := req.filter(campaign.ID)
+	filename := fmt.Sprintf("%s-donations-%s.%s", campaign.Slug, time.Now().Format(time.DateOnly), filter.Format)
+
+	contentType := "text/csv; charset=utf-8"
+
+	if filter.Format == services.ExportFormatXLSX {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	// the stream is written after the handler returns, so it can't use the
+	// request context
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+		defer cancel()
+
+		if err := h.s.ExportDonations(ctx, w, filter); err != nil {
+			log.Printf("failed to export donations of campaign %d: %v", filter.CampaignID, err)
+
+			// the status is already sent, the last line tells the file is
+			// incomplete. A workbook cut off halfway misses its zip directory,
+			// so spreadsheet apps refuse to open it.
+			if filter.Format != services.ExportFormatXLSX {
+				fmt.Fprint(w, "\n# export failed, the file is incomplete\n")
+			}
+		}
+
+		if err := w.Flush(); err != nil {
+			log.Printf("failed to flush donations export of campaign %d: %v", filter.CampaignID, err)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type fundraiserHandler struct {
+	s *services.FundraiserService
+}
+
+func NewFundraiserHandler(s *services.FundraiserService) *fundraiserHandler {
+	return &fundraiserHandler{
+		s: s,
+	}
+}
+
+// Create starts a fundraiser page for the campaign, donations made through it
+// still go to the campaign.
+func (h *fundraiserHandler) Create(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	var req createFundraiserRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	fundraiser, err := h.s.Create(c.Context(), services.CreateFundraiserRequest{
+		UserID:       int32(userID),
+		CampaignSlug: c.Params("slug"),
+		Slug:         req.Slug,
+		Title:        req.Title,
+		Story:        req.Story,
+		TargetAmount: decimal.NewFromFloat32(req.TargetAmount),
+	})
+
+	var fieldErrs services.FieldErrors
+
+	if errors.As(err, &fieldErrs) {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError(fieldErrs)),
+		)
+	}
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if errors.Is(err, services.ErrCampaignNotActive) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Campaign is not active", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to create fundraiser", err.Error()),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Fundraiser created successfully", fundraiser),
+	)
+}
+
+// Show returns a fundraiser page with its progress and parent campaign.
+func (h *fundraiserHandler) Show(c *fiber.Ctx) error {
+	fundraiser, err := h.s.GetFundraiser(c.Context(), c.Params("slug"))
+
+	if errors.Is(err, services.ErrFundraiserNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Fundraiser not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Fundraiser retrieved successfully", fundraiser),
+	)
+}
+
+// Leaderboard ranks the fundraisers of a campaign by the amount they raised.
+func (h *fundraiserHandler) Leaderboard(c *fiber.Ctx) error {
+	req := leaderboardRequest{Limit: 10}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	fundraisers, err := h.s.Leaderboard(c.Context(), c.Params("slug"), req.Limit)
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Fundraisers retrieved successfully", fundraisers),
+	)
+}
+
+// Index lists the fundraisers the user started.
+func (h *fundraiserHandler) Index(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	fundraisers, err := h.s.GetUserFundraisers(c.Context(), int32(userID))
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Fundraisers retrieved successfully", fundraisers),
+	)
+}
+
+func (h *fundraiserHandler) Update(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	id, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid fundraiser ID", "Fundraiser ID must be a valid integer"),
+		)
+	}
+
+	var req updateFundraiserRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err = req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	fundraiser, err := h.s.Update(c.Context(), services.UpdateFundraiserRequest{
+		ID:           int32(id),
+		UserID:       int32(userID),
+		Title:        req.Title,
+		Story:        req.Story,
+		TargetAmount: decimal.NewFromFloat32(req.TargetAmount),
+	})
+
+	if errors.Is(err, services.ErrFundraiserNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Fundraiser not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to update fundraiser", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Fundraiser updated successfully", fundraiser),
+	)
+}
+
+func (h *fundraiserHandler) Delete(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	id, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid fundraiser ID", "Fundraiser ID must be a valid integer"),
+		)
+	}
+
+	err = h.s.Delete(c.Context(), int32(id), int32(userID))
+
+	if errors.Is(err, services.ErrFundraiserNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Fundraiser not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to delete fundraiser", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Fundraiser deleted successfully", nil),
+	)
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type leaderboardHandler struct {
+	s *services.LeaderboardService
+}
+
+func NewLeaderboardHandler(s *services.LeaderboardService) *leaderboardHandler {
+	return &leaderboardHandler{
+		s: s,
+	}
+}
+
+// TopDonors ranks the donors of a campaign by the total they donated.
+func (h *leaderboardHandler) TopDonors(c *fiber.Ctx) error {
+	req := leaderboardRequest{Limit: 10}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	donors, err := h.s.TopDonors(c.Context(), c.Params("slug"), req.Limit)
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Top donors retrieved successfully", donors),
+	)
+}
+
+// Trending lists the campaigns gaining donations the fastest.
+func (h *leaderboardHandler) Trending(c *fiber.Ctx) error {
+	req := trendingRequest{Limit: 10}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	campaigns, err := h.s.Trending(c.Context(), req.Category, req.Limit)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	// scores only change when they are recomputed
+	c.Set(fiber.HeaderCacheControl, "public, max-age=60")
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Trending campaigns retrieved successfully", campaigns),
+	)
+}
@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type matchingPoolHandler struct {
+	s         *services.MatchingPoolService
+	campaigns *services.UserCampaignService
+}
+
+func NewMatchingPoolHandler(s *services.MatchingPoolService, campaigns *services.UserCampaignService) *matchingPoolHandler {
+	return &matchingPoolHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+func (h *matchingPoolHandler) Index(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	pools, err := h.s.GetPools(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Matching pools retrieved successfully", pools),
+	)
+}
+
+// Create records a sponsor's pledge, once the sponsor confirmed it paid
+// donations are matched from it while its window is open and its cap isn't
+// spent.
+func (h *matchingPoolHandler) Create(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	var req createMatchingPoolRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	pool, err := h.s.Create(c.Context(), campaign.Title, services.CreateMatchingPoolRequest{
+		CampaignID:   campaign.ID,
+		UserID:       int32(userID),
+		SponsorName:  req.SponsorName,
+		SponsorEmail: req.SponsorEmail,
+		Ratio:        decimal.NewFromFloat(req.Ratio).Round(2),
+		Cap:          decimal.NewFromFloat(req.Cap).Round(2),
+		StartsAt:     req.StartsAt,
+		EndsAt:       req.EndsAt,
+	})
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to create matching pool", err.Error()),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Matching pool created successfully", pool),
+	)
+}
+
+// Confirm is public, the token emailed to the sponsor is the proof the
+// sponsor made the pledge.
+func (h *matchingPoolHandler) Confirm(c *fiber.Ctx) error {
+	err := h.s.Confirm(c.Context(), c.Params("token"))
+
+	if errors.Is(err, services.ErrConfirmationNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Confirmation not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to confirm matching pool", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Matching pool confirmed successfully", nil),
+	)
+}
+
+func (h *matchingPoolHandler) Delete(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	poolID, err := strconv.Atoi(c.Params("poolId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid matching pool ID", "Matching pool ID must be a valid integer"),
+		)
+	}
+
+	err = h.s.Cancel(c.Context(), campaign.ID, int32(poolID))
+
+	if errors.Is(err, services.ErrMatchingPoolNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Matching pool not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to cancel matching pool", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Matching pool cancelled successfully", nil),
+	)
+}
+
+// Statement lists the donations the pool matched, it is what the sponsor is
+// emailed once the pool closes.
+func (h *matchingPoolHandler) Statement(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	poolID, err := strconv.Atoi(c.Params("poolId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid matching pool ID", "Matching pool ID must be a valid integer"),
+		)
+	}
+
+	statement, err := h.s.Statement(c.Context(), campaign.ID, int32(poolID))
+
+	if errors.Is(err, services.ErrMatchingPoolNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Matching pool not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Matching pool statement retrieved successfully", statement),
+	)
+}
@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type memberHandler struct {
+	s         *services.MemberService
+	campaigns *services.UserCampaignService
+}
+
+func NewMemberHandler(s *services.MemberService, campaigns *services.UserCampaignService) *memberHandler {
+	return &memberHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+func (h *memberHandler) Index(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	members, err := h.s.GetMembers(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Members retrieved successfully", members),
+	)
+}
+
+func (h *memberHandler) Invite(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanManageMembers)
+	if campaign == nil {
+		return resp
+	}
+
+	var req inviteMemberRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	role, _ := entities.ParseMemberRole(req.Role)
+
+	err = h.s.Invite(c.Context(), campaign.Title, services.InviteMemberRequest{
+		CampaignID: campaign.ID,
+		InvitedBy:  int32(c.Locals("userID").(int)),
+		Email:      req.Email,
+		Role:       role,
+	})
+
+	if errors.Is(err, services.ErrAlreadyMember) {
+		return c.Status(fiber.StatusConflict).JSON(
+			response.NewErrorResponse("error", "Failed to invite member", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to invite member", err.Error()),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Invitation sent successfully", nil),
+	)
+}
+
+func (h *memberHandler) Update(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanManageMembers)
+	if campaign == nil {
+		return resp
+	}
+
+	memberID, err := strconv.Atoi(c.Params("memberId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid member ID", "Member ID must be a valid integer"),
+		)
+	}
+
+	var req updateMemberRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err = req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	role, _ := entities.ParseMemberRole(req.Role)
+
+	if err := h.s.UpdateRole(c.Context(), campaign.ID, int32(memberID), role); err != nil {
+		return c.Status(memberErrorStatus(err)).JSON(
+			response.NewErrorResponse("error", "Failed to update member", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Member updated successfully", nil),
+	)
+}
+
+func (h *memberHandler) Delete(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanManageMembers)
+	if campaign == nil {
+		return resp
+	}
+
+	memberID, err := strconv.Atoi(c.Params("memberId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid member ID", "Member ID must be a valid integer"),
+		)
+	}
+
+	if err := h.s.RemoveMember(c.Context(), campaign.ID, int32(memberID)); err != nil {
+		return c.Status(memberErrorStatus(err)).JSON(
+			response.NewErrorResponse("error", "Failed to remove member", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Member removed successfully", nil),
+	)
+}
+
+// Invitations lists the pending invitations of the current user.
+func (h *memberHandler) Invitations(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	invitations, err := h.s.GetInvitations(c.Context(), int32(userID))
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Invitations retrieved successfully", invitations),
+	)
+}
+
+func (h *memberHandler) Accept(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	if err := h.s.AcceptInvitation(c.Context(), int32(userID), c.Params("token")); err != nil {
+		return c.Status(memberErrorStatus(err)).JSON(
+			response.NewErrorResponse("error", "Failed to accept invitation", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Invitation accepted successfully", nil),
+	)
+}
+
+func (h *memberHandler) Decline(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	if err := h.s.DeclineInvitation(c.Context(), int32(userID), c.Params("token")); err != nil {
+		return c.Status(memberErrorStatus(err)).JSON(
+			response.NewErrorResponse("error", "Failed to decline invitation", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Invitation declined successfully", nil),
+	)
+}
+
+func memberErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrMemberNotFound), errors.Is(err, services.ErrInvitationNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(err, services.ErrInvitationEmailMismatch):
+		return fiber.StatusForbidden
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+// memberCampaign loads the campaign from the :id param when the current user
+// is a member of it, allowed checks the member role and nil accepts every
+// role. When the campaign is nil the error response has been written and the
+// second value is what the handler should return.
+func memberCampaign(c *fiber.Ctx, campaigns *services.UserCampaignService, allowed func(entities.MemberRole) bool) (*sqlc.GetUserCampaignByIdRow, error) {
+	userID := c.Locals("userID").(int)
+	campaignID, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid campaign ID", "Campaign ID must be a valid integer"),
+		)
+	}
+
+	campaign, err := campaigns.FindUserCampaign(c.Context(), int32(userID), int32(campaignID))
+
+	if err != nil {
+		return nil, c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if allowed != nil && !allowed(entities.MemberRole(campaign.MemberRole)) {
+		return nil, c.Status(fiber.StatusForbidden).JSON(
+			response.NewErrorResponse("error", "Forbidden", "Your role can't perform this action"),
+		)
+	}
+
+	return campaign, nil
+}
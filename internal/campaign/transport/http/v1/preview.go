@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type previewHandler struct {
+	s         *services.PreviewService
+	campaigns *services.UserCampaignService
+}
+
+func NewPreviewHandler(s *services.PreviewService, campaigns *services.UserCampaignService) *previewHandler {
+	return &previewHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+func (h *previewHandler) Index(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	previews, err := h.s.GetPreviews(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Preview links retrieved successfully", previews),
+	)
+}
+
+// Create generates a new preview link, the body is optional.
+func (h *previewHandler) Create(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	req := createPreviewRequest{ExpiresInHours: 72}
+
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				response.NewErrorResponse("error", "Invalid request body", err.Error()),
+			)
+		}
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	preview, err := h.s.Create(c.Context(), services.CreatePreviewRequest{
+		CampaignID: campaign.ID,
+		UserID:     int32(userID),
+		ExpiresIn:  time.Duration(req.ExpiresInHours) * time.Hour,
+	})
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to create preview link", err.Error()),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Preview link created successfully", preview),
+	)
+}
+
+// Delete revokes a preview link, it stops working right away.
+func (h *previewHandler) Delete(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	previewID, err := strconv.Atoi(c.Params("previewId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid preview ID", "Preview ID must be a valid integer"),
+		)
+	}
+
+	err = h.s.Revoke(c.Context(), campaign.ID, int32(previewID))
+
+	if errors.Is(err, services.ErrPreviewNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Preview link not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to revoke preview link", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Preview link revoked successfully", nil),
+	)
+}
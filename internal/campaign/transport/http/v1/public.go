@@ -1,15 +1,19 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"slices"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/shopspring/decimal"
 	"go-campaign.com/internal/campaign/entities"
 	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/campaign/services/repository"
 	"go-campaign.com/internal/config"
+	"go-campaign.com/internal/shared/http/request"
 	"go-campaign.com/internal/shared/http/response"
 	"go-campaign.com/internal/shared/services/payment"
 	"go-campaign.com/pkg/validation"
@@ -32,14 +36,86 @@ func NewPublicHandler(
 }
 
 func (h *publicHandler) Index(c *fiber.Ctx) error {
-	page := c.QueryInt("page", 1)
-	perPage := c.QueryInt("per_page", 10)
+	page, perPage := parsePage(c)
 
-	campaigns, totalCount, err := h.s.GetCampaigns(
-		c.Context(),
-		(int32(page)-1)*int32(perPage),
-		int32(perPage),
-	)
+	var listRequest campaignListRequest
+
+	if err := c.QueryParser(&listRequest); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Invalid query parameters",
+				err.Error(),
+			),
+		)
+	}
+
+	err := listRequest.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	cursor, cursorMode, err := parseCursor(c)
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid cursor", err.Error()),
+		)
+	}
+
+	listReq := services.GetCampaignListRequest{
+		Query:       listRequest.Query,
+		Sort:        listRequest.Sort,
+		Category:    listRequest.Category,
+		ProgressMin: listRequest.ProgressMin,
+		ProgressMax: listRequest.ProgressMax,
+		TargetMin:   listRequest.TargetMin,
+		TargetMax:   listRequest.TargetMax,
+		Province:    listRequest.Province,
+		City:        listRequest.City,
+		Latitude:    listRequest.Lat,
+		Longitude:   listRequest.Lng,
+		RadiusKm:    listRequest.RadiusKm,
+		Offset:      (int32(page) - 1) * int32(perPage),
+		Limit:       int32(perPage),
+		Cursor:      cursor,
+	}
+
+	if cursorMode {
+		campaigns, err := h.s.GetCampaignsByCursor(c.Context(), listReq)
+
+		if errors.Is(err, request.ErrInvalidCursor) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				response.NewErrorResponse("error", "Invalid cursor", err.Error()),
+			)
+		}
+
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				response.NewErrorResponse("error", "Internal server error", err.Error()),
+			)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(
+			response.NewCursorPagination(
+				"success",
+				"Campaigns retrieved successfully",
+				campaigns.Items,
+				response.NewCursorMeta(perPage, campaigns.NextCursor(), campaigns.PrevCursor()),
+			),
+		)
+	}
+
+	campaigns, totalCount, err := h.s.GetCampaigns(c.Context(), listReq)
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -82,6 +158,17 @@ func (h *publicHandler) Show(c *fiber.Ctx) error {
 
 	campaign, err := h.s.GetCampaignBySlug(c.Context(), slug)
 	if err != nil {
+		// a renamed campaign moves permanently to its new slug
+		if current, _ := h.s.FindRedirectedSlug(c.Context(), slug); current != "" {
+			location := strings.TrimSuffix(c.Path(), slug) + current
+
+			if query := c.Request().URI().QueryString(); len(query) > 0 {
+				location += "?" + string(query)
+			}
+
+			return c.Redirect(location, fiber.StatusMovedPermanently)
+		}
+
 		return c.Status(fiber.StatusNotFound).JSON(
 			response.NewErrorResponse(
 				"error",
@@ -100,6 +187,41 @@ func (h *publicHandler) Show(c *fiber.Ctx) error {
 	)
 }
 
+// Preview shows a campaign, drafts included, through a preview link. Preview
+// links are read-only, donating still goes through the published slug.
+func (h *publicHandler) Preview(c *fiber.Ctx) error {
+	campaign, err := h.s.GetCampaignPreview(c.Context(), c.Params("token"))
+	if errors.Is(err, services.ErrPreviewNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Preview not found",
+				"The preview link is invalid, expired or revoked",
+			),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Internal server error",
+				err.Error(),
+			),
+		)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	return c.Status(200).JSON(
+		response.NewResponse(
+			"success",
+			"Campaign preview retrieved successfully",
+			campaign,
+		),
+	)
+}
+
 func (h *publicHandler) Donate(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(int)
 
@@ -136,6 +258,16 @@ func (h *publicHandler) Donate(c *fiber.Ctx) error {
 		)
 	}
 
+	if campaign.Suspended {
+		return c.Status(fiber.StatusForbidden).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Campaign is suspended",
+				entities.SuspensionNotice,
+			),
+		)
+	}
+
 	if campaign.Status != int32(entities.StatusActive) {
 		return c.Status(fiber.StatusBadRequest).JSON(
 			response.NewErrorResponse(
@@ -173,14 +305,35 @@ func (h *publicHandler) Donate(c *fiber.Ctx) error {
 	}
 
 	url, err := h.s.Donate(c.Context(), services.DonationRequest{
-		CampaignID: campaign.ID,
-		UserID:     int32(userID),
-		Amount:     decimal.NewFromFloat32(donationRequest.Amount),
-		Name:       donationRequest.Name,
-		Email:      donationRequest.Email,
-		Note:       &donationRequest.Note,
+		CampaignID:   campaign.ID,
+		UserID:       int32(userID),
+		Amount:       decimal.NewFromFloat32(donationRequest.Amount),
+		Name:         donationRequest.Name,
+		Email:        donationRequest.Email,
+		Note:         &donationRequest.Note,
+		RewardTierID: donationRequest.RewardTierID,
+		Shipping:     donationRequest.Shipping.toShippingAddress(),
+		Anonymous:    donationRequest.Anonymous,
+		Fundraiser:   donationRequest.Fundraiser,
+		Dedication:   donationRequest.Dedication.toDedication(),
 	})
 
+	if rewardErr, ok := rewardError(err); ok {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError{
+				"reward_tier_id": rewardErr.Error(),
+			}),
+		)
+	}
+
+	if errors.Is(err, repository.ErrFundraiserNotFound) {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError{
+				"fundraiser": err.Error(),
+			}),
+		)
+	}
+
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(
 			response.NewErrorResponse(
@@ -213,11 +366,11 @@ func (h *publicHandler) XenditWebhookCallback(c *fiber.Ctx) error {
 	_, exists := payment.MapPaymentStatus[webhookEvent.Status]
 
 	if !exists {
-		return fmt.Errorf("payment status is invalid: %w", webhookEvent.Status)
+		return fmt.Errorf("payment status is invalid: %s", webhookEvent.Status)
 	}
 
 	if err := h.s.UpdatePaymentFromCallback(c.Context(), webhookEvent); err != nil {
-		log.Printf("Error updating payment from callback: %w", err)
+		log.Printf("Error updating payment from callback: %v", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(
 			response.NewErrorResponse(
 				"error",
@@ -248,21 +401,49 @@ func (h *publicHandler) Donatur(c *fiber.Ctx) error {
 		)
 	}
 
-	page := c.QueryInt("page", 1)
-	if page <= 0 {
-		page = 1
-	}
+	page, perPage := parsePage(c)
 
-	perPage := c.QueryInt("per_page", 10)
-	if perPage <= 0 {
-		perPage = 10
+	cursor, cursorMode, err := parseCursor(c)
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid cursor", err.Error()),
+		)
 	}
 
-	donaturs, totalCount, err := h.s.GetDonatur(c.Context(), services.GetDonaturListRequest{
+	listReq := services.GetDonaturListRequest{
 		Slug:   slug,
 		Limit:  int32(perPage),
 		Offset: int32((page - 1) * perPage),
-	})
+		Cursor: cursor,
+	}
+
+	if cursorMode {
+		donaturs, err := h.s.GetDonaturByCursor(c.Context(), listReq)
+
+		if errors.Is(err, request.ErrInvalidCursor) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				response.NewErrorResponse("error", "Invalid cursor", err.Error()),
+			)
+		}
+
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				response.NewErrorResponse("error", "Internal server error", err.Error()),
+			)
+		}
+
+		return c.Status(200).JSON(
+			response.NewCursorPagination(
+				"success",
+				"Donaturs retrieved successfully",
+				donaturs.Items,
+				response.NewCursorMeta(perPage, donaturs.NextCursor(), donaturs.PrevCursor()),
+			),
+		)
+	}
+
+	donaturs, totalCount, err := h.s.GetDonatur(c.Context(), listReq)
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type recommendationHandler struct {
+	s *services.RecommendationService
+}
+
+func NewRecommendationHandler(s *services.RecommendationService) *recommendationHandler {
+	return &recommendationHandler{
+		s: s,
+	}
+}
+
+// Similar suggests active campaigns related to a campaign.
+func (h *recommendationHandler) Similar(c *fiber.Ctx) error {
+	req := similarCampaignsRequest{Limit: 6}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	campaigns, err := h.s.Similar(c.Context(), c.Params("slug"), req.Limit)
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	// similarities only change when they are recomputed
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Similar campaigns retrieved successfully", campaigns),
+	)
+}
@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type reportHandler struct {
+	s *services.ReportService
+	// keySecret keys the hash of the reporter's IP
+	keySecret []byte
+}
+
+func NewReportHandler(s *services.ReportService, keySecret string) *reportHandler {
+	return &reportHandler{
+		s:         s,
+		keySecret: []byte(keySecret),
+	}
+}
+
+// Report lets any visitor flag a campaign, repeated reports of the same
+// visitor are accepted but not counted again.
+func (h *reportHandler) Report(c *fiber.Ctx) error {
+	var req reportCampaignRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	// the address is hashed so reports don't keep the visitor's IP around, the
+	// secret key stops the hash from being reversed by trying every address
+	mac := hmac.New(sha256.New, h.keySecret)
+	mac.Write([]byte(c.IP()))
+
+	created, err := h.s.Report(c.Context(), services.ReportCampaignRequest{
+		Slug:        c.Params("slug"),
+		ReporterKey: hex.EncodeToString(mac.Sum(nil)),
+		Category:    req.Category,
+		Description: req.Description,
+	})
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to report campaign", err.Error()),
+		)
+	}
+
+	if !created {
+		return c.Status(200).JSON(
+			response.NewResponse("success", "You have already reported this campaign", nil),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Thank you, the campaign has been reported", nil),
+	)
+}
+
+// Index lists the campaigns with open reports for the admins to triage.
+func (h *reportHandler) Index(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	perPage := c.QueryInt("per_page", 10)
+
+	campaigns, total, err := h.s.GetReportedCampaigns(c.Context(), int32(perPage), int32((page-1)*perPage))
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(response.NewPagination(
+		"success",
+		"Reported campaigns retrieved successfully",
+		campaigns,
+		response.NewMeta(page, perPage, int(total)),
+	))
+}
+
+func (h *reportHandler) Show(c *fiber.Ctx) error {
+	campaignID, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid campaign ID", "Campaign ID must be a valid integer"),
+		)
+	}
+
+	reports, err := h.s.GetCampaignReports(c.Context(), int32(campaignID))
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reports retrieved successfully", reports),
+	)
+}
+
+// Resolve closes the open reports of a campaign, suspend upholds them and
+// dismiss reinstates the campaign.
+func (h *reportHandler) Resolve(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+	campaignID, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid campaign ID", "Campaign ID must be a valid integer"),
+		)
+	}
+
+	var req resolveReportsRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err = req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	err = h.s.Resolve(c.Context(), services.ResolveReportsRequest{
+		CampaignID: int32(campaignID),
+		AdminID:    int32(userID),
+		Suspend:    req.Action == "suspend",
+		Note:       req.Note,
+	})
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to resolve reports", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reports resolved successfully", nil),
+	)
+}
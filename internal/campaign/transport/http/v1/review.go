@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type reviewHandler struct {
+	s *services.ReviewService
+}
+
+func NewReviewHandler(s *services.ReviewService) *reviewHandler {
+	return &reviewHandler{
+		s: s,
+	}
+}
+
+// Index lists the campaigns waiting for an admin review.
+func (h *reviewHandler) Index(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	perPage := c.QueryInt("per_page", 10)
+
+	campaigns, total, err := h.s.GetReviewQueue(c.Context(), int32(perPage), int32((page-1)*perPage))
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(response.NewPagination(
+		"success",
+		"Review queue retrieved successfully",
+		campaigns,
+		response.NewMeta(page, perPage, int(total)),
+	))
+}
+
+func (h *reviewHandler) Approve(c *fiber.Ctx) error {
+	return h.review(c, true)
+}
+
+func (h *reviewHandler) Reject(c *fiber.Ctx) error {
+	return h.review(c, false)
+}
+
+func (h *reviewHandler) review(c *fiber.Ctx, approved bool) error {
+	userID := c.Locals("userID").(int)
+	campaignID, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid campaign ID", "Campaign ID must be a valid integer"),
+		)
+	}
+
+	var req reviewCampaignRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err = req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	err = h.s.Review(c.Context(), services.ReviewCampaignRequest{
+		CampaignID: int32(campaignID),
+		ReviewerID: int32(userID),
+		Approved:   approved,
+		Reason:     req.Reason,
+	})
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if errors.Is(err, services.ErrCampaignNotPendingReview) {
+		return c.Status(fiber.StatusConflict).JSON(
+			response.NewErrorResponse("error", "Failed to review campaign", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to review campaign", err.Error()),
+		)
+	}
+
+	message := "Campaign rejected successfully"
+
+	if approved {
+		message = "Campaign approved successfully"
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", message, nil),
+	)
+}
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+// Revisions lists the saved revisions of a campaign, every member can see
+// them.
+func (h *handler) Revisions(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.s, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	revisions, err := h.s.GetRevisions(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Revisions retrieved successfully", revisions),
+	)
+}
+
+func (h *handler) Revision(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.s, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	revision, err := strconv.Atoi(c.Params("revision"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid revision", "Revision must be a valid integer"),
+		)
+	}
+
+	rev, err := h.s.GetRevision(c.Context(), campaign.ID, int32(revision))
+
+	if err != nil {
+		return revisionError(c, err)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Revision retrieved successfully", rev),
+	)
+}
+
+// RevisionDiff compares two revisions field by field.
+func (h *handler) RevisionDiff(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.s, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	var req revisionDiffRequest
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	diff, err := h.s.DiffRevisions(c.Context(), campaign.ID, req.From, req.To)
+
+	if err != nil {
+		return revisionError(c, err)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Revision diff retrieved successfully", diff),
+	)
+}
+
+// Revert restores the content of an older revision, the revert itself is
+// saved as a new revision.
+func (h *handler) Revert(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+
+	campaign, resp := memberCampaign(c, h.s, entities.MemberRole.CanEdit)
+	if campaign == nil {
+		return resp
+	}
+
+	revision, err := strconv.Atoi(c.Params("revision"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid revision", "Revision must be a valid integer"),
+		)
+	}
+
+	updated, err := h.s.RevertCampaign(c.Context(), campaign, int32(revision), int32(userID))
+
+	var fieldErrs services.FieldErrors
+
+	if errors.As(err, &fieldErrs) {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError(fieldErrs)),
+		)
+	}
+
+	if err != nil {
+		return revisionError(c, err)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Campaign reverted successfully", updated),
+	)
+}
+
+func revisionError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, services.ErrRevisionNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Revision not found", err.Error()),
+		)
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		response.NewErrorResponse("error", "Internal server error", err.Error()),
+	)
+}
@@ -0,0 +1,280 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+// rewardErrors are the reward problems a donor can fix in the request.
+var rewardErrors = []error{
+	repository.ErrRewardTierNotFound,
+	repository.ErrRewardTierSoldOut,
+	repository.ErrRewardAmountTooLow,
+	repository.ErrShippingAddressRequired,
+}
+
+func rewardError(err error) (error, bool) {
+	for _, rewardErr := range rewardErrors {
+		if errors.Is(err, rewardErr) {
+			return rewardErr, true
+		}
+	}
+
+	return nil, false
+}
+
+type rewardTierHandler struct {
+	s         *services.RewardTierService
+	campaigns *services.UserCampaignService
+}
+
+func NewRewardTierHandler(s *services.RewardTierService, campaigns *services.UserCampaignService) *rewardTierHandler {
+	return &rewardTierHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+// PublicIndex lists the reward tiers of a campaign for donors.
+func (h *rewardTierHandler) PublicIndex(c *fiber.Ctx) error {
+	tiers, err := h.s.GetRewardTiersBySlug(c.Context(), c.Params("slug"))
+
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reward tiers retrieved successfully", tiers),
+	)
+}
+
+func (h *rewardTierHandler) Index(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+
+	if campaign == nil {
+		return resp
+	}
+
+	tiers, err := h.s.GetRewardTiers(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reward tiers retrieved successfully", tiers),
+	)
+}
+
+func (h *rewardTierHandler) Create(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+
+	if campaign == nil {
+		return resp
+	}
+
+	req, resp := parseRewardTierRequest(c)
+
+	if req == nil {
+		return resp
+	}
+
+	tier, err := h.s.CreateRewardTier(c.Context(), campaign.ID, *req)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Failed to create reward tier", err.Error()),
+		)
+	}
+
+	return c.Status(201).JSON(
+		response.NewResponse("success", "Reward tier created successfully", tier),
+	)
+}
+
+func (h *rewardTierHandler) Update(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+
+	if campaign == nil {
+		return resp
+	}
+
+	tierID, err := strconv.Atoi(c.Params("rewardId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid reward tier ID", "Reward tier ID must be a valid integer"),
+		)
+	}
+
+	req, resp := parseRewardTierRequest(c)
+
+	if req == nil {
+		return resp
+	}
+
+	tier, err := h.s.UpdateRewardTier(c.Context(), campaign.ID, int32(tierID), *req)
+
+	if errors.Is(err, services.ErrRewardQuantityTooLow) {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError{
+				"quantity": err.Error(),
+			}),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Failed to update reward tier", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reward tier updated successfully", tier),
+	)
+}
+
+func (h *rewardTierHandler) Delete(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+
+	if campaign == nil {
+		return resp
+	}
+
+	tierID, err := strconv.Atoi(c.Params("rewardId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid reward tier ID", "Reward tier ID must be a valid integer"),
+		)
+	}
+
+	if err := h.s.DeleteRewardTier(c.Context(), campaign.ID, int32(tierID)); err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Failed to delete reward tier", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reward tier deleted successfully", nil),
+	)
+}
+
+// Fulfilments lists the claimed rewards the owner has to send out.
+func (h *rewardTierHandler) Fulfilments(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+
+	if campaign == nil {
+		return resp
+	}
+
+	page := c.QueryInt("page", 1)
+	perPage := c.QueryInt("per_page", 10)
+
+	var fulfilled *bool
+
+	if value := c.Query("fulfilled"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				response.NewErrorResponse("error", "Invalid query parameters", "fulfilled must be a boolean"),
+			)
+		}
+
+		fulfilled = &parsed
+	}
+
+	fulfilments, total, err := h.s.GetFulfilments(c.Context(), services.GetRewardFulfilmentsRequest{
+		CampaignID:   campaign.ID,
+		RewardTierID: int32(c.QueryInt("reward_tier_id", 0)),
+		Fulfilled:    fulfilled,
+		Limit:        int32(perPage),
+		Offset:       int32((page - 1) * perPage),
+	})
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(response.NewPagination(
+		"success",
+		"Reward fulfilments retrieved successfully",
+		fulfilments,
+		response.NewMeta(page, perPage, int(total)),
+	))
+}
+
+func (h *rewardTierHandler) MarkFulfilled(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, entities.MemberRole.CanEdit)
+
+	if campaign == nil {
+		return resp
+	}
+
+	rewardID, err := strconv.Atoi(c.Params("fulfilmentId"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid fulfilment ID", "Fulfilment ID must be a valid integer"),
+		)
+	}
+
+	if err := h.s.MarkFulfilled(c.Context(), campaign.ID, int32(rewardID)); err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Failed to mark reward as fulfilled", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Reward marked as fulfilled", nil),
+	)
+}
+
+// parseRewardTierRequest works like memberCampaign, a nil request means the
+// error response has been written.
+func parseRewardTierRequest(c *fiber.Ctx) (*services.RewardTierRequest, error) {
+	var req rewardTierRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid request body", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return nil, c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return nil, c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	return &services.RewardTierRequest{
+		Title:            req.Title,
+		Description:      req.Description,
+		MinAmount:        decimal.NewFromFloat(req.MinAmount).Round(2),
+		Quantity:         req.Quantity,
+		RequiresShipping: req.RequiresShipping,
+	}, nil
+}
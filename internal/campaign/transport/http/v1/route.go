@@ -1,11 +1,34 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"go-campaign.com/internal/shared/http/middleware"
 )
 
-func RegisterRoute(router fiber.Router, userHandler *handler, publicHandler *publicHandler) error {
+func RegisterRoute(
+	router fiber.Router,
+	userHandler *handler,
+	publicHandler *publicHandler,
+	rewardTierHandler *rewardTierHandler,
+	memberHandler *memberHandler,
+	reviewHandler *reviewHandler,
+	reportHandler *reportHandler,
+	analyticsHandler *analyticsHandler,
+	exportHandler *exportHandler,
+	previewHandler *previewHandler,
+	widgetHandler *widgetHandler,
+	shareHandler *shareHandler,
+	campaignUpdateHandler *campaignUpdateHandler,
+	leaderboardHandler *leaderboardHandler,
+	fundraiserHandler *fundraiserHandler,
+	matchingPoolHandler *matchingPoolHandler,
+	statsHandler *statsHandler,
+	recommendationHandler *recommendationHandler,
+	donationStreamHandler *donationStreamHandler,
+	isAdmin middleware.IsAdminFunc,
+) error {
 	routeGroup := router.Group("/user/campaigns", middleware.Protected(), middleware.ExtractToken)
 
 	routeGroup.Get(
@@ -19,6 +42,78 @@ func RegisterRoute(router fiber.Router, userHandler *handler, publicHandler *pub
 	routeGroup.Post("/", userHandler.Create)
 	routeGroup.Get("/:id", userHandler.Show)
 	routeGroup.Put("/:id", userHandler.Update)
+	routeGroup.Put("/:id/milestones", userHandler.ReplaceMilestones)
+	routeGroup.Get("/:id/revisions", userHandler.Revisions)
+	routeGroup.Get("/:id/revisions/diff", userHandler.RevisionDiff)
+	routeGroup.Get("/:id/revisions/:revision", userHandler.Revision)
+	routeGroup.Post("/:id/revisions/:revision/revert", userHandler.Revert)
+	routeGroup.Get("/:id/analytics", analyticsHandler.Show)
+	routeGroup.Get("/:id/donations/export", exportHandler.Donations)
+	routeGroup.Get("/:id/share", shareHandler.Show)
+
+	routeGroup.Get("/:id/rewards", rewardTierHandler.Index)
+	routeGroup.Post("/:id/rewards", rewardTierHandler.Create)
+	routeGroup.Get(
+		"/:id/rewards/fulfilments",
+		middleware.PaginationQueryNormalizer(middleware.QueryNormalization{
+			"page":     1,
+			"per_page": 10,
+		}),
+		rewardTierHandler.Fulfilments,
+	)
+	routeGroup.Put("/:id/rewards/fulfilments/:fulfilmentId", rewardTierHandler.MarkFulfilled)
+	routeGroup.Put("/:id/rewards/:rewardId", rewardTierHandler.Update)
+	routeGroup.Delete("/:id/rewards/:rewardId", rewardTierHandler.Delete)
+
+	routeGroup.Get("/:id/updates", campaignUpdateHandler.Index)
+	routeGroup.Post("/:id/updates", campaignUpdateHandler.Create)
+	routeGroup.Delete("/:id/updates/:updateId", campaignUpdateHandler.Delete)
+
+	routeGroup.Get("/:id/matching-pools", matchingPoolHandler.Index)
+	routeGroup.Post("/:id/matching-pools", matchingPoolHandler.Create)
+	routeGroup.Delete("/:id/matching-pools/:poolId", matchingPoolHandler.Delete)
+	routeGroup.Get("/:id/matching-pools/:poolId/statement", matchingPoolHandler.Statement)
+
+	routeGroup.Get("/:id/previews", previewHandler.Index)
+	routeGroup.Post("/:id/previews", previewHandler.Create)
+	routeGroup.Delete("/:id/previews/:previewId", previewHandler.Delete)
+
+	routeGroup.Get("/:id/members", memberHandler.Index)
+	routeGroup.Post("/:id/members", memberHandler.Invite)
+	routeGroup.Put("/:id/members/:memberId", memberHandler.Update)
+	routeGroup.Delete("/:id/members/:memberId", memberHandler.Delete)
+
+	userFundraisers := router.Group("/user/fundraisers", middleware.Protected(), middleware.ExtractToken)
+	userFundraisers.Get("/", fundraiserHandler.Index)
+	userFundraisers.Put("/:id", fundraiserHandler.Update)
+	userFundraisers.Delete("/:id", fundraiserHandler.Delete)
+
+	invitations := router.Group("/user/invitations", middleware.Protected(), middleware.ExtractToken)
+	invitations.Get("/", memberHandler.Invitations)
+	invitations.Post("/:token/accept", memberHandler.Accept)
+	invitations.Post("/:token/decline", memberHandler.Decline)
+
+	admin := router.Group("/admin/campaigns", middleware.Protected(), middleware.ExtractToken, middleware.Admin(isAdmin))
+	admin.Get(
+		"/reviews",
+		middleware.PaginationQueryNormalizer(middleware.QueryNormalization{
+			"page":     1,
+			"per_page": 10,
+		}),
+		reviewHandler.Index,
+	)
+	admin.Post("/:id/approve", reviewHandler.Approve)
+	admin.Post("/:id/reject", reviewHandler.Reject)
+	admin.Get(
+		"/reports",
+		middleware.PaginationQueryNormalizer(middleware.QueryNormalization{
+			"page":     1,
+			"per_page": 10,
+		}),
+		reportHandler.Index,
+	)
+	admin.Get("/:id/reports", reportHandler.Show)
+	admin.Post("/:id/reports/resolve", reportHandler.Resolve)
 
 	publicCampaign := router.Group("/campaigns")
 	publicCampaign.Get(
@@ -29,11 +124,29 @@ func RegisterRoute(router fiber.Router, userHandler *handler, publicHandler *pub
 		}),
 		publicHandler.Index,
 	)
+	// registered before /:slug, which would match it otherwise
+	publicCampaign.Get("/trending", leaderboardHandler.Trending)
 	publicCampaign.Get("/:slug", publicHandler.Show)
+	publicCampaign.Get("/previews/:token", publicHandler.Preview)
+	publicCampaign.Post("/matching-pools/:token/confirm", middleware.LimitPerIP(10, time.Hour), matchingPoolHandler.Confirm)
 	publicCampaign.Post("/:slug/donate", middleware.Protected(), middleware.ExtractToken, publicHandler.Donate)
 	publicCampaign.Get("/:slug/donaturs", publicHandler.Donatur)
+	publicCampaign.Get("/:slug/leaderboard", leaderboardHandler.TopDonors)
+	publicCampaign.Get("/:slug/similar", recommendationHandler.Similar)
+	publicCampaign.Get("/:slug/stream", donationStreamHandler.Stream)
+	publicCampaign.Get("/:slug/fundraisers", fundraiserHandler.Leaderboard)
+	publicCampaign.Post("/:slug/fundraisers", middleware.Protected(), middleware.ExtractToken, fundraiserHandler.Create)
+	publicCampaign.Get("/:slug/rewards", rewardTierHandler.PublicIndex)
+	publicCampaign.Get("/:slug/updates", campaignUpdateHandler.PublicIndex)
+	publicCampaign.Get("/:slug/widget.svg", widgetHandler.SVG)
+	publicCampaign.Get("/:slug/widget.html", widgetHandler.HTML)
+	publicCampaign.Get("/:slug/qr.png", shareHandler.QRCode)
+	publicCampaign.Post("/:slug/report", middleware.LimitPerIP(5, time.Hour), reportHandler.Report)
 
 	publicCampaign.Post("/xendit/callback", publicHandler.XenditWebhookCallback)
 
+	router.Get("/fundraisers/:slug", fundraiserHandler.Show)
+	router.Get("/stats", statsHandler.Show)
+
 	return nil
 }
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type shareHandler struct {
+	s         *services.ShareService
+	campaigns *services.UserCampaignService
+}
+
+func NewShareHandler(s *services.ShareService, campaigns *services.UserCampaignService) *shareHandler {
+	return &shareHandler{
+		s:         s,
+		campaigns: campaigns,
+	}
+}
+
+// Show returns the page, short link and QR code URLs of the campaign along
+// with the clicks its short link got.
+func (h *shareHandler) Show(c *fiber.Ctx) error {
+	campaign, resp := memberCampaign(c, h.campaigns, nil)
+	if campaign == nil {
+		return resp
+	}
+
+	links, err := h.s.GetLinks(c.Context(), campaign.ID, campaign.Slug)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Share links retrieved successfully", links),
+	)
+}
+
+// QRCode renders a PNG QR code of the campaign's short link, ready to be
+// printed on posters and flyers.
+func (h *shareHandler) QRCode(c *fiber.Ctx) error {
+	req := qrCodeRequest{Scale: 8}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	png, err := h.s.QRCode(c.Context(), c.Params("slug"), req.Scale)
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	// the short link of a campaign never changes, so neither does its code
+	c.Set(fiber.HeaderCacheControl, "public, max-age=86400")
+	c.Set(fiber.HeaderContentType, "image/png")
+
+	return c.Status(200).Send(png)
+}
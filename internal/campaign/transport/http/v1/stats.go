@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+)
+
+type statsHandler struct {
+	s *services.StatsService
+}
+
+func NewStatsHandler(s *services.StatsService) *statsHandler {
+	return &statsHandler{
+		s: s,
+	}
+}
+
+// Show returns the platform-wide totals and their breakdown per category.
+func (h *statsHandler) Show(c *fiber.Ctx) error {
+	stats, err := h.s.Stats(c.Context())
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	// statistics only change when they are recomputed
+	c.Set(fiber.HeaderCacheControl, "public, max-age=60")
+
+	return c.Status(200).JSON(
+		response.NewResponse("success", "Platform statistics retrieved successfully", stats),
+	)
+}
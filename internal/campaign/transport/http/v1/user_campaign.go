@@ -1,11 +1,15 @@
 package v1
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/shopspring/decimal"
+	"go-campaign.com/internal/campaign/entities"
 	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/request"
 	"go-campaign.com/internal/shared/http/response"
 	"go-campaign.com/pkg/validation"
 )
@@ -25,19 +29,53 @@ func NewHandler(s *services.UserCampaignService) *handler {
 func (h *handler) Index(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(int)
 
-	page := c.QueryInt("page", 1)
-	perPage := c.QueryInt("per_page", 10)
+	page, perPage := parsePage(c)
 
-	title := c.Query("title", "")
+	// title is kept as an alias of q for older clients
+	query := c.Query("q", c.Query("title", ""))
 	status := c.QueryInt("status", 0)
-	campaigns, totalCount, err := h.s.GetPaginatedUserCampaigns(
-		c.Context(), services.PaginatedCampaignRequest{
-			UserID: int32(userID),
-			Limit:  int32(perPage),
-			Offset: int32((page - 1) * perPage),
-			Title:  title,
-			Status: int32(status),
-		})
+
+	cursor, cursorMode, err := parseCursor(c)
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid cursor", err.Error()),
+		)
+	}
+
+	listReq := services.PaginatedCampaignRequest{
+		UserID: int32(userID),
+		Limit:  int32(perPage),
+		Offset: int32((page - 1) * perPage),
+		Query:  query,
+		Status: int32(status),
+		Cursor: cursor,
+	}
+
+	if cursorMode {
+		campaigns, err := h.s.GetUserCampaignsByCursor(c.Context(), listReq)
+
+		if errors.Is(err, request.ErrInvalidCursor) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				response.NewErrorResponse("error", "Invalid cursor", err.Error()),
+			)
+		}
+
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				response.NewErrorResponse("error", "Internal server error", err.Error()),
+			)
+		}
+
+		return c.Status(200).JSON(response.NewCursorPagination(
+			"success",
+			"Campaigns retrieved successfully",
+			campaigns.Items,
+			response.NewCursorMeta(perPage, campaigns.NextCursor(), campaigns.PrevCursor()),
+		))
+	}
+
+	campaigns, totalCount, err := h.s.GetPaginatedUserCampaigns(c.Context(), listReq)
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -96,13 +134,24 @@ func (h *handler) Create(c *fiber.Ctx) error {
 		Title:        req.Title,
 		Description:  req.Description,
 		Slug:         req.Slug,
-		TargetAmount: req.TargetAmount,
+		TargetAmount: decimal.NewFromFloat32(req.TargetAmount),
 		StartDate:    req.StartDate,
 		EndDate:      req.EndDate,
 		Status:       int(req.Status),
 		Images:       req.Images,
+		Tags:         req.Tags,
+		Category:     req.Category,
+		Location:     req.Location.toLocation(),
 	})
 
+	var fieldErrs services.FieldErrors
+
+	if errors.As(err, &fieldErrs) {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError(fieldErrs)),
+		)
+	}
+
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(
 			response.NewErrorResponse(
@@ -127,6 +176,8 @@ func (h *handler) Create(c *fiber.Ctx) error {
 				"start_date":     campaign.StartDate.Format(time.RFC3339),
 				"end_date":       campaign.EndDate.Format(time.RFC3339),
 				"status":         campaign.Status,
+				"tags":           campaign.Tags,
+				"category":       campaign.Category,
 			},
 		),
 	)
@@ -158,6 +209,48 @@ func (h *handler) Show(c *fiber.Ctx) error {
 		)
 	}
 
+	milestones, err := h.s.GetMilestones(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Internal server error",
+				err.Error(),
+			),
+		)
+	}
+
+	location, err := h.s.GetLocation(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Internal server error",
+				err.Error(),
+			),
+		)
+	}
+
+	reviews, err := h.s.GetReviews(c.Context(), campaign.ID)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Internal server error",
+				err.Error(),
+			),
+		)
+	}
+
+	var approvedAt *time.Time
+
+	if campaign.ApprovedAt.Valid {
+		approvedAt = &campaign.ApprovedAt.Time
+	}
+
 	return c.Status(200).JSON(
 		response.NewResponse(
 			"success",
@@ -174,6 +267,13 @@ func (h *handler) Show(c *fiber.Ctx) error {
 				"end_date":       campaign.EndDate.Format("2006-01-02 15:04:05"),
 				"status":         campaign.Status,
 				"images":         campaign.Images,
+				"tags":           campaign.Tags,
+				"category":       campaign.Category,
+				"location":       location,
+				"milestones":     milestones,
+				"role":           entities.MemberRole(campaign.MemberRole).String(),
+				"approved_at":    approvedAt,
+				"reviews":        reviews,
 			},
 		),
 	)
@@ -205,6 +305,16 @@ func (h *handler) Update(c *fiber.Ctx) error {
 		)
 	}
 
+	if !entities.MemberRole(cp.MemberRole).CanEdit() {
+		return c.Status(fiber.StatusForbidden).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Forbidden",
+				"Your role can't edit this campaign",
+			),
+		)
+	}
+
 	var req updateCampaignRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(
@@ -230,18 +340,29 @@ func (h *handler) Update(c *fiber.Ctx) error {
 		)
 	}
 
-	updatedCampaign, err := h.s.UpdateCampaign(c.Context(), cp.ID, services.CreateCampaignRequest{
+	updatedCampaign, err := h.s.UpdateCampaign(c.Context(), cp, services.CreateCampaignRequest{
 		Title:        req.Title,
 		Description:  req.Description,
 		Slug:         req.Slug,
-		TargetAmount: req.TargetAmount,
+		TargetAmount: decimal.NewFromFloat32(req.TargetAmount),
 		StartDate:    req.StartDate,
 		EndDate:      req.EndDate,
 		Status:       int(req.Status),
 		UserID:       int32(userID),
 		Images:       req.Images,
+		Tags:         req.Tags,
+		Category:     req.Category,
+		Location:     req.Location.toLocation(),
 	})
 
+	var fieldErrs services.FieldErrors
+
+	if errors.As(err, &fieldErrs) {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validation.ValidationError(fieldErrs)),
+		)
+	}
+
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(
 			response.NewErrorResponse(
@@ -261,6 +382,97 @@ func (h *handler) Update(c *fiber.Ctx) error {
 	)
 }
 
+func (h *handler) ReplaceMilestones(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(int)
+	campaignID, err := strconv.Atoi(c.Params("id"))
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Invalid campaign ID",
+				"Campaign ID must be a valid integer",
+			),
+		)
+	}
+
+	campaign, err := h.s.FindUserCampaign(c.Context(), int32(userID), int32(campaignID))
+
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Campaign not found",
+				err.Error(),
+			),
+		)
+	}
+
+	if !entities.MemberRole(campaign.MemberRole).CanEdit() {
+		return c.Status(fiber.StatusForbidden).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Forbidden",
+				"Your role can't edit this campaign",
+			),
+		)
+	}
+
+	var req replaceMilestonesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Invalid request body",
+				err.Error(),
+			),
+		)
+	}
+
+	err = req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	milestones := make([]services.MilestoneRequest, 0, len(req.Milestones))
+
+	for _, milestone := range req.Milestones {
+		milestones = append(milestones, services.MilestoneRequest{
+			Amount:      decimal.NewFromFloat(milestone.Amount).Round(2),
+			Description: milestone.Description,
+		})
+	}
+
+	updated, err := h.s.ReplaceMilestones(c.Context(), campaign.ID, milestones)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse(
+				"error",
+				"Failed to update milestones",
+				err.Error(),
+			),
+		)
+	}
+
+	return c.Status(200).JSON(
+		response.NewResponse(
+			"success",
+			"Milestones updated successfully",
+			updated,
+		),
+	)
+}
+
 // func (h *handler) Delete(c *fiber.Ctx) error {
 // 	userID, err := auth.ValidateToken(c.Locals("user").(*jwt.Token).Raw)
 
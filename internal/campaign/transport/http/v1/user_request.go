@@ -1,45 +1,70 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/campaign/services/repository"
+	"go-campaign.com/pkg/slug"
 	validationPkg "go-campaign.com/pkg/validation"
 )
 
+var slugFormat = validation.Match(regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)).
+	Error("must contain only lowercase letters, digits and single dashes")
+
 type createCampaignRequest struct {
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	Slug         string   `json:"slug"`
-	TargetAmount float32  `json:"target_amount"`
-	StartDate    string   `json:"start_date"`
-	EndDate      string   `json:"end_date"`
-	Status       int      `json:"status"`
-	Images       []string `json:"images"`
+	Title        string                   `json:"title"`
+	Description  string                   `json:"description"`
+	Slug         string                   `json:"slug"`
+	TargetAmount float32                  `json:"target_amount"`
+	StartDate    string                   `json:"start_date"`
+	EndDate      string                   `json:"end_date"`
+	Status       int                      `json:"status"`
+	Images       []string                 `json:"images"`
+	Tags         []string                 `json:"tags"`
+	Category     string                   `json:"category"`
+	Location     *campaignLocationRequest `json:"location"`
 }
 
 func (r *createCampaignRequest) Validate() error {
 	return validation.ValidateStruct(r,
 		validation.Field(&r.Title, validation.Required, validation.Length(3, 100)),
 		validation.Field(&r.Description, validation.Required, validation.Length(10, 500)),
-		validation.Field(&r.Slug, validation.Required, validation.Length(3, 50), validationPkg.Unique("campaigns", "slug", "", nil, "Slug already taken")),
+		// the slug is generated from the title when it is left empty
+		validation.Field(&r.Slug, validation.Length(3, slug.MaxLength), slugFormat, validationPkg.Unique("campaigns", "slug", "", nil, "Slug already taken").SoftDeletes("deleted_at")),
 		validation.Field(&r.TargetAmount, validation.Required, validation.Min(0.0)),
 		validation.Field(&r.StartDate, validation.Required, validation.Date("2006-01-02 15:04:00")),
 		validation.Field(&r.EndDate, validation.Required, validation.Date("2006-01-02 15:04:00")),
-		validation.Field(&r.Status, validation.Required, validation.In(1, 2)),
+		// new campaigns are either drafts or submitted for review
+		validation.Field(&r.Status, validation.Required, validation.In(1, 5)),
 		validation.Field(&r.Images, validation.Each(is.URL)),
+		validation.Field(&r.Tags, validation.Length(0, 10), validation.Each(validation.Length(2, 30))),
+		validation.Field(&r.Category, validation.In(campaignCategories()...)),
+		validation.Field(&r.Location),
 	)
 }
 
 type updateCampaignRequest struct {
-	ID           int      `json:"id"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	Slug         string   `json:"slug"`
-	TargetAmount float32  `json:"target_amount"`
-	StartDate    string   `json:"start_date"`
-	EndDate      string   `json:"end_date"`
-	Images       []string `json:"images"`
-	Status       int      `json:"status"`
+	ID           int                      `json:"id"`
+	Title        string                   `json:"title"`
+	Description  string                   `json:"description"`
+	Slug         string                   `json:"slug"`
+	TargetAmount float32                  `json:"target_amount"`
+	StartDate    string                   `json:"start_date"`
+	EndDate      string                   `json:"end_date"`
+	Images       []string                 `json:"images"`
+	Status       int                      `json:"status"`
+	Tags         []string                 `json:"tags"`
+	Category     string                   `json:"category"`
+	Location     *campaignLocationRequest `json:"location"`
 }
 
 func (r *updateCampaignRequest) Validate() error {
@@ -47,11 +72,392 @@ func (r *updateCampaignRequest) Validate() error {
 		validation.Field(&r.ID, validation.Required),
 		validation.Field(&r.Title, validation.Required, validation.Length(3, 100)),
 		validation.Field(&r.Description, validation.Required, validation.Length(10, 500)),
-		validation.Field(&r.Slug, validation.Required, validation.Length(3, 50), validationPkg.Unique("campaigns", "slug", "id", r.ID, "Slug already taken")),
+		// an empty slug keeps the current one
+		validation.Field(&r.Slug, validation.Length(3, slug.MaxLength), slugFormat, validationPkg.Unique("campaigns", "slug", "id", r.ID, "Slug already taken").SoftDeletes("deleted_at")),
 		validation.Field(&r.TargetAmount, validation.Required, validation.Min(0.0)),
 		validation.Field(&r.StartDate, validation.Required, validation.Date("2006-01-02 15:04:00")),
 		validation.Field(&r.EndDate, validation.Required, validation.Date("2006-01-02 15:04:00")),
-		validation.Field(&r.Status, validation.Required, validation.In(1, 2)),
+		validation.Field(&r.Status, validation.Required, validation.In(1, 2, 3, 4, 5)),
 		validation.Field(&r.Images, validation.Each(is.URL)),
+		validation.Field(&r.Tags, validation.Length(0, 10), validation.Each(validation.Length(2, 30))),
+		validation.Field(&r.Category, validation.In(campaignCategories()...)),
+		validation.Field(&r.Location),
+	)
+}
+
+type campaignLocationRequest struct {
+	Province  string   `json:"province"`
+	City      string   `json:"city"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+func (r campaignLocationRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Province, validation.Length(2, 60)),
+		validation.Field(&r.City, validation.Length(2, 60)),
+		// coordinates are given together
+		validation.Field(&r.Latitude, validation.When(r.Longitude != nil, validation.NotNil), validation.Min(-90.0), validation.Max(90.0)),
+		validation.Field(&r.Longitude, validation.When(r.Latitude != nil, validation.NotNil), validation.Min(-180.0), validation.Max(180.0)),
+	)
+}
+
+// toLocation returns nil when no location was sent.
+func (r *campaignLocationRequest) toLocation() *repository.CampaignLocation {
+	if r == nil {
+		return nil
+	}
+
+	location := &repository.CampaignLocation{
+		Latitude:  r.Latitude,
+		Longitude: r.Longitude,
+	}
+
+	if province := strings.TrimSpace(r.Province); province != "" {
+		location.Province = &province
+	}
+
+	if city := strings.TrimSpace(r.City); city != "" {
+		location.City = &city
+	}
+
+	return location
+}
+
+func campaignCategories() []any {
+	categories := make([]any, 0, len(entities.CampaignCategories))
+	for _, category := range entities.CampaignCategories {
+		categories = append(categories, category)
+	}
+
+	return categories
+}
+
+type rewardTierRequest struct {
+	Title            string  `json:"title"`
+	Description      string  `json:"description"`
+	MinAmount        float64 `json:"min_amount"`
+	Quantity         *int32  `json:"quantity"`
+	RequiresShipping bool    `json:"requires_shipping"`
+}
+
+func (r *rewardTierRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Title, validation.Required, validation.Length(3, 100)),
+		validation.Field(&r.Description, validation.Length(0, 500)),
+		validation.Field(&r.MinAmount, validation.Required, validation.Min(1.0)),
+		validation.Field(&r.Quantity, validation.NilOrNotEmpty, validation.Min(int32(1))),
+	)
+}
+
+type milestoneRequest struct {
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+}
+
+func (r milestoneRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Amount, validation.Required, validation.Min(1.0)),
+		validation.Field(&r.Description, validation.Required, validation.Length(3, 255)),
+	)
+}
+
+type replaceMilestonesRequest struct {
+	Milestones []milestoneRequest `json:"milestones"`
+}
+
+func (r *replaceMilestonesRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Milestones, validation.Length(0, 20), validation.By(uniqueMilestoneAmounts)),
+	)
+}
+
+func uniqueMilestoneAmounts(value any) error {
+	milestones, _ := value.([]milestoneRequest)
+	seen := make(map[float64]bool, len(milestones))
+
+	for _, milestone := range milestones {
+		if seen[milestone.Amount] {
+			return errors.New("milestone amounts must be unique")
+		}
+
+		seen[milestone.Amount] = true
+	}
+
+	return nil
+}
+
+type inviteMemberRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+func (r *inviteMemberRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Email, validation.Required, validation.Length(5, 100), is.Email),
+		validation.Field(&r.Role, validation.Required, validation.In("editor", "viewer")),
+	)
+}
+
+type updateMemberRequest struct {
+	Role string `json:"role"`
+}
+
+func (r *updateMemberRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Role, validation.Required, validation.In("editor", "viewer")),
+	)
+}
+
+type reviewCampaignRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (r *reviewCampaignRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Reason, validation.Required, validation.Length(10, 1000)),
+	)
+}
+
+type resolveReportsRequest struct {
+	Action string `json:"action"`
+	Note   string `json:"note"`
+}
+
+func (r *resolveReportsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Action, validation.Required, validation.In("dismiss", "suspend")),
+		validation.Field(&r.Note, validation.Required, validation.Length(10, 1000)),
+	)
+}
+
+// maxAnalyticsDays caps the analytics period so the daily series stays small.
+const maxAnalyticsDays = 366
+
+type analyticsRequest struct {
+	From string `query:"from"`
+	To   string `query:"to"`
+	Top  int32  `query:"top"`
+}
+
+func (r *analyticsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.From, validation.Date(time.DateOnly)),
+		validation.Field(&r.To, validation.Date(time.DateOnly), validation.By(r.checkPeriod)),
+		validation.Field(&r.Top, validation.Min(int32(1)), validation.Max(int32(50))),
+	)
+}
+
+func (r *analyticsRequest) checkPeriod(_ interface{}) error {
+	from, to := r.period(time.Now())
+
+	if to.Before(from) {
+		return errors.New("must not be before from")
+	}
+
+	if to.Sub(from) >= maxAnalyticsDays*24*time.Hour {
+		return fmt.Errorf("the period can't be longer than %d days", maxAnalyticsDays)
+	}
+
+	return nil
+}
+
+// period resolves the requested dates, to defaults to today and from to 29
+// days before to. Invalid dates are left to Validate.
+func (r *analyticsRequest) period(now time.Time) (time.Time, time.Time) {
+	to, err := time.Parse(time.DateOnly, r.To)
+
+	if err != nil {
+		to, _ = time.Parse(time.DateOnly, now.Format(time.DateOnly))
+	}
+
+	from, err := time.Parse(time.DateOnly, r.From)
+
+	if err != nil {
+		from = to.AddDate(0, 0, -29)
+	}
+
+	return from, to
+}
+
+type exportDonationsRequest struct {
+	Format string `query:"format"`
+	From   string `query:"from"`
+	To     string `query:"to"`
+	Status string `query:"status"`
+}
+
+func (r *exportDonationsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Format, validation.In("csv", "xlsx")),
+		validation.Field(&r.From, validation.Date(time.DateOnly)),
+		validation.Field(&r.To, validation.Date(time.DateOnly), validation.By(r.checkPeriod)),
+		validation.Field(&r.Status, validation.By(checkPaymentStatus)),
+	)
+}
+
+func (r *exportDonationsRequest) checkPeriod(_ interface{}) error {
+	from, fromErr := time.Parse(time.DateOnly, r.From)
+	to, toErr := time.Parse(time.DateOnly, r.To)
+
+	if fromErr == nil && toErr == nil && to.Before(from) {
+		return errors.New("must not be before from")
+	}
+
+	return nil
+}
+
+func checkPaymentStatus(value interface{}) error {
+	status, _ := value.(string)
+
+	if status == "" {
+		return nil
+	}
+
+	if _, ok := sqlc.ParseDonationPaymentStatus(status); !ok {
+		return errors.New("must be a valid payment status")
+	}
+
+	return nil
+}
+
+// filter converts the query into the service request, the fields are
+// expected to be valid already.
+func (r *exportDonationsRequest) filter(campaignID int32) services.ExportDonationsRequest {
+	req := services.ExportDonationsRequest{
+		CampaignID: campaignID,
+		Format:     services.ExportFormatCSV,
+	}
+
+	if r.Format != "" {
+		req.Format = services.ExportFormat(r.Format)
+	}
+
+	if from, err := time.Parse(time.DateOnly, r.From); err == nil {
+		req.From = &from
+	}
+
+	if to, err := time.Parse(time.DateOnly, r.To); err == nil {
+		req.To = &to
+	}
+
+	if status, ok := sqlc.ParseDonationPaymentStatus(r.Status); ok {
+		value := int32(status)
+		req.Status = &value
+	}
+
+	return req
+}
+
+type revisionDiffRequest struct {
+	From int32 `query:"from"`
+	To   int32 `query:"to"`
+}
+
+func (r *revisionDiffRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.From, validation.Required, validation.Min(int32(1))),
+		validation.Field(&r.To, validation.Required, validation.Min(int32(1))),
 	)
 }
+
+// maxPreviewHours keeps preview links from living longer than 30 days.
+const maxPreviewHours = 30 * 24
+
+type createPreviewRequest struct {
+	// ExpiresInHours is how long the link stays valid, 72 hours by default
+	ExpiresInHours int `json:"expires_in_hours"`
+}
+
+func (r *createPreviewRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.ExpiresInHours, validation.Min(1), validation.Max(maxPreviewHours)),
+	)
+}
+
+type campaignUpdateRequest struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+func (r *campaignUpdateRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Title, validation.Required, validation.Length(3, 255)),
+		validation.Field(&r.Body, validation.Required, validation.Length(10, 5000)),
+	)
+}
+
+type createFundraiserRequest struct {
+	Title        string  `json:"title"`
+	Slug         string  `json:"slug"`
+	Story        string  `json:"story"`
+	TargetAmount float32 `json:"target_amount"`
+}
+
+func (r *createFundraiserRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Title, validation.Required, validation.Length(3, 100)),
+		// the slug is generated from the title when it is left empty
+		validation.Field(&r.Slug, validation.Length(3, slug.MaxLength), slugFormat, validationPkg.Unique("campaign_fundraisers", "slug", "", nil, "Slug already taken").SoftDeletes("deleted_at")),
+		validation.Field(&r.Story, validation.Required, validation.Length(10, 5000)),
+		validation.Field(&r.TargetAmount, validation.Required, validation.Min(float32(1))),
+	)
+}
+
+type updateFundraiserRequest struct {
+	Title        string  `json:"title"`
+	Story        string  `json:"story"`
+	TargetAmount float32 `json:"target_amount"`
+}
+
+func (r *updateFundraiserRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Title, validation.Required, validation.Length(3, 100)),
+		validation.Field(&r.Story, validation.Required, validation.Length(10, 5000)),
+		validation.Field(&r.TargetAmount, validation.Required, validation.Min(float32(1))),
+	)
+}
+
+const maxMatchingCap = 9999999999.99
+
+type createMatchingPoolRequest struct {
+	SponsorName  string  `json:"sponsor_name"`
+	SponsorEmail string  `json:"sponsor_email"`
+	Ratio        float64 `json:"ratio"`
+	Cap          float64 `json:"cap"`
+	StartsAt     string  `json:"starts_at"`
+	EndsAt       string  `json:"ends_at"`
+}
+
+func (r *createMatchingPoolRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.SponsorName, validation.Required, validation.Length(2, 100)),
+		validation.Field(&r.SponsorEmail, validation.Required, validation.Length(5, 100), is.Email),
+		// the ratio is what the sponsor adds for every 1 donated
+		validation.Field(&r.Ratio, validation.Required, validation.Min(0.1), validation.Max(10.0)),
+		// matched_amount is a DECIMAL(12, 2)
+		validation.Field(&r.Cap, validation.Required, validation.Min(1.0), validation.Max(maxMatchingCap)),
+		validation.Field(&r.StartsAt, validation.Required, validation.Date("2006-01-02 15:04:00")),
+		validation.Field(&r.EndsAt, validation.Required, validation.Date("2006-01-02 15:04:00"), validation.By(r.checkWindow)),
+	)
+}
+
+func (r *createMatchingPoolRequest) checkWindow(_ interface{}) error {
+	startsAt, err := time.Parse(time.DateTime, r.StartsAt)
+
+	if err != nil {
+		return nil
+	}
+
+	endsAt, err := time.Parse(time.DateTime, r.EndsAt)
+
+	if err != nil {
+		return nil
+	}
+
+	if !endsAt.After(startsAt) {
+		return errors.New("must be after starts_at")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/validation"
+)
+
+type widgetHandler struct {
+	s *services.WidgetService
+}
+
+func NewWidgetHandler(s *services.WidgetService) *widgetHandler {
+	return &widgetHandler{
+		s: s,
+	}
+}
+
+// SVG renders the progress badge of a campaign for partner websites.
+func (h *widgetHandler) SVG(c *fiber.Ctx) error {
+	return h.render(c, services.WidgetSVG)
+}
+
+// HTML renders the progress widget of a campaign, meant to be embedded in
+// an iframe.
+func (h *widgetHandler) HTML(c *fiber.Ctx) error {
+	return h.render(c, services.WidgetHTML)
+}
+
+func (h *widgetHandler) render(c *fiber.Ctx, format services.WidgetFormat) error {
+	req := widgetRequest{Theme: "light", Size: "medium"}
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	widget, err := h.s.Render(c.Context(), services.WidgetRequest{
+		Slug:   c.Params("slug"),
+		Format: format,
+		Theme:  req.Theme,
+		Size:   req.Size,
+	})
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	// browsers keep the widget briefly and then revalidate it with the ETag,
+	// which is cheap while the server side copy is cached
+	c.Set(fiber.HeaderCacheControl, "public, max-age=30, stale-while-revalidate=60")
+	c.Set(fiber.HeaderETag, widget.ETag)
+	c.Set(fiber.HeaderVary, fiber.HeaderAcceptEncoding)
+
+	if c.Get(fiber.HeaderIfNoneMatch) == widget.ETag {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, widget.ContentType)
+
+	return c.Status(200).Send(widget.Body)
+}
@@ -0,0 +1,142 @@
+package web
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/shared/http/response"
+	"go-campaign.com/pkg/feed"
+	"go-campaign.com/pkg/validation"
+)
+
+type feedHandler struct {
+	s *services.FeedService
+}
+
+func NewFeedHandler(s *services.FeedService) *feedHandler {
+	return &feedHandler{
+		s: s,
+	}
+}
+
+// Campaigns serves the newest active campaigns as RSS, Atom or JSON Feed.
+func (h *feedHandler) Campaigns(c *fiber.Ctx) error {
+	req, resp := parseFeedRequest(c)
+	if req == nil {
+		return resp
+	}
+
+	f, err := h.s.CampaignsFeed(c.Context(), req.Category)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return sendFeed(c, f, feed.Format(req.Format))
+}
+
+// Updates serves the updates posted on a campaign as RSS, Atom or JSON Feed.
+func (h *feedHandler) Updates(c *fiber.Ctx) error {
+	req, resp := parseFeedRequest(c)
+	if req == nil {
+		return resp
+	}
+
+	f, err := h.s.UpdatesFeed(c.Context(), c.Params("slug"))
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			response.NewErrorResponse("error", "Campaign not found", err.Error()),
+		)
+	}
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	return sendFeed(c, f, feed.Format(req.Format))
+}
+
+func parseFeedRequest(c *fiber.Ctx) (*feedRequest, error) {
+	req := feedRequest{Format: string(feed.RSS)}
+
+	if err := c.QueryParser(&req); err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(
+			response.NewErrorResponse("error", "Invalid query parameters", err.Error()),
+		)
+	}
+
+	err := req.Validate()
+	validationErr, err := validation.ParseValidationErrors(err)
+	if err != nil {
+		return nil, c.Status(500).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	if len(validationErr) > 0 {
+		return nil, c.Status(422).JSON(
+			response.NewFailedValidationErrorResponse("error", "Validation failed", validationErr),
+		)
+	}
+
+	return &req, nil
+}
+
+// sendFeed encodes the feed and answers conditional requests, aggregators
+// poll feeds often and mostly get a 304 back.
+func sendFeed(c *fiber.Ctx, f *feed.Feed, format feed.Format) error {
+	body, err := f.Encode(format)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			response.NewErrorResponse("error", "Internal server error", err.Error()),
+		)
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	lastModified := f.Updated.UTC().Truncate(time.Second)
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderLastModified, lastModified.Format(http.TimeFormat))
+
+	if notModified(c, etag, lastModified) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, format.ContentType())
+
+	return c.Status(200).Send(body)
+}
+
+// notModified checks the validators the client sent, If-None-Match wins over
+// If-Modified-Since when both are present.
+func notModified(c *fiber.Ctx, etag string, lastModified time.Time) bool {
+	if match := c.Get(fiber.HeaderIfNoneMatch); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+
+			if tag == etag || tag == "*" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	since, err := http.ParseTime(c.Get(fiber.HeaderIfModifiedSince))
+
+	return err == nil && !lastModified.After(since)
+}
@@ -0,0 +1,63 @@
+package web
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestNotModified(t *testing.T) {
+	etag := `"3f2a"`
+	lastModified := time.Date(2024, 3, 2, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{"no validators", nil, false},
+		{"matching etag", map[string]string{"If-None-Match": `"3f2a"`}, true},
+		{"weak etag", map[string]string{"If-None-Match": `W/"3f2a"`}, true},
+		{"etag in a list", map[string]string{"If-None-Match": `"aaaa", "3f2a"`}, true},
+		{"any etag", map[string]string{"If-None-Match": `*`}, true},
+		{"other etag", map[string]string{"If-None-Match": `"aaaa"`}, false},
+		{"same date", map[string]string{"If-Modified-Since": "Sat, 02 Mar 2024 10:30:00 GMT"}, true},
+		{"later date", map[string]string{"If-Modified-Since": "Sun, 03 Mar 2024 00:00:00 GMT"}, true},
+		{"earlier date", map[string]string{"If-Modified-Since": "Sat, 02 Mar 2024 10:29:59 GMT"}, false},
+		{"invalid date", map[string]string{"If-Modified-Since": "yesterday"}, false},
+		{
+			"etag wins over the date",
+			map[string]string{"If-None-Match": `"aaaa"`, "If-Modified-Since": "Sun, 03 Mar 2024 00:00:00 GMT"},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		app := fiber.New()
+		app.Get("/", func(c *fiber.Ctx) error {
+			if notModified(c, etag, lastModified) {
+				return c.SendStatus(fiber.StatusNotModified)
+			}
+
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest("GET", "/", nil)
+
+		for k, v := range tt.headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := app.Test(req)
+
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		if got := resp.StatusCode == fiber.StatusNotModified; got != tt.want {
+			t.Errorf("%s: notModified() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package web
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/internal/campaign/services/repository"
+)
+
+// categoryPageSize is how many of the newest campaigns a category page lists
+const categoryPageSize = 50
+
+type handler struct {
+	share     *services.ShareService
+	campaigns *services.CampaignService
+	appURL    string
+}
+
+func NewHandler(share *services.ShareService, campaigns *services.CampaignService, appURL string) *handler {
+	return &handler{
+		share:     share,
+		campaigns: campaigns,
+		appURL:    appURL,
+	}
+}
+
+type categoryPage struct {
+	Category  string
+	PageURL   string
+	Campaigns []repository.CampaignList
+}
+
+// Campaign renders the landing page of a published campaign. Its OpenGraph
+// and Twitter card tags give the link a preview when it is shared.
+func (h *handler) Campaign(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+
+	page, err := h.share.LandingPage(c.Context(), slug)
+
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		// a renamed campaign moves permanently to its new slug
+		if current, _ := h.campaigns.FindRedirectedSlug(c.Context(), slug); current != "" {
+			location := strings.TrimSuffix(c.Path(), slug) + current
+
+			if query := c.Request().URI().QueryString(); len(query) > 0 {
+				location += "?" + string(query)
+			}
+
+			return c.Redirect(location, fiber.StatusMovedPermanently)
+		}
+
+		return render(c, fiber.StatusNotFound, notFoundTemplate, nil)
+	}
+
+	if err != nil {
+		log.Printf("failed to render campaign page %s: %v", slug, err)
+
+		return render(c, fiber.StatusInternalServerError, errorTemplate, nil)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age=60")
+
+	return render(c, fiber.StatusOK, campaignTemplate, page)
+}
+
+// Category lists the newest active campaigns of a category, these pages are
+// what the category sitemap points to.
+func (h *handler) Category(c *fiber.Ctx) error {
+	category := c.Params("category")
+
+	if !slices.Contains(entities.CampaignCategories, category) {
+		return render(c, fiber.StatusNotFound, notFoundTemplate, nil)
+	}
+
+	campaigns, _, err := h.campaigns.GetCampaigns(c.Context(), services.GetCampaignListRequest{
+		Sort:     repository.SortNewest,
+		Category: category,
+		Limit:    categoryPageSize,
+	})
+
+	if err != nil {
+		log.Printf("failed to render category page %s: %v", category, err)
+
+		return render(c, fiber.StatusInternalServerError, errorTemplate, nil)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+
+	return render(c, fiber.StatusOK, categoryTemplate, categoryPage{
+		Category:  category,
+		PageURL:   h.appURL + "/categories/" + category,
+		Campaigns: campaigns,
+	})
+}
+
+// ShortLink counts the click and sends the visitor to the campaign page.
+func (h *handler) ShortLink(c *fiber.Ctx) error {
+	slug, err := h.share.Visit(c.Context(), c.Params("code"))
+
+	if errors.Is(err, services.ErrShortLinkNotFound) {
+		return render(c, fiber.StatusNotFound, notFoundTemplate, nil)
+	}
+
+	if err != nil {
+		log.Printf("failed to visit short link %s: %v", c.Params("code"), err)
+
+		return render(c, fiber.StatusInternalServerError, errorTemplate, nil)
+	}
+
+	// clicks are counted on every visit, so the redirect must not be cached
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	return c.Redirect("/campaigns/"+slug, fiber.StatusFound)
+}
+
+func render(c *fiber.Ctx, status int, tmpl *template.Template, data any) error {
+	var body bytes.Buffer
+
+	if err := tmpl.Execute(&body, data); err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+
+	return c.Status(status).Send(body.Bytes())
+}
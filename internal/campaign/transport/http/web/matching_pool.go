@@ -0,0 +1,55 @@
+package web
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+)
+
+type matchingPoolHandler struct {
+	s *services.MatchingPoolService
+}
+
+func NewMatchingPoolHandler(s *services.MatchingPoolService) *matchingPoolHandler {
+	return &matchingPoolHandler{
+		s: s,
+	}
+}
+
+// Show renders the pledge the sponsor was emailed about with the button
+// confirming it.
+func (h *matchingPoolHandler) Show(c *fiber.Ctx) error {
+	pledge, err := h.s.PendingPledge(c.Context(), c.Params("token"))
+
+	if errors.Is(err, services.ErrConfirmationNotFound) {
+		return render(c, fiber.StatusNotFound, pledgeNotFoundTemplate, nil)
+	}
+
+	if err != nil {
+		log.Printf("failed to render the matching pledge: %v", err)
+
+		return render(c, fiber.StatusInternalServerError, errorTemplate, nil)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	return render(c, fiber.StatusOK, pledgeTemplate, pledge)
+}
+
+func (h *matchingPoolHandler) Confirm(c *fiber.Ctx) error {
+	err := h.s.Confirm(c.Context(), c.Params("token"))
+
+	if errors.Is(err, services.ErrConfirmationNotFound) {
+		return render(c, fiber.StatusNotFound, pledgeNotFoundTemplate, nil)
+	}
+
+	if err != nil {
+		log.Printf("failed to confirm the matching pledge: %v", err)
+
+		return render(c, fiber.StatusInternalServerError, errorTemplate, nil)
+	}
+
+	return render(c, fiber.StatusOK, pledgeConfirmedTemplate, nil)
+}
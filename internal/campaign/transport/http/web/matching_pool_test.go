@@ -0,0 +1,76 @@
+package web
+
+import (
+	"database/sql/driver"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/repository/sqlc"
+	"go-campaign.com/internal/campaign/services"
+	"go-campaign.com/pkg/mailer"
+	"go-campaign.com/pkg/sqlfake"
+)
+
+func TestMatchingPoolConfirmation(t *testing.T) {
+	db, fake := sqlfake.New()
+	start := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	pool := []driver.Value{
+		int64(1), int64(1), "PT Sponsor", "csr@sponsor.test", "1", "1000000", "0", start, start.Add(24 * time.Hour),
+		nil, nil, start, start, nil, nil, start,
+	}
+
+	fake.On("GetPendingMatchingPool", func(args []driver.Value) sqlfake.Result {
+		if args[0] != "pending" {
+			return sqlfake.Result{}
+		}
+
+		return sqlfake.Result{Rows: [][]driver.Value{{"PT Sponsor", "0.5", "1000000", start, start.Add(24 * time.Hour), "Bantu Korban Banjir"}}}
+	})
+	fake.On("ConfirmMatchingPool", func(args []driver.Value) sqlfake.Result {
+		if args[0] != "pending" {
+			return sqlfake.Result{}
+		}
+
+		return sqlfake.Result{Rows: [][]driver.Value{pool}}
+	})
+
+	app := fiber.New()
+	RegisterRoute(app, nil, nil, nil, NewMatchingPoolHandler(
+		services.NewMatchingPoolService(db, sqlc.New(db), mailer.NewLogMailer(), "https://go-campaign.com"),
+	))
+
+	tests := []struct {
+		method string
+		token  string
+		status int
+		body   string
+	}{
+		{"GET", "pending", fiber.StatusOK, `<form method="post">`},
+		{"GET", "unknown", fiber.StatusNotFound, "Pledge not found"},
+		{"POST", "pending", fiber.StatusOK, "Pledge confirmed"},
+		{"POST", "unknown", fiber.StatusNotFound, "Pledge not found"},
+	}
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(tt.method, "/matching-pools/"+tt.token, nil))
+
+		if err != nil {
+			t.Fatalf("%s %s: %v", tt.method, tt.token, err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+
+		if resp.StatusCode != tt.status || !strings.Contains(string(body), tt.body) {
+			t.Errorf("%s %s = %d %s, want %d with %q", tt.method, tt.token, resp.StatusCode, body, tt.status, tt.body)
+		}
+	}
+
+	// showing the pledge doesn't confirm it
+	if confirmed := fake.Calls("ConfirmMatchingPool"); len(confirmed) != 2 {
+		t.Errorf("ConfirmMatchingPool called %d times, want 2", len(confirmed))
+	}
+}
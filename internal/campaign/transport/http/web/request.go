@@ -0,0 +1,29 @@
+package web
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go-campaign.com/internal/campaign/entities"
+	"go-campaign.com/pkg/feed"
+)
+
+type feedRequest struct {
+	Format   string `query:"format"`
+	Category string `query:"category"`
+}
+
+func (r *feedRequest) Validate() error {
+	formats := make([]any, 0, len(feed.Formats))
+	for _, format := range feed.Formats {
+		formats = append(formats, format)
+	}
+
+	categories := make([]any, 0, len(entities.CampaignCategories))
+	for _, category := range entities.CampaignCategories {
+		categories = append(categories, category)
+	}
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Format, validation.In(formats...)),
+		validation.Field(&r.Category, validation.In(categories...)),
+	)
+}
@@ -0,0 +1,27 @@
+package web
+
+import "github.com/gofiber/fiber/v2"
+
+func RegisterRoute(
+	router fiber.Router,
+	h *handler,
+	feedHandler *feedHandler,
+	sitemapHandler *sitemapHandler,
+	matchingPoolHandler *matchingPoolHandler,
+) {
+	router.Get("/campaigns/:slug", h.Campaign)
+	router.Get("/categories/:category", h.Category)
+	router.Get("/c/:code", h.ShortLink)
+
+	// the link emailed to sponsors, confirming takes the form's POST so mail
+	// scanners following the link don't confirm the pledge
+	router.Get("/matching-pools/:token", matchingPoolHandler.Show)
+	router.Post("/matching-pools/:token", matchingPoolHandler.Confirm)
+
+	router.Get("/feeds/campaigns", feedHandler.Campaigns)
+	router.Get("/feeds/campaigns/:slug/updates", feedHandler.Updates)
+
+	router.Get("/sitemap.xml", sitemapHandler.Index)
+	router.Get("/sitemaps/categories.xml", sitemapHandler.Categories)
+	router.Get("/sitemaps/campaigns-:page.xml", sitemapHandler.Campaigns)
+}
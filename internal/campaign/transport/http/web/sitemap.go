@@ -0,0 +1,57 @@
+package web
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"go-campaign.com/internal/campaign/services"
+)
+
+type sitemapHandler struct {
+	s *services.SitemapService
+}
+
+func NewSitemapHandler(s *services.SitemapService) *sitemapHandler {
+	return &sitemapHandler{
+		s: s,
+	}
+}
+
+// Index serves /sitemap.xml, the index of the category and campaign sitemaps.
+func (h *sitemapHandler) Index(c *fiber.Ctx) error {
+	body, err := h.s.Index(c.Context())
+
+	return sendSitemap(c, body, err)
+}
+
+// Categories serves the sitemap of the category pages.
+func (h *sitemapHandler) Categories(c *fiber.Ctx) error {
+	body, err := h.s.Sitemap(c.Context(), "categories")
+
+	return sendSitemap(c, body, err)
+}
+
+// Campaigns serves one page of the campaign sitemaps.
+func (h *sitemapHandler) Campaigns(c *fiber.Ctx) error {
+	body, err := h.s.Sitemap(c.Context(), "campaigns-"+c.Params("page"))
+
+	return sendSitemap(c, body, err)
+}
+
+func sendSitemap(c *fiber.Ctx, body []byte, err error) error {
+	if errors.Is(err, services.ErrSitemapNotFound) {
+		return c.SendStatus(fiber.StatusNotFound)
+	}
+
+	if err != nil {
+		log.Printf("failed to serve sitemap %s: %v", c.Path(), err)
+
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age=3600")
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationXMLCharsetUTF8)
+
+	return c.Status(fiber.StatusOK).Send(body)
+}
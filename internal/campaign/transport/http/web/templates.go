@@ -0,0 +1,192 @@
+package web
+
+import (
+	"html/template"
+
+	"go-campaign.com/internal/campaign/services"
+)
+
+const pageStyle = `<style>
+body{margin:0;font-family:Helvetica,Arial,sans-serif;background:#f9fafb;color:#111827}
+main{box-sizing:border-box;max-width:720px;margin:0 auto;padding:24px 16px}
+img.cover{display:block;width:100%;max-height:400px;object-fit:cover;border-radius:8px}
+h1{font-size:28px;margin:24px 0 8px}
+.muted{color:#6b7280;font-size:14px}
+.track{height:10px;border-radius:5px;background:#e5e7eb;margin:16px 0 8px}
+.bar{height:10px;border-radius:5px;background:#16a34a}
+.match{padding:12px;border-radius:6px;background:#dcfce7;color:#166534;margin:12px 0}
+.notice{padding:12px;border-radius:6px;background:#fef3c7;color:#92400e;margin:16px 0}
+.description{white-space:pre-line;line-height:1.6}
+.share{margin-top:32px;padding:16px;border-radius:8px;background:#ffffff;text-align:center}
+.share a{color:#16a34a}
+ul.campaigns{list-style:none;padding:0}
+ul.campaigns li{padding:16px;margin:12px 0;border-radius:8px;background:#ffffff}
+ul.campaigns a{color:#111827;font-weight:bold;text-decoration:none}
+</style>`
+
+var campaignTemplate = template.Must(template.New("campaign.html").Funcs(template.FuncMap{
+	"rupiah": services.FormatRupiah,
+}).Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Campaign.Title}}</title>
+<meta name="description" content="{{.Description}}">
+<link rel="canonical" href="{{.Links.PageURL}}">
+<meta property="og:type" content="website">
+<meta property="og:title" content="{{.Campaign.Title}}">
+<meta property="og:description" content="{{.Description}}">
+<meta property="og:url" content="{{.Links.PageURL}}">
+{{if .ImageURL}}<meta property="og:image" content="{{.ImageURL}}">
+<meta name="twitter:card" content="summary_large_image">
+<meta name="twitter:image" content="{{.ImageURL}}">
+{{else}}<meta name="twitter:card" content="summary">
+{{end}}<meta name="twitter:title" content="{{.Campaign.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+` + pageStyle + `
+</head>
+<body>
+<main>
+{{if .ImageURL}}<img class="cover" src="{{.ImageURL}}" alt="{{.Campaign.Title}}">
+{{end}}<h1>{{.Campaign.Title}}</h1>
+<div class="muted">by {{.Campaign.UserName}} · ends {{.Campaign.EndDate.Format "2 Jan 2006"}}</div>
+{{with .Campaign.SuspensionNotice}}<div class="notice">{{.}}</div>
+{{end}}<div class="track"><div class="bar" style="width:{{.BarPercent}}%"></div></div>
+<div><strong>{{.Raised}}</strong> raised of {{.Target}} ({{.Percent}}%)</div>
+{{range .Campaign.MatchingPools}}<div class="match">{{.SponsorName}} matches every Rp 1 with Rp {{.Ratio}} until {{.EndsAt.Format "2 Jan 2006"}}, {{rupiah .Remaining}} of matching left</div>
+{{end}}{{with .Campaign.Description}}<p class="description">{{.}}</p>
+{{end}}<div class="share">
+<p>Share this campaign</p>
+<p><a href="{{.Links.ShortURL}}">{{.Links.ShortURL}}</a></p>
+<p><a href="https://wa.me/?text={{.Campaign.Title}}%20{{.Links.ShortURL}}" target="_blank" rel="noopener">Share on WhatsApp</a></p>
+<img src="{{.Links.QRCodeURL}}?scale=6" width="222" alt="QR code of {{.Links.ShortURL}}">
+</div>
+</main>
+</body>
+</html>
+`))
+
+var categoryTemplate = template.Must(template.New("category.html").Funcs(template.FuncMap{
+	"rupiah": services.FormatRupiah,
+}).Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Category}} campaigns</title>
+<meta name="description" content="Active {{.Category}} campaigns open for donations">
+<link rel="canonical" href="{{.PageURL}}">
+<meta property="og:type" content="website">
+<meta property="og:title" content="{{.Category}} campaigns">
+<meta property="og:url" content="{{.PageURL}}">
+` + pageStyle + `
+</head>
+<body>
+<main>
+<h1>{{.Category}} campaigns</h1>
+{{if .Campaigns}}<ul class="campaigns">
+{{range .Campaigns}}<li>
+<a href="/campaigns/{{.Slug}}">{{.Title}}</a>
+<div class="muted">{{rupiah .CurrentAmount}} raised of {{rupiah .TargetAmount}} · ends {{.EndDate.Format "2 Jan 2006"}}</div>
+</li>
+{{end}}</ul>
+{{else}}<p class="muted">There are no active campaigns in this category yet.</p>
+{{end}}</main>
+</body>
+</html>
+`))
+
+var notFoundTemplate = template.Must(template.New("not_found.html").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Campaign not found</title>
+` + pageStyle + `
+</head>
+<body>
+<main>
+<h1>Campaign not found</h1>
+<p class="muted">The campaign doesn't exist or is no longer available.</p>
+</main>
+</body>
+</html>
+`))
+
+var errorTemplate = template.Must(template.New("error.html").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Something went wrong</title>
+` + pageStyle + `
+</head>
+<body>
+<main>
+<h1>Something went wrong</h1>
+<p class="muted">Please try again in a moment.</p>
+</main>
+</body>
+</html>
+`))
+
+var pledgeTemplate = template.Must(template.New("pledge.html").Funcs(template.FuncMap{
+	"rupiah": services.FormatRupiah,
+}).Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Confirm your matching pledge</title>
+` + pageStyle + `
+</head>
+<body>
+<main>
+<h1>Confirm your matching pledge</h1>
+<p>The team of <strong>{{.CampaignTitle}}</strong> recorded that {{.SponsorName}} matches every Rp 1 donated with Rp {{.Ratio}}, up to {{rupiah .Cap}}, from {{.StartsAt.Format "2 Jan 2006 15:04"}} to {{.EndsAt.Format "2 Jan 2006 15:04"}}.</p>
+<p class="muted">Donations are only matched once you confirm. If you didn't make this pledge, close this page.</p>
+<form method="post"><button type="submit">Confirm the pledge</button></form>
+</main>
+</body>
+</html>
+`))
+
+var pledgeConfirmedTemplate = template.Must(template.New("pledge_confirmed.html").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Pledge confirmed</title>
+` + pageStyle + `
+</head>
+<body>
+<main>
+<h1>Pledge confirmed</h1>
+<p class="muted">Thank you, the donations paid from now on are matched. You will be emailed a statement once the pool closes.</p>
+</main>
+</body>
+</html>
+`))
+
+var pledgeNotFoundTemplate = template.Must(template.New("pledge_not_found.html").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Pledge not found</title>
+` + pageStyle + `
+</head>
+<body>
+<main>
+<h1>Pledge not found</h1>
+<p class="muted">The pledge was already confirmed or cancelled by the campaign team.</p>
+</main>
+</body>
+</html>
+`))
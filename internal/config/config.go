@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -33,19 +34,41 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("Secret key is required")
 	}
 
+	if c.App.Moderation.ReportThreshold < 1 {
+		return fmt.Errorf("REPORT SUSPEND THRESHOLD must be at least 1")
+	}
+
+	if c.App.Moderation.ReporterKeySecret == "" {
+		return fmt.Errorf("REPORTER KEY SECRET is required")
+	}
+
+	if c.App.Service.Mail.Driver == "smtp" && c.App.Service.Mail.Host == "" {
+		return fmt.Errorf("MAIL HOST is required when using the smtp mail driver")
+	}
+
 	return nil
 }
 
 type AppConfig struct {
-	Port      string
-	URL       string
-	ENV       string
-	Service   ServiceConfig
-	JwtSecret string
+	Port       string
+	URL        string
+	ENV        string
+	Service    ServiceConfig
+	JwtSecret  string
+	Moderation ModerationConfig
+}
+
+type ModerationConfig struct {
+	// ReportThreshold is the number of open reports that suspends a campaign
+	ReportThreshold int
+	// ReporterKeySecret keys the hash identifying a reporter, without it the
+	// hash of an IP address could be reversed by trying every address
+	ReporterKeySecret string
 }
 
 type ServiceConfig struct {
 	Payment PaymentConfig
+	Mail    MailConfig
 }
 
 type PaymentConfig struct {
@@ -53,6 +76,16 @@ type PaymentConfig struct {
 	SecretKey string
 }
 
+type MailConfig struct {
+	// Driver is either smtp or log, log only prints the messages
+	Driver   string
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
 type DatabaseConfig struct {
 	URL  string
 	Type string
@@ -67,11 +100,23 @@ func Load() (*Config, error) {
 			URL:       getEnv("APP_URL", ""),
 			ENV:       getEnv("APP_ENV", "development"),
 			JwtSecret: getEnv("JWT_SECRET", ""),
+			Moderation: ModerationConfig{
+				ReportThreshold:   getEnvInt("REPORT_SUSPEND_THRESHOLD", 5),
+				ReporterKeySecret: getEnv("REPORTER_KEY_SECRET", ""),
+			},
 			Service: ServiceConfig{
 				Payment: PaymentConfig{
 					Vendor:    getEnv("PAYMENT_VENDOR", "xendit"),
 					SecretKey: getEnv("PAYMENT_SECRET_KEY", ""),
 				},
+				Mail: MailConfig{
+					Driver:   getEnv("MAIL_DRIVER", "log"),
+					Host:     getEnv("MAIL_HOST", ""),
+					Port:     getEnv("MAIL_PORT", "587"),
+					Username: getEnv("MAIL_USERNAME", ""),
+					Password: getEnv("MAIL_PASSWORD", ""),
+					From:     getEnv("MAIL_FROM", "no-reply@go-campaign.com"),
+				},
 			},
 		},
 		Database: DatabaseConfig{
@@ -88,3 +133,13 @@ func getEnv(key, fallback string) string {
 
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
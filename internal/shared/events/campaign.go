@@ -0,0 +1,50 @@
+package events
+
+import "github.com/shopspring/decimal"
+
+const (
+	PaymentPaidEvent      = "payment.paid"
+	MilestoneReachedEvent = "campaign.milestone_reached"
+	CampaignReviewedEvent = "campaign.reviewed"
+)
+
+// PaymentPaid is published once a donation payment is marked PAID.
+type PaymentPaid struct {
+	PaymentID  int32
+	DonationID int32
+	DonaturID  int32
+	CampaignID int32
+	Amount     decimal.Decimal
+}
+
+func (PaymentPaid) Name() string {
+	return PaymentPaidEvent
+}
+
+// MilestoneReached is published when a paid donation pushes the campaign
+// past one of its milestones.
+type MilestoneReached struct {
+	CampaignID  int32
+	MilestoneID int32
+	Amount      decimal.Decimal
+	Description string
+	Stretch     bool
+}
+
+func (MilestoneReached) Name() string {
+	return MilestoneReachedEvent
+}
+
+// CampaignReviewed is published after an admin approved or rejected a
+// campaign waiting for review.
+type CampaignReviewed struct {
+	CampaignID int32
+	Title      string
+	OwnerEmail string
+	Approved   bool
+	Reason     string
+}
+
+func (CampaignReviewed) Name() string {
+	return CampaignReviewedEvent
+}
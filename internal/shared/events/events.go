@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// Event is anything published on the bus, Name is the key handlers subscribe
+// to.
+type Event interface {
+	Name() string
+}
+
+type Handler func(ctx context.Context, event Event)
+
+// Bus is an in-process publish/subscribe hub. Handlers run in their own
+// goroutine so a slow subscriber never holds up the publisher.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	wg       sync.WaitGroup
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Publish hands the event to every subscriber. Handlers get a background
+// context because the request that published the event may be gone by the
+// time they run.
+func (b *Bus) Publish(event Event) {
+	b.mu.RLock()
+	handlers := b.handlers[event.Name()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.wg.Add(1)
+
+		go func() {
+			defer b.wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("event handler for %s panicked: %v", event.Name(), r)
+				}
+			}()
+
+			handler(context.Background(), event)
+		}()
+	}
+}
+
+// Wait blocks until the running handlers are done, it is meant for a
+// graceful shutdown.
+func (b *Bus) Wait() {
+	b.wg.Wait()
+}
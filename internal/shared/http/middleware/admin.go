@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IsAdminFunc func(ctx context.Context, userID int) (bool, error)
+
+// Admin only lets administrators through, it has to run after ExtractToken.
+// The flag is read on every request so revoking it applies right away.
+func Admin(isAdmin IsAdminFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals("userID").(int)
+
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "No token provided",
+			})
+		}
+
+		admin, err := isAdmin(c.Context(), userID)
+
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal server error",
+				"message": err.Error(),
+			})
+		}
+
+		if !admin {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "Only administrators can access this resource",
+			})
+		}
+
+		return c.Next()
+	}
+}
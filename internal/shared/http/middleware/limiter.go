@@ -8,9 +8,14 @@ import (
 )
 
 func RateLimiter() fiber.Handler {
+	return LimitPerIP(20, 60*time.Second)
+}
+
+// LimitPerIP allows max requests per client IP within the sliding window.
+func LimitPerIP(max int, expiration time.Duration) fiber.Handler {
 	return limiter.New(limiter.Config{
-		Max:               20,
-		Expiration:        60 * time.Second,
+		Max:               max,
+		Expiration:        expiration,
 		LimiterMiddleware: limiter.SlidingWindow{},
 		KeyGenerator: func(c *fiber.Ctx) string {
 			// Use the client's IP address as the key for rate limiting
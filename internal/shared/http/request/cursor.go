@@ -0,0 +1,126 @@
+package request
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at the row a keyset page starts from. It is handed to the
+// client as an opaque string, see EncodeCursor.
+type Cursor struct {
+	// Key is the value of the sort column of the row, in its text form.
+	Key string `json:"k"`
+	// ID breaks ties between rows sharing the same Key.
+	ID int32 `json:"i"`
+	// Backward is set on cursors that walk to the previous page.
+	Backward bool `json:"b,omitempty"`
+	// Sort pins the cursor to the ordering it was created for.
+	Sort string `json:"s,omitempty"`
+}
+
+type CursorPaginationRequest struct {
+	// Cursor is nil on the first page.
+	Cursor *Cursor
+	Limit  int32
+}
+
+// CursorPage is a keyset page, Next and Prev are nil when there is no such
+// page.
+type CursorPage[T any] struct {
+	Items []T
+	Next  *Cursor
+	Prev  *Cursor
+}
+
+// Keyed pairs a row with the cursor pointing at it.
+type Keyed[T any] struct {
+	Item   T
+	Cursor Cursor
+}
+
+func EncodeCursor(c Cursor) string {
+	bytes, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+
+	if err := json.Unmarshal(bytes, &c); err != nil || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// PageByCursor turns the rows of a keyset query into a page. The query is
+// expected to fetch Limit+1 rows, in reverse order when the cursor walks
+// backward, so the extra row tells whether another page exists.
+func PageByCursor[T any](rows []Keyed[T], req CursorPaginationRequest) CursorPage[T] {
+	backward := req.Cursor != nil && req.Cursor.Backward
+	hasMore := len(rows) > int(req.Limit)
+
+	if hasMore {
+		rows = rows[:req.Limit]
+	}
+
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	page := CursorPage[T]{
+		Items: make([]T, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		page.Items = append(page.Items, row.Item)
+	}
+
+	if len(rows) == 0 {
+		return page
+	}
+
+	if (!backward && hasMore) || (backward && req.Cursor != nil) {
+		c := rows[len(rows)-1].Cursor
+		c.Backward = false
+		page.Next = &c
+	}
+
+	if (backward && hasMore) || (!backward && req.Cursor != nil) {
+		c := rows[0].Cursor
+		c.Backward = true
+		page.Prev = &c
+	}
+
+	return page
+}
+
+// NextCursor returns the encoded cursor of the next page or an empty string.
+func (p CursorPage[T]) NextCursor() string {
+	if p.Next == nil {
+		return ""
+	}
+
+	return EncodeCursor(*p.Next)
+}
+
+// PrevCursor returns the encoded cursor of the previous page or an empty
+// string.
+func (p CursorPage[T]) PrevCursor() string {
+	if p.Prev == nil {
+		return ""
+	}
+
+	return EncodeCursor(*p.Prev)
+}
@@ -0,0 +1,95 @@
+package request
+
+import (
+	"encoding/base64"
+	"slices"
+	"testing"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	valid := Cursor{Key: "2024-03-01 08:00:00", ID: 42, Backward: true, Sort: "newest"}
+
+	tests := []struct {
+		name  string
+		value string
+		want  *Cursor
+	}{
+		{"round trip", EncodeCursor(valid), &valid},
+		{"not base64", "!!!", nil},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"k":"12","i":4}`)), nil},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("k=1")), nil},
+		{"missing id", base64.RawURLEncoding.EncodeToString([]byte(`{"k":"1"}`)), nil},
+		{"wrong id type", base64.RawURLEncoding.EncodeToString([]byte(`{"k":"1","i":"4"}`)), nil},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := DecodeCursor(tt.value)
+
+		if tt.want == nil {
+			if err != ErrInvalidCursor {
+				t.Errorf("%s: DecodeCursor() error = %v, want ErrInvalidCursor", tt.name, err)
+			}
+
+			continue
+		}
+
+		if err != nil || *got != *tt.want {
+			t.Errorf("%s: DecodeCursor() = %+v, %v, want %+v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestPageByCursor(t *testing.T) {
+	keyed := func(ids ...int32) []Keyed[int32] {
+		rows := make([]Keyed[int32], 0, len(ids))
+
+		for _, id := range ids {
+			rows = append(rows, Keyed[int32]{Item: id, Cursor: Cursor{ID: id}})
+		}
+
+		return rows
+	}
+
+	tests := []struct {
+		name   string
+		rows   []Keyed[int32]
+		cursor *Cursor
+		items  []int32
+		next   int32
+		prev   int32
+	}{
+		{"single page", keyed(1, 2), nil, []int32{1, 2}, 0, 0},
+		{"first page", keyed(1, 2, 3), nil, []int32{1, 2}, 2, 0},
+		{"middle page", keyed(3, 4, 5), &Cursor{ID: 2}, []int32{3, 4}, 4, 3},
+		{"last page", keyed(5), &Cursor{ID: 4}, []int32{5}, 0, 5},
+		// backward rows come newest first and are put back in order
+		{"backward with more", keyed(4, 3, 2), &Cursor{ID: 5, Backward: true}, []int32{3, 4}, 4, 3},
+		{"backward to the start", keyed(2, 1), &Cursor{ID: 3, Backward: true}, []int32{1, 2}, 2, 0},
+		{"empty", nil, &Cursor{ID: 9}, []int32{}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		page := PageByCursor(tt.rows, CursorPaginationRequest{Cursor: tt.cursor, Limit: 2})
+
+		if !slices.Equal(page.Items, tt.items) {
+			t.Errorf("%s: items = %v, want %v", tt.name, page.Items, tt.items)
+		}
+
+		if got := cursorID(page.Next); got != tt.next || (page.Next != nil && page.Next.Backward) {
+			t.Errorf("%s: next = %+v, want id %d", tt.name, page.Next, tt.next)
+		}
+
+		if got := cursorID(page.Prev); got != tt.prev || (page.Prev != nil && !page.Prev.Backward) {
+			t.Errorf("%s: prev = %+v, want id %d", tt.name, page.Prev, tt.prev)
+		}
+	}
+}
+
+func cursorID(c *Cursor) int32 {
+	if c == nil {
+		return 0
+	}
+
+	return c.ID
+}
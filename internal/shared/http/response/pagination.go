@@ -67,3 +67,47 @@ func NewMeta(currentPage, perPage, totalItems int) meta {
 		PrevPage:    prevPage,
 	}
 }
+
+type cursorMeta struct {
+	PerPage    int     `json:"per_page"`
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+}
+
+type cursorPagination[T any] struct {
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+	Data    []T        `json:"data"`
+	Meta    cursorMeta `json:"meta"`
+}
+
+func NewCursorPagination[T any](status, message string, data []T, meta cursorMeta) cursorPagination[T] {
+	if len(data) == 0 {
+		data = []T{}
+	}
+
+	return cursorPagination[T]{
+		Status:  status,
+		Message: message,
+		Data:    data,
+		Meta:    meta,
+	}
+}
+
+// NewCursorMeta builds the meta of a keyset page, nextCursor and prevCursor
+// are the already encoded cursors or empty when there is no such page.
+func NewCursorMeta(perPage int, nextCursor, prevCursor string) cursorMeta {
+	meta := cursorMeta{
+		PerPage: perPage,
+	}
+
+	if nextCursor != "" {
+		meta.NextCursor = &nextCursor
+	}
+
+	if prevCursor != "" {
+		meta.PrevCursor = &prevCursor
+	}
+
+	return meta
+}
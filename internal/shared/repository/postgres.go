@@ -15,8 +15,8 @@ func newPostgresStore(db *sql.DB) *postgresStore {
 	}
 }
 
-func (s *postgresStore) IsUnique(table, column string, value any) (bool, error) {
-	query := "SELECT EXISTS (SELECT 1 FROM " + table + " WHERE " + column + " = $1)"
+func (s *postgresStore) IsUnique(table, column, deletedColumn string, value any) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM " + table + " WHERE " + column + " = $1" + notDeleted(deletedColumn) + ")"
 	var exists bool
 	err := s.db.QueryRow(query, value).Scan(&exists)
 	if err != nil {
@@ -28,13 +28,14 @@ func (s *postgresStore) IsUnique(table, column string, value any) (bool, error)
 func (s *postgresStore) IsUniqueWithCondition(
 	table,
 	column,
-	conditionColumn string,
+	conditionColumn,
+	deletedColumn string,
 	value,
 	conditionValue any,
 ) (bool, error) {
 	query := fmt.Sprintf(
-		"SELECT EXISTS (SELECT 1 FROM %s WHERE %s = $1 AND %s != $2)",
-		table, column, conditionColumn,
+		"SELECT EXISTS (SELECT 1 FROM %s WHERE %s = $1 AND %s != $2%s)",
+		table, column, conditionColumn, notDeleted(deletedColumn),
 	)
 	var exists bool
 	err := s.db.QueryRow(query, value, conditionValue).Scan(&exists)
@@ -43,3 +44,11 @@ func (s *postgresStore) IsUniqueWithCondition(
 	}
 	return exists, nil
 }
+
+func notDeleted(column string) string {
+	if column == "" {
+		return ""
+	}
+
+	return " AND " + column + " IS NULL"
+}
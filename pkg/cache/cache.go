@@ -0,0 +1,76 @@
+// Package cache is a small in-memory cache whose entries expire after a fixed
+// time to live.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepAt is the size from which Set drops the expired entries, so keys that
+// are never read again don't pile up.
+const sweepAt = 1024
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+type Cache[V any] struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	items map[string]entry[V]
+}
+
+func New[V any](ttl time.Duration) *Cache[V] {
+	return &Cache[V]{
+		ttl:   ttl,
+		items: make(map[string]entry[V]),
+	}
+}
+
+func (c *Cache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+
+	if !ok || time.Now().After(item.expiresAt) {
+		var zero V
+		return zero, false
+	}
+
+	return item.value, true
+}
+
+func (c *Cache[V]) Set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	if len(c.items) >= sweepAt {
+		for k, item := range c.items {
+			if now.After(item.expiresAt) {
+				delete(c.items, k)
+			}
+		}
+	}
+
+	c.items[key] = entry[V]{
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// DeleteFunc drops every entry fn returns true for.
+func (c *Cache[V]) DeleteFunc(fn func(key string, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, item := range c.items {
+		if fn(k, item.value) {
+			delete(c.items, k)
+		}
+	}
+}
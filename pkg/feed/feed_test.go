@@ -0,0 +1,162 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed() *Feed {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	return &Feed{
+		Title:       "New campaigns",
+		Description: "The newest campaigns open for donations",
+		Link:        "https://go-campaign.com",
+		URL:         "https://go-campaign.com/feeds/campaigns",
+		Updated:     time.Date(2024, 3, 2, 17, 30, 0, 0, jakarta),
+		Items: []Item{
+			{
+				ID:         "urn:go-campaign:campaign:7",
+				Title:      "Rumah & Sekolah <Banjir>",
+				Link:       "https://go-campaign.com/campaigns/rumah-dan-sekolah",
+				Summary:    "Target Rp 10.000.000, ends 1 Apr 2024.",
+				Author:     "Budi",
+				Categories: []string{"education"},
+				Published:  time.Date(2024, 3, 1, 8, 0, 0, 0, jakarta),
+				Updated:    time.Date(2024, 3, 2, 17, 30, 0, 0, jakarta),
+			},
+		},
+	}
+}
+
+func TestEncodeRSS(t *testing.T) {
+	body, err := testFeed().Encode(RSS)
+
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:dc="http://purl.org/dc/elements/1.1/">`,
+		`<atom:link href="https://go-campaign.com/feeds/campaigns" rel="self" type="application/rss+xml; charset=utf-8"></atom:link>`,
+		`<lastBuildDate>Sat, 02 Mar 2024 10:30:00 +0000</lastBuildDate>`,
+		`<title>Rumah &amp; Sekolah &lt;Banjir&gt;</title>`,
+		`<guid isPermaLink="false">urn:go-campaign:campaign:7</guid>`,
+		`<dc:creator>Budi</dc:creator>`,
+		`<category>education</category>`,
+		`<pubDate>Fri, 01 Mar 2024 01:00:00 +0000</pubDate>`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("the RSS feed is missing %s\n%s", want, body)
+		}
+	}
+}
+
+func TestEncodeAtom(t *testing.T) {
+	body, err := testFeed().Encode(Atom)
+
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		ID      string   `xml:"id"`
+		Updated string   `xml:"updated"`
+		Links   []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Entries []struct {
+			ID     string `xml:"id"`
+			Title  string `xml:"title"`
+			Author struct {
+				Name string `xml:"name"`
+			} `xml:"author"`
+			Category struct {
+				Term string `xml:"term,attr"`
+			} `xml:"category"`
+			Published string `xml:"published"`
+			Updated   string `xml:"updated"`
+		} `xml:"entry"`
+	}
+
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("the Atom feed doesn't parse: %v\n%s", err, body)
+	}
+
+	if doc.ID != "https://go-campaign.com/feeds/campaigns" || doc.Updated != "2024-03-02T10:30:00Z" {
+		t.Errorf("feed = %s %s", doc.ID, doc.Updated)
+	}
+
+	if len(doc.Links) != 2 || doc.Links[0].Rel != "alternate" || doc.Links[1].Rel != "self" {
+		t.Errorf("links = %+v", doc.Links)
+	}
+
+	if len(doc.Entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(doc.Entries))
+	}
+
+	entry := doc.Entries[0]
+
+	if entry.ID != "urn:go-campaign:campaign:7" || entry.Title != "Rumah & Sekolah <Banjir>" || entry.Author.Name != "Budi" || entry.Category.Term != "education" {
+		t.Errorf("entry = %+v", entry)
+	}
+
+	if entry.Published != "2024-03-01T01:00:00Z" || entry.Updated != "2024-03-02T10:30:00Z" {
+		t.Errorf("entry dates = %s %s", entry.Published, entry.Updated)
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	f := testFeed()
+	f.Items = append(f.Items, Item{ID: "urn:go-campaign:campaign:8", Title: "Anonymous"})
+
+	body, err := f.Encode(JSON)
+
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	var doc map[string]any
+
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("the JSON feed doesn't parse: %v", err)
+	}
+
+	if doc["version"] != "https://jsonfeed.org/version/1.1" || doc["feed_url"] != "https://go-campaign.com/feeds/campaigns" {
+		t.Errorf("feed = %v", doc)
+	}
+
+	items := doc["items"].([]any)
+	first := items[0].(map[string]any)
+
+	if first["date_published"] != "2024-03-01T01:00:00Z" || first["date_modified"] != "2024-03-02T10:30:00Z" {
+		t.Errorf("item dates = %v %v", first["date_published"], first["date_modified"])
+	}
+
+	if authors := first["authors"].([]any); authors[0].(map[string]any)["name"] != "Budi" {
+		t.Errorf("authors = %v", authors)
+	}
+
+	// authors and tags are left out rather than empty
+	second := items[1].(map[string]any)
+
+	if _, ok := second["authors"]; ok {
+		t.Errorf("an item without author has authors: %v", second)
+	}
+
+	if _, ok := second["tags"]; ok {
+		t.Errorf("an item without categories has tags: %v", second)
+	}
+}
+
+func TestEncodeUnknownFormat(t *testing.T) {
+	if _, err := testFeed().Encode("csv"); err == nil {
+		t.Error("Encode() of an unknown format didn't fail")
+	}
+}
@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"log"
+	"strings"
+)
+
+type logMailer struct {
+}
+
+// NewLogMailer prints messages instead of sending them, it is meant for
+// local development.
+func NewLogMailer() Mailer {
+	return &logMailer{}
+}
+
+var _ Mailer = (*logMailer)(nil)
+
+func (l *logMailer) Send(ctx context.Context, message Message) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	log.Printf(
+		"mail to %s\nSubject: %s\n\n%s",
+		strings.Join(message.To, ", "),
+		message.Subject,
+		message.Body,
+	)
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package mailer
+
+import (
+	"context"
+)
+
+type Message struct {
+	To      []string
+	Subject string
+	// Body is sent as plain text
+	Body string
+	// HTML is an optional rendered version of Body, clients that can't show
+	// it fall back to Body
+	HTML string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
@@ -0,0 +1,91 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(host, port, username, password, from string) Mailer {
+	var auth smtp.Auth
+
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &smtpMailer{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+var _ Mailer = (*smtpMailer)(nil)
+
+func (s *smtpMailer) Send(ctx context.Context, message Message) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(message.To) == 0 {
+		return fmt.Errorf("message has no recipient")
+	}
+
+	var body strings.Builder
+
+	fmt.Fprintf(&body, "From: %s\r\n", s.from)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(message.To, ", "))
+	// the subject can carry user input, a line break would start a new header
+	fmt.Fprintf(&body, "Subject: %s\r\n", strings.NewReplacer("\r", " ", "\n", " ").Replace(message.Subject))
+	body.WriteString("MIME-Version: 1.0\r\n")
+
+	if message.HTML == "" {
+		body.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+		body.WriteString("\r\n")
+		body.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+
+		return smtp.SendMail(s.addr, s.auth, s.from, message.To, []byte(body.String()))
+	}
+
+	boundary, err := newBoundary()
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(&body, "Content-Type: multipart/alternative; boundary=\"%s\"\r\n", boundary)
+	body.WriteString("\r\n")
+	fmt.Fprintf(&body, "--%s\r\n", boundary)
+	body.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	body.WriteString("\r\n")
+	body.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	fmt.Fprintf(&body, "\r\n--%s\r\n", boundary)
+	body.WriteString("Content-Type: text/html; charset=\"utf-8\"\r\n")
+	body.WriteString("\r\n")
+	body.WriteString(strings.ReplaceAll(message.HTML, "\n", "\r\n"))
+	fmt.Fprintf(&body, "\r\n--%s--\r\n", boundary)
+
+	return smtp.SendMail(s.addr, s.auth, s.from, message.To, []byte(body.String()))
+}
+
+func newBoundary() (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate the mime boundary: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,68 @@
+// Package pgnotify delivers the notifications sent with NOTIFY on a Postgres
+// channel, every listening process receives every notification.
+package pgnotify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	// pingInterval checks the connection when no notification came in for a
+	// while, a dead connection would otherwise go unnoticed
+	pingInterval = 90 * time.Second
+)
+
+// Listener is the part of *pq.Listener Listen relies on.
+type Listener interface {
+	Listen(channel string) error
+	NotificationChannel() <-chan *pq.Notification
+	Ping() error
+	Close() error
+}
+
+// Listen calls fn with the payload of every notification on channel until
+// ctx is done. The listener reconnects on its own after a connection loss,
+// notifications sent in the meantime are lost.
+func Listen(ctx context.Context, dsn, channel string, fn func(payload string)) error {
+	listener := pq.NewListener(dsn, minReconnectInterval, maxReconnectInterval, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("listener on %s: %v", channel, err)
+		}
+	})
+
+	return listen(ctx, listener, channel, pingInterval, fn)
+}
+
+func listen(ctx context.Context, listener Listener, channel string, ping time.Duration, fn func(payload string)) error {
+	defer listener.Close()
+
+	if err := listener.Listen(channel); err != nil {
+		return fmt.Errorf("listen on %s: %w", channel, err)
+	}
+
+	ticker := time.NewTicker(ping)
+	defer ticker.Stop()
+
+	notifications := listener.NotificationChannel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-notifications:
+			// a nil notification tells the connection was re-established
+			if notification != nil {
+				fn(notification.Extra)
+			}
+		case <-ticker.C:
+			go listener.Ping()
+		}
+	}
+}
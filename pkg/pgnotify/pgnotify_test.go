@@ -0,0 +1,145 @@
+package pgnotify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type fakeListener struct {
+	notify    chan *pq.Notification
+	listenErr error
+
+	mu       sync.Mutex
+	channels []string
+	pings    int
+	closed   bool
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{notify: make(chan *pq.Notification)}
+}
+
+func (l *fakeListener) Listen(channel string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.channels = append(l.channels, channel)
+
+	return l.listenErr
+}
+
+func (l *fakeListener) NotificationChannel() <-chan *pq.Notification {
+	return l.notify
+}
+
+func (l *fakeListener) Ping() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pings++
+
+	return nil
+}
+
+func (l *fakeListener) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+
+	return nil
+}
+
+func (l *fakeListener) state() (pings int, closed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.pings, l.closed
+}
+
+func TestListen(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := newFakeListener()
+	payloads := make(chan string, 3)
+	done := make(chan error)
+
+	go func() {
+		done <- listen(ctx, listener, "donation_paid", time.Hour, func(payload string) {
+			payloads <- payload
+		})
+	}()
+
+	listener.notify <- &pq.Notification{Channel: "donation_paid", Extra: `{"donation_id":1}`}
+	// sent when the connection is re-established, it carries no payload
+	listener.notify <- nil
+	listener.notify <- &pq.Notification{Channel: "donation_paid", Extra: `{"donation_id":2}`}
+
+	for _, want := range []string{`{"donation_id":1}`, `{"donation_id":2}`} {
+		if got := <-payloads; got != want {
+			t.Errorf("payload = %s, want %s", got, want)
+		}
+	}
+
+	cancel()
+
+	if err := <-done; err != nil {
+		t.Errorf("listen() error = %v", err)
+	}
+
+	if len(payloads) > 0 {
+		t.Errorf("unexpected payload %s", <-payloads)
+	}
+
+	if len(listener.channels) != 1 || listener.channels[0] != "donation_paid" {
+		t.Errorf("listened on %q, want donation_paid", listener.channels)
+	}
+
+	if _, closed := listener.state(); !closed {
+		t.Error("the listener was not closed")
+	}
+}
+
+func TestListenPings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener := newFakeListener()
+
+	go listen(ctx, listener, "donation_paid", time.Millisecond, func(string) {})
+
+	deadline := time.Now().Add(time.Second)
+
+	for {
+		if pings, _ := listener.state(); pings >= 2 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the listener to be pinged")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestListenError(t *testing.T) {
+	listener := newFakeListener()
+	listener.listenErr = errors.New("connection refused")
+
+	err := listen(context.Background(), listener, "donation_paid", time.Hour, func(string) {
+		t.Error("a notification was delivered")
+	})
+
+	if !errors.Is(err, listener.listenErr) {
+		t.Errorf("listen() error = %v, want %v", err, listener.listenErr)
+	}
+
+	if _, closed := listener.state(); !closed {
+		t.Error("the listener was not closed")
+	}
+}
@@ -0,0 +1,316 @@
+package qrcode
+
+// draw lays out the function patterns and the codewords, then keeps the mask
+// that scores the lowest penalty.
+func (c *Code) draw(codewords []byte) {
+	c.Modules = grid(c.Size)
+	function := grid(c.Size)
+
+	set := func(x, y int, dark bool) {
+		c.Modules[y][x] = dark
+		function[y][x] = true
+	}
+
+	c.drawFunctionPatterns(set)
+	c.drawCodewords(codewords, function)
+
+	best, bestPenalty := 0, -1
+
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask, function)
+		c.drawFormat(mask, set)
+
+		if penalty := c.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+
+		// masks are xor, applying it again undoes it
+		c.applyMask(mask, function)
+	}
+
+	c.applyMask(best, function)
+	c.drawFormat(best, set)
+}
+
+func grid(size int) [][]bool {
+	g := make([][]bool, size)
+
+	for i := range g {
+		g[i] = make([]bool, size)
+	}
+
+	return g
+}
+
+func (c *Code) drawFunctionPatterns(set func(x, y int, dark bool)) {
+	for i := 0; i < c.Size; i++ {
+		set(6, i, i%2 == 0)
+		set(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3, set)
+	c.drawFinder(c.Size-4, 3, set)
+	c.drawFinder(3, c.Size-4, set)
+
+	align := versions[c.Version].alignment
+	last := len(align) - 1
+
+	for i, y := range align {
+		for j, x := range align {
+			// the corners are taken by the finder patterns
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					set(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// reserve the format areas, drawFormat fills them per mask
+	c.drawFormat(0, set)
+	c.drawVersion(set)
+}
+
+// drawFinder draws a finder pattern centered on x, y with its separator.
+func (c *Code) drawFinder(x, y int, set func(x, y int, dark bool)) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+
+			if xx < 0 || xx >= c.Size || yy < 0 || yy >= c.Size {
+				continue
+			}
+
+			d := max(abs(dx), abs(dy))
+			set(xx, yy, d != 2 && d != 4)
+		}
+	}
+}
+
+// formatBits returns the 15 bit format information of the medium error
+// correction level with mask.
+func formatBits(mask int) int {
+	// 0b00 is the medium error correction level
+	data := 0b00<<3 | mask
+	rem := data
+
+	for i := 0; i < 10; i++ {
+		rem = rem<<1 ^ (rem>>9)*0x537
+	}
+
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawFormat writes both copies of the error correction level and mask.
+func (c *Code) drawFormat(mask int, set func(x, y int, dark bool)) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return (bits>>i)&1 == 1 }
+
+	for i := 0; i <= 5; i++ {
+		set(8, i, bit(i))
+	}
+
+	set(8, 7, bit(6))
+	set(8, 8, bit(7))
+	set(7, 8, bit(8))
+
+	for i := 9; i < 15; i++ {
+		set(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		set(c.Size-1-i, 8, bit(i))
+	}
+
+	for i := 8; i < 15; i++ {
+		set(8, c.Size-15+i, bit(i))
+	}
+
+	set(8, c.Size-8, true)
+}
+
+// versionBits returns the 18 bit version information of version.
+func versionBits(version int) int {
+	rem := version
+
+	for i := 0; i < 12; i++ {
+		rem = rem<<1 ^ (rem>>11)*0x1F25
+	}
+
+	return version<<12 | rem
+}
+
+// drawVersion writes the version blocks versions 7 and up carry.
+func (c *Code) drawVersion(set func(x, y int, dark bool)) {
+	if c.Version < 7 {
+		return
+	}
+
+	bits := versionBits(c.Version)
+
+	for i := 0; i < 18; i++ {
+		dark := (bits>>i)&1 == 1
+		a, b := c.Size-11+i%3, i/3
+
+		set(a, b, dark)
+		set(b, a, dark)
+	}
+}
+
+// drawCodewords fills the free modules in the two column zigzag, starting at
+// the bottom right corner.
+func (c *Code) drawCodewords(codewords []byte, function [][]bool) {
+	i := 0
+
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		// the vertical timing pattern is skipped as a whole column
+		if right == 6 {
+			right = 5
+		}
+
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vert
+				}
+
+				if function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+
+				c.Modules[y][x] = (codewords[i/8]>>(7-i%8))&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+func (c *Code) applyMask(mask int, function [][]bool) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if function[y][x] {
+				continue
+			}
+
+			var invert bool
+
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+
+			c.Modules[y][x] = c.Modules[y][x] != invert
+		}
+	}
+}
+
+var (
+	finderLeft  = []bool{true, false, true, true, true, false, true, false, false, false, false}
+	finderRight = []bool{false, false, false, false, true, false, true, true, true, false, true}
+)
+
+// penalty scores how hard the masked code is to scan, following the four
+// rules of the specification.
+func (c *Code) penalty() int {
+	penalty := 0
+	dark := 0
+
+	at := func(x, y int, vertical bool) bool {
+		if vertical {
+			return c.Modules[x][y]
+		}
+
+		return c.Modules[y][x]
+	}
+
+	for _, vertical := range []bool{false, true} {
+		for y := 0; y < c.Size; y++ {
+			run := 1
+
+			for x := 1; x < c.Size; x++ {
+				if at(x, y, vertical) == at(x-1, y, vertical) {
+					run++
+					continue
+				}
+
+				if run >= 5 {
+					penalty += run - 2
+				}
+
+				run = 1
+			}
+
+			if run >= 5 {
+				penalty += run - 2
+			}
+
+			for x := 0; x+len(finderLeft) <= c.Size; x++ {
+				left, right := true, true
+
+				for k := range finderLeft {
+					m := at(x+k, y, vertical)
+					left = left && m == finderLeft[k]
+					right = right && m == finderRight[k]
+				}
+
+				if left {
+					penalty += 40
+				}
+
+				if right {
+					penalty += 40
+				}
+			}
+		}
+	}
+
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.Modules[y][x] {
+				dark++
+			}
+
+			if x > 0 && y > 0 {
+				m := c.Modules[y][x]
+
+				if m == c.Modules[y-1][x] && m == c.Modules[y][x-1] && m == c.Modules[y-1][x-1] {
+					penalty += 3
+				}
+			}
+		}
+	}
+
+	percent := dark * 100 / (c.Size * c.Size)
+	penalty += abs(percent-50) / 5 * 10
+
+	return penalty
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
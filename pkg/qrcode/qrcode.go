@@ -0,0 +1,192 @@
+// Package qrcode encodes short texts, such as links, as QR codes. It covers
+// byte mode with the medium error correction level up to version 10, which
+// fits 213 bytes and is plenty for URLs.
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+var ErrTooLong = errors.New("qrcode: content too long")
+
+// quietZone is the light border, in modules, scanners need around the code.
+const quietZone = 4
+
+// Code is an encoded QR code, Modules[y][x] is true for dark modules.
+type Code struct {
+	Version int
+	Size    int
+	Modules [][]bool
+}
+
+// version describes the medium level error correction blocks of a version.
+type version struct {
+	ecPerBlock int
+	// blocks are the data codeword counts of each block
+	blocks []int
+	// alignment are the row and column centers of the alignment patterns
+	alignment []int
+}
+
+var versions = []version{
+	1:  {10, []int{16}, nil},
+	2:  {16, []int{28}, []int{6, 18}},
+	3:  {26, []int{44}, []int{6, 22}},
+	4:  {18, []int{32, 32}, []int{6, 26}},
+	5:  {24, []int{43, 43}, []int{6, 30}},
+	6:  {16, []int{27, 27, 27, 27}, []int{6, 34}},
+	7:  {18, []int{31, 31, 31, 31}, []int{6, 22, 38}},
+	8:  {22, []int{38, 38, 39, 39}, []int{6, 24, 42}},
+	9:  {22, []int{36, 36, 36, 37, 37}, []int{6, 26, 46}},
+	10: {26, []int{43, 43, 43, 43, 44}, []int{6, 28, 50}},
+}
+
+func (v version) dataCodewords() int {
+	total := 0
+
+	for _, n := range v.blocks {
+		total += n
+	}
+
+	return total
+}
+
+// Encode builds the smallest QR code that holds content.
+func Encode(content string) (*Code, error) {
+	data := []byte(content)
+
+	for v := 1; v < len(versions); v++ {
+		countBits := 8
+
+		if v >= 10 {
+			countBits = 16
+		}
+
+		// mode indicator, character count and the data itself
+		if 4+countBits+len(data)*8 > versions[v].dataCodewords()*8 {
+			continue
+		}
+
+		code := &Code{
+			Version: v,
+			Size:    17 + 4*v,
+		}
+
+		code.draw(interleave(versions[v], encodeData(data, countBits, versions[v].dataCodewords())))
+
+		return code, nil
+	}
+
+	return nil, ErrTooLong
+}
+
+// encodeData writes the byte mode segment and pads it to capacity codewords.
+func encodeData(data []byte, countBits, capacity int) []byte {
+	var bb bitBuffer
+
+	bb.append(0b0100, 4)
+	bb.append(len(data), countBits)
+
+	for _, b := range data {
+		bb.append(int(b), 8)
+	}
+
+	bb.append(0, min(4, capacity*8-bb.len()))
+	bb.append(0, (8-bb.len()%8)%8)
+
+	for pad := 0xEC; bb.len() < capacity*8; pad ^= 0xEC ^ 0x11 {
+		bb.append(pad, 8)
+	}
+
+	return bb.bytes()
+}
+
+// interleave splits the data into blocks, adds their error correction and
+// mixes the codewords the way scanners read them back.
+func interleave(v version, data []byte) []byte {
+	blocks := make([][]byte, len(v.blocks))
+	ecc := make([][]byte, len(v.blocks))
+	offset := 0
+
+	for i, n := range v.blocks {
+		blocks[i] = data[offset : offset+n]
+		ecc[i] = reedSolomon(blocks[i], v.ecPerBlock)
+		offset += n
+	}
+
+	result := make([]byte, 0, len(data)+len(v.blocks)*v.ecPerBlock)
+
+	for i := 0; i < v.blocks[len(v.blocks)-1]; i++ {
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	for i := 0; i < v.ecPerBlock; i++ {
+		for _, block := range ecc {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+// Image renders the code with scale pixels per module and the quiet zone
+// around it.
+func (c *Code) Image(scale int) image.Image {
+	size := (c.Size + 2*quietZone) * scale
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{color.White, color.Black})
+
+	for y, row := range c.Modules {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					img.SetColorIndex((x+quietZone)*scale+dx, (y+quietZone)*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+// PNG writes the code as a PNG image with scale pixels per module.
+func (c *Code) PNG(w io.Writer, scale int) error {
+	return png.Encode(w, c.Image(scale))
+}
+
+type bitBuffer struct {
+	bits []bool
+}
+
+func (b *bitBuffer) append(value, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.bits = append(b.bits, (value>>i)&1 == 1)
+	}
+}
+
+func (b *bitBuffer) len() int {
+	return len(b.bits)
+}
+
+func (b *bitBuffer) bytes() []byte {
+	out := make([]byte, (len(b.bits)+7)/8)
+
+	for i, bit := range b.bits {
+		if bit {
+			out[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+
+	return out
+}
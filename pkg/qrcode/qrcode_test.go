@@ -0,0 +1,325 @@
+package qrcode
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// the "HELLO WORLD" 1-M codewords of the thonky.com QR code tutorial
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if got := reedSolomon(data, 10); !bytes.Equal(got, want) {
+		t.Errorf("reedSolomon() = %v, want %v", got, want)
+	}
+
+	// the degree 10 generator as exponents of alpha
+	var exponents []int
+
+	for _, c := range generator(10) {
+		exponents = append(exponents, int(log[c]))
+	}
+
+	if want := []int{251, 67, 46, 61, 118, 70, 64, 94, 32, 45}; !slices.Equal(exponents, want) {
+		t.Errorf("generator(10) = %v, want %v", exponents, want)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	// the medium level rows of the format information table
+	want := []string{
+		"101010000010010",
+		"101000100100101",
+		"101111001111100",
+		"101101101001011",
+		"100010111111001",
+		"100000011001110",
+		"100111110010111",
+		"100101010100000",
+	}
+
+	for mask, bits := range want {
+		if got := strconv.FormatInt(int64(formatBits(mask)), 2); got != bits {
+			t.Errorf("formatBits(%d) = %s, want %s", mask, got, bits)
+		}
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	tests := []struct {
+		version int
+		want    string
+	}{
+		{7, "000111110010010100"},
+		{8, "001000010110111100"},
+		{9, "001001101010011001"},
+		{10, "001010010011010011"},
+	}
+
+	for _, tt := range tests {
+		if got := strconv.FormatInt(int64(versionBits(tt.version)), 2); strings.Repeat("0", 18-len(got))+got != tt.want {
+			t.Errorf("versionBits(%d) = %s, want %s", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		content string
+		version int
+		// the medium level block layout of the specification
+		blocks       int
+		dataPerBlock int
+		ecPerBlock   int
+		alignment    []int
+	}{
+		{"https://gc.id", 1, 1, 16, 10, nil},
+		{"https://go-campaign.com/campaigns/bantu-korban-banjir?ref=qr&utm=poster", 5, 2, 43, 24, []int{6, 30}},
+		{"https://go-campaign.com/campaigns/penggalangan-dana-untuk-pembangunan-masjid-di-desa?ref=qr&utm_source=poster&utm_x=1", 7, 4, 31, 18, []int{6, 22, 38}},
+	}
+
+	for _, tt := range tests {
+		code, err := Encode(tt.content)
+
+		if err != nil {
+			t.Fatalf("Encode(%q) error = %v", tt.content, err)
+		}
+
+		if code.Version != tt.version || code.Size != 17+4*tt.version {
+			t.Fatalf("Encode(%q) = version %d size %d, want version %d", tt.content, code.Version, code.Size, tt.version)
+		}
+
+		got, problem := decode(code, tt.blocks, tt.dataPerBlock, tt.ecPerBlock, tt.alignment)
+
+		if problem != "" {
+			t.Errorf("decode(Encode(%q)): %s", tt.content, problem)
+			continue
+		}
+
+		if got != tt.content {
+			t.Errorf("decode(Encode(%q)) = %q", tt.content, got)
+		}
+	}
+
+	if _, err := Encode(strings.Repeat("a", 214)); err != ErrTooLong {
+		t.Errorf("Encode() of 214 bytes error = %v, want ErrTooLong", err)
+	}
+}
+
+// decode reads a code back the way a scanner does, following the
+// specification rather than the encoder, and returns what went wrong.
+func decode(code *Code, blocks, dataPerBlock, ecPerBlock int, alignment []int) (string, string) {
+	size := code.Size
+	m := code.Modules
+
+	for _, corner := range [][2]int{{0, 0}, {size - 7, 0}, {0, size - 7}} {
+		for dy := 0; dy < 7; dy++ {
+			for dx := 0; dx < 7; dx++ {
+				d := max(abs(dx-3), abs(dy-3))
+
+				if m[corner[1]+dy][corner[0]+dx] != (d != 2) {
+					return "", "broken finder pattern"
+				}
+			}
+		}
+	}
+
+	for i := 8; i < size-8; i++ {
+		if m[6][i] != (i%2 == 0) || m[i][6] != (i%2 == 0) {
+			return "", "broken timing pattern"
+		}
+	}
+
+	if !m[size-8][8] {
+		return "", "missing dark module"
+	}
+
+	// both copies of the format information, most significant bit first
+	var first, second int
+
+	for _, p := range [][2]int{{0, 8}, {1, 8}, {2, 8}, {3, 8}, {4, 8}, {5, 8}, {7, 8}, {8, 8}, {8, 7}, {8, 5}, {8, 4}, {8, 3}, {8, 2}, {8, 1}, {8, 0}} {
+		first = first<<1 | bit(m[p[1]][p[0]])
+	}
+
+	for i := 0; i < 7; i++ {
+		second = second<<1 | bit(m[size-1-i][8])
+	}
+
+	for i := 0; i < 8; i++ {
+		second = second<<1 | bit(m[8][size-8+i])
+	}
+
+	if first != second {
+		return "", "the format information copies differ"
+	}
+
+	mask := -1
+
+	for i, bits := range []int{0x5412, 0x5125, 0x5E7C, 0x5B4B, 0x45F9, 0x40CE, 0x4F97, 0x4AA0} {
+		if first == bits {
+			mask = i
+		}
+	}
+
+	if mask < 0 {
+		return "", "unknown format information " + strconv.FormatInt(int64(first), 2)
+	}
+
+	reserved := grid(size)
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			reserved[y][x] = x == 6 || y == 6 ||
+				(x < 9 && y < 9) || (x >= size-8 && y < 9) || (x < 9 && y >= size-8) ||
+				(code.Version >= 7 && ((x >= size-11 && x < size-8 && y < 6) || (y >= size-11 && y < size-8 && x < 6)))
+		}
+	}
+
+	for _, cy := range alignment {
+		for _, cx := range alignment {
+			// the corners are taken by the finder patterns, the timing pattern
+			// runs through the others
+			if (cx < 9 || cx >= size-8) && cy < 9 || cx < 9 && cy >= size-8 {
+				continue
+			}
+
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					if m[cy+dy][cx+dx] != (max(abs(dx), abs(dy)) != 1) {
+						return "", "broken alignment pattern"
+					}
+
+					reserved[cy+dy][cx+dx] = true
+				}
+			}
+		}
+	}
+
+	if code.Version >= 7 {
+		var upper, lower int
+
+		for i := 17; i >= 0; i-- {
+			upper = upper<<1 | bit(m[i/3][size-11+i%3])
+			lower = lower<<1 | bit(m[size-11+i%3][i/3])
+		}
+
+		if upper != versionBits(code.Version) || lower != upper {
+			return "", "wrong version information"
+		}
+	}
+
+	var bits []bool
+	upward := true
+
+	for right := size - 1; right > 0; right -= 2 {
+		if right == 6 {
+			right--
+		}
+
+		for i := 0; i < size; i++ {
+			y := i
+
+			if upward {
+				y = size - 1 - i
+			}
+
+			for _, x := range []int{right, right - 1} {
+				if !reserved[y][x] {
+					bits = append(bits, m[y][x] != masked(mask, x, y))
+				}
+			}
+		}
+
+		upward = !upward
+	}
+
+	total := blocks * (dataPerBlock + ecPerBlock)
+
+	if len(bits) < total*8 {
+		return "", "too few data modules"
+	}
+
+	codewords := make([]byte, total)
+
+	for i := range codewords {
+		for j := 0; j < 8; j++ {
+			codewords[i] = codewords[i]<<1 | byte(bit(bits[i*8+j]))
+		}
+	}
+
+	var data []byte
+
+	for b := 0; b < blocks; b++ {
+		block := make([]byte, 0, dataPerBlock+ecPerBlock)
+
+		for i := 0; i < dataPerBlock; i++ {
+			block = append(block, codewords[i*blocks+b])
+		}
+
+		for i := 0; i < ecPerBlock; i++ {
+			block = append(block, codewords[blocks*dataPerBlock+i*blocks+b])
+		}
+
+		// a valid block has no remainder at every root of the generator
+		for root := 0; root < ecPerBlock; root++ {
+			var syndrome byte
+
+			for _, c := range block {
+				syndrome = gfMul(syndrome, exp[root]) ^ c
+			}
+
+			if syndrome != 0 {
+				return "", "block " + strconv.Itoa(b) + " fails its error correction"
+			}
+		}
+
+		data = append(data, block[:dataPerBlock]...)
+	}
+
+	// byte mode, an 8 bit count up to version 9
+	if data[0]>>4 != 0b0100 {
+		return "", "not byte mode"
+	}
+
+	n := int(data[0]&0x0F)<<4 | int(data[1]>>4)
+	content := make([]byte, n)
+
+	for i := range content {
+		content[i] = data[1+i]<<4 | data[2+i]>>4
+	}
+
+	return string(content), ""
+}
+
+func masked(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (y+x)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (y+x)%3 == 0
+	case 4:
+		return (y/2+x/3)%2 == 0
+	case 5:
+		return (y*x)%2+(y*x)%3 == 0
+	case 6:
+		return ((y*x)%2+(y*x)%3)%2 == 0
+	default:
+		return ((y+x)%2+(y*x)%3)%2 == 0
+	}
+}
+
+func bit(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
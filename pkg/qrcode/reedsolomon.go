@@ -0,0 +1,71 @@
+package qrcode
+
+// exp and log are the GF(256) tables for the QR polynomial
+// x^8 + x^4 + x^3 + x^2 + 1.
+var exp, log = gfTables()
+
+func gfTables() (e [512]byte, l [256]byte) {
+	x := 1
+
+	for i := 0; i < 255; i++ {
+		e[i] = byte(x)
+		l[x] = byte(i)
+		x <<= 1
+
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+
+	// doubled so products don't need a modulo
+	for i := 255; i < 512; i++ {
+		e[i] = e[i-255]
+	}
+
+	return e, l
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return exp[int(log[a])+int(log[b])]
+}
+
+// generator returns the coefficients of (x - a^0)(x - a^1)...(x - a^(n-1)),
+// highest degree first without the leading 1.
+func generator(n int) []byte {
+	poly := []byte{1}
+
+	for i := 0; i < n; i++ {
+		next := make([]byte, len(poly)+1)
+
+		for j, c := range poly {
+			next[j] ^= c
+			next[j+1] ^= gfMul(c, exp[i])
+		}
+
+		poly = next
+	}
+
+	return poly[1:]
+}
+
+// reedSolomon computes the n error correction codewords of data.
+func reedSolomon(data []byte, n int) []byte {
+	gen := generator(n)
+	rem := make([]byte, n)
+
+	for _, d := range data {
+		factor := d ^ rem[0]
+		copy(rem, rem[1:])
+		rem[n-1] = 0
+
+		for i, g := range gen {
+			rem[i] ^= gfMul(g, factor)
+		}
+	}
+
+	return rem
+}
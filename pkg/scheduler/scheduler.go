@@ -0,0 +1,100 @@
+// Package scheduler runs background jobs at a fixed interval for as long as
+// the application is up.
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+type Job func(ctx context.Context) error
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+type Scheduler struct {
+	mu      sync.Mutex
+	entries []entry
+	// ctx is set while the scheduler runs
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job, it runs once when the scheduler starts and then
+// every interval. Jobs registered after Start begin right away.
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := entry{name: name, interval: interval, job: job}
+	s.entries = append(s.entries, e)
+
+	if s.ctx != nil {
+		s.run(e)
+	}
+}
+
+// Start runs the registered jobs in the background until Stop is called.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ctx != nil {
+		return
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	for _, e := range s.entries {
+		s.run(e)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	s.wg.Wait()
+}
+
+// run starts the job loop, the caller holds the lock.
+func (s *Scheduler) run(e entry) {
+	ctx := s.ctx
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			// a failed run is retried on the next tick
+			if err := e.job(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("scheduled job %s failed: %v", e.name, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitFor polls cond until it holds or a second went by.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	s := New()
+
+	var hourly, fast, failing atomic.Int32
+
+	s.Every("hourly", time.Hour, func(context.Context) error {
+		hourly.Add(1)
+		return nil
+	})
+
+	// a failed run is logged and retried on the next tick
+	s.Every("failing", 5*time.Millisecond, func(context.Context) error {
+		failing.Add(1)
+		return errors.New("database is down")
+	})
+
+	if hourly.Load() != 0 {
+		t.Fatal("a job ran before Start")
+	}
+
+	s.Start()
+	s.Start()
+
+	waitFor(t, "the first run", func() bool { return hourly.Load() == 1 })
+	waitFor(t, "the failing job to be retried", func() bool { return failing.Load() >= 3 })
+
+	// jobs registered once started run right away
+	s.Every("fast", 5*time.Millisecond, func(context.Context) error {
+		fast.Add(1)
+		return nil
+	})
+
+	waitFor(t, "the late job to run", func() bool { return fast.Load() >= 2 })
+
+	s.Stop()
+
+	stopped := fast.Load()
+	time.Sleep(20 * time.Millisecond)
+
+	if fast.Load() != stopped {
+		t.Error("a job kept running after Stop")
+	}
+
+	if hourly.Load() != 1 {
+		t.Errorf("the hourly job ran %d times, want 1", hourly.Load())
+	}
+}
+
+func TestSchedulerStopCancelsJobs(t *testing.T) {
+	s := New()
+	started := make(chan struct{})
+
+	var cancelled atomic.Bool
+
+	s.Every("long", time.Hour, func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		cancelled.Store(true)
+
+		return ctx.Err()
+	})
+
+	s.Start()
+	<-started
+
+	// Stop waits for the running job to return
+	s.Stop()
+
+	if !cancelled.Load() {
+		t.Error("Stop returned before the running job was cancelled")
+	}
+}
+
+func TestSchedulerStopBeforeStart(t *testing.T) {
+	s := New()
+	s.Every("never", time.Hour, func(context.Context) error {
+		t.Error("a job ran without Start")
+		return nil
+	})
+
+	s.Stop()
+}
@@ -0,0 +1,72 @@
+// Package sitemap writes sitemaps and sitemap indexes following the
+// sitemaps.org protocol.
+package sitemap
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// MaxURLs is the most URLs a single sitemap may list, larger sites split
+// their URLs over several sitemaps listed in an index.
+const MaxURLs = 50000
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type URL struct {
+	Loc string
+	// LastMod is left out when it is zero
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	XMLNS   string   `xml:"xmlns,attr"`
+	URLs    []entry  `xml:"url"`
+}
+
+type index struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	XMLNS    string   `xml:"xmlns,attr"`
+	Sitemaps []entry  `xml:"sitemap"`
+}
+
+type entry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// WriteURLSet writes a sitemap of the given URLs, at most MaxURLs of them.
+func WriteURLSet(w io.Writer, urls []URL) error {
+	return write(w, urlSet{XMLNS: namespace, URLs: entries(urls)})
+}
+
+// WriteIndex writes a sitemap index, Loc of each URL points to a sitemap.
+func WriteIndex(w io.Writer, sitemaps []URL) error {
+	return write(w, index{XMLNS: namespace, Sitemaps: entries(sitemaps)})
+}
+
+func entries(urls []URL) []entry {
+	out := make([]entry, 0, len(urls))
+
+	for _, u := range urls {
+		e := entry{Loc: u.Loc}
+
+		if !u.LastMod.IsZero() {
+			e.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+
+		out = append(out, e)
+	}
+
+	return out
+}
+
+func write(w io.Writer, doc any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	return xml.NewEncoder(w).Encode(doc)
+}
@@ -0,0 +1,102 @@
+// Package slug turns titles into URL slugs. Symbols are spelled out in
+// Indonesian and accented letters are folded to plain ASCII, so
+// "Bantu Rumah & Sekolah Café" becomes "bantu-rumah-dan-sekolah-cafe".
+package slug
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MaxLength keeps generated slugs within the campaign slug validation.
+const MaxLength = 50
+
+// words spells out the symbols that carry meaning in a title.
+var words = map[rune]string{
+	'&': "dan",
+	'+': "plus",
+	'%': "persen",
+	'@': "di",
+	'=': "sama dengan",
+	'#': "nomor",
+}
+
+// letters folds the accented letters common in Indonesian names and
+// loanwords.
+var letters = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'ÿ': "y", 'ß': "ss",
+}
+
+// Make builds the slug of a title, it is empty when the title has no letters
+// or digits at all.
+func Make(title string) string {
+	var b strings.Builder
+	dash := false
+
+	write := func(s string) {
+		for _, r := range s {
+			if r == ' ' {
+				dash = b.Len() > 0
+				continue
+			}
+
+			if dash {
+				b.WriteByte('-')
+				dash = false
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			write(string(r))
+		case r == '\'' || r == '’':
+			// "Jum'at" reads as one word
+		case words[r] != "":
+			write(" " + words[r] + " ")
+		case letters[r] != "":
+			write(letters[r])
+		default:
+			dash = b.Len() > 0
+		}
+	}
+
+	return truncate(b.String(), MaxLength)
+}
+
+// WithSuffix appends the number used to tell apart campaigns with the same
+// title, keeping the result within MaxLength.
+func WithSuffix(s string, n int) string {
+	suffix := "-" + strconv.Itoa(n)
+
+	return truncate(s, MaxLength-len(suffix)) + suffix
+}
+
+// truncate cuts s to at most max bytes, on a dash when there is one so words
+// aren't split.
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+
+	// the cut already falls between two words
+	if s[max] == '-' {
+		return s[:max]
+	}
+
+	s = s[:max]
+
+	if i := strings.LastIndexByte(s, '-'); i > 0 {
+		s = s[:i]
+	}
+
+	return strings.Trim(s, "-")
+}
@@ -0,0 +1,37 @@
+package slug
+
+import "testing"
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Bantu Korban Banjir", "bantu-korban-banjir"},
+		{"  Rumah & Sekolah  ", "rumah-dan-sekolah"},
+		{"Diskon 50% untuk Panti", "diskon-50-persen-untuk-panti"},
+		{"Café Élégant", "cafe-elegant"},
+		{"Jum'at Berkah!!", "jumat-berkah"},
+		{"Operasi #2 @ RSUD", "operasi-nomor-2-di-rsud"},
+		{"!!!", ""},
+		{"Penggalangan dana untuk pembangunan masjid di desa terpencil", "penggalangan-dana-untuk-pembangunan-masjid-di-desa"},
+	}
+
+	for _, tt := range tests {
+		if got := Make(tt.title); got != tt.want {
+			t.Errorf("Make(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestWithSuffix(t *testing.T) {
+	if got := WithSuffix("bantu-korban-banjir", 2); got != "bantu-korban-banjir-2" {
+		t.Errorf("WithSuffix() = %q", got)
+	}
+
+	long := Make("Penggalangan dana untuk pembangunan masjid di desa terpencil")
+
+	if got := WithSuffix(long, 12); len(got) > MaxLength {
+		t.Errorf("WithSuffix() = %q is longer than %d", got, MaxLength)
+	}
+}
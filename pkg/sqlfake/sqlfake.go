@@ -0,0 +1,225 @@
+// Package sqlfake is a database/sql driver for tests. Queries are answered by
+// handlers registered under the sqlc query name, so code built on the
+// generated queries can be tested without a database server.
+package sqlfake
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// Result is the answer of a handler, Rows for queries and RowsAffected for
+// statements.
+type Result struct {
+	Rows         [][]driver.Value
+	RowsAffected int64
+	Err          error
+}
+
+// Handler answers one call of a query with its arguments.
+type Handler func(args []driver.Value) Result
+
+// Call is a query the code ran.
+type Call struct {
+	Name string
+	Args []driver.Value
+}
+
+type DB struct {
+	mu       sync.Mutex
+	handlers map[string]Handler
+	calls    []Call
+	commits  int
+}
+
+// New returns a connection pool answered by the returned DB.
+func New() (*sql.DB, *DB) {
+	db := &DB{handlers: make(map[string]Handler)}
+
+	return sql.OpenDB(connector{db}), db
+}
+
+// On answers the query named name with handler, a query without handler
+// fails.
+func (d *DB) On(name string, handler Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.handlers[name] = handler
+}
+
+// Calls returns the calls of the query named name, in order.
+func (d *DB) Calls(name string) []Call {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var calls []Call
+
+	for _, call := range d.calls {
+		if call.Name == name {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Commits returns how many transactions were committed.
+func (d *DB) Commits() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.commits
+}
+
+var queryName = regexp.MustCompile(`-- name: (\w+)`)
+
+func (d *DB) run(query string, args []driver.NamedValue) Result {
+	name := query
+
+	if m := queryName.FindStringSubmatch(query); m != nil {
+		name = m[1]
+	}
+
+	values := make([]driver.Value, len(args))
+
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	d.mu.Lock()
+	d.calls = append(d.calls, Call{Name: name, Args: values})
+	handler, ok := d.handlers[name]
+	d.mu.Unlock()
+
+	if !ok {
+		return Result{Err: fmt.Errorf("sqlfake: unexpected query %s", name)}
+	}
+
+	return handler(values)
+}
+
+type connector struct {
+	db *DB
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{db: c.db}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("sqlfake: open the database with New")
+}
+
+type conn struct {
+	db *DB
+}
+
+func (c *conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("sqlfake: prepared statements are not supported")
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return tx{db: c.db}, nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result := c.db.run(query, args)
+
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	return &rows{values: result.Rows}, nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result := c.db.run(query, args)
+
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	return driver.RowsAffected(result.RowsAffected), nil
+}
+
+// CheckNamedValue lets every argument through as is, the handlers see what
+// the code passed.
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if valuer, ok := nv.Value.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		nv.Value = value
+
+		return err
+	}
+
+	return nil
+}
+
+type tx struct {
+	db *DB
+}
+
+func (t tx) Commit() error {
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+
+	t.db.commits++
+
+	return nil
+}
+
+func (t tx) Rollback() error {
+	return nil
+}
+
+type rows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *rows) Columns() []string {
+	if len(r.values) == 0 {
+		return nil
+	}
+
+	columns := make([]string, len(r.values[0]))
+
+	for i := range columns {
+		columns[i] = "column" + strconv.Itoa(i+1)
+	}
+
+	return columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+
+	copy(dest, r.values[r.next])
+	r.next++
+
+	return nil
+}
@@ -1,10 +1,11 @@
 package validation
 
 type DatabaseValidationRepository interface {
-	// IsUnique
-	IsUnique(table, column string, value any) (bool, error)
-	IsUniqueWithCondition(table, column, conditionColumn string, value, conditionValue any) (bool, error)
+	// IsUnique reports whether the value is already used, rows with
+	// deletedColumn set are ignored when deletedColumn isn't empty
+	IsUnique(table, column, deletedColumn string, value any) (bool, error)
+	IsUniqueWithCondition(table, column, conditionColumn, deletedColumn string, value, conditionValue any) (bool, error)
 }
 
-type IsUniqueFunc func(table, column string, value any) (bool, error)
-type IsUniqueWithConditionFunc func(table, column, conditionColumn string, value, conditionValue any) (bool, error)
+type IsUniqueFunc func(table, column, deletedColumn string, value any) (bool, error)
+type IsUniqueWithConditionFunc func(table, column, conditionColumn, deletedColumn string, value, conditionValue any) (bool, error)
@@ -23,17 +23,30 @@ type dbUniqueRule struct {
 	field         string
 	excludeColumn string
 	excludeValue  any
+	deletedColumn string
 	message       string
 }
 
+// SoftDeletes ignores the rows where column is set, so soft-deleted rows
+// don't keep holding on to their values.
+func (r dbUniqueRule) SoftDeletes(column string) dbUniqueRule {
+	r.deletedColumn = column
+	return r
+}
+
 func (r dbUniqueRule) Validate(value interface{}) error {
 	var exists bool
 	var err error
 
+	// empty values are left to the Required rule
+	if s, ok := value.(string); ok && s == "" {
+		return nil
+	}
+
 	if r.excludeColumn != "" {
-		exists, err = databaseRepository.IsUniqueWithCondition(r.table, r.field, r.excludeColumn, value, r.excludeValue)
+		exists, err = databaseRepository.IsUniqueWithCondition(r.table, r.field, r.excludeColumn, r.deletedColumn, value, r.excludeValue)
 	} else {
-		exists, err = databaseRepository.IsUnique(r.table, r.field, value)
+		exists, err = databaseRepository.IsUnique(r.table, r.field, r.deletedColumn, value)
 	}
 
 	if err != nil {
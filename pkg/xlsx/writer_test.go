@@ -0,0 +1,115 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		index int
+		want  string
+	}{
+		{0, "A"},
+		{25, "Z"},
+		{26, "AA"},
+		{51, "AZ"},
+		{52, "BA"},
+		{701, "ZZ"},
+		{702, "AAA"},
+	}
+
+	for _, tt := range tests {
+		if got := columnName(tt.index); got != tt.want {
+			t.Errorf("columnName(%d) = %q, want %q", tt.index, got, tt.want)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, `Donasi <"Q1">`)
+
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	rows := [][]Cell{
+		{String("Donor"), String("Amount")},
+		{String("Budi & <Ani>"), Number("150000.50")},
+		{String("Anonymous"), Number("not a number")},
+	}
+
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	if err != nil {
+		t.Fatalf("the workbook is not a zip file: %v", err)
+	}
+
+	parts := map[string]string{}
+
+	for _, f := range zr.File {
+		r, err := f.Open()
+
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", f.Name, err)
+		}
+
+		content, err := io.ReadAll(r)
+		r.Close()
+
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", f.Name, err)
+		}
+
+		// every part has to be well formed or the workbook won't open
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well formed: %v", f.Name, err)
+			}
+		}
+
+		parts[f.Name] = string(content)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("the workbook is missing %s", name)
+		}
+	}
+
+	if !strings.Contains(parts["xl/workbook.xml"], `name="Donasi &lt;&#34;Q1&#34;&gt;"`) {
+		t.Errorf("the sheet name is not escaped: %s", parts["xl/workbook.xml"])
+	}
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+
+	for _, want := range []string{
+		`<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">Donor</t></is></c>`,
+		`<c r="A2" t="inlineStr"><is><t xml:space="preserve">Budi &amp; &lt;Ani&gt;</t></is></c><c r="B2"><v>150000.50</v></c>`,
+		`<c r="B3" t="inlineStr"><is><t xml:space="preserve">not a number</t></is></c>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("the sheet is missing %s", want)
+		}
+	}
+}